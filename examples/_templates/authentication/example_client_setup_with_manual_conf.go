@@ -30,7 +30,7 @@ func main() {
 		CustomTimeout:               60,  // in seconds
 		TokenRefreshBufferPeriod:    300, // in seconds
 		TotalRetryDuration:          60,  // in seconds
-		FollowRedirects:             true,
+		FollowRedirects:             jamfpro.TruePtr(),
 		MaxRedirects:                5,
 		EnableConcurrencyManagement: true,
 		MandatoryRequestDelay:       0, // in milliseconds
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 func main() {
@@ -31,12 +32,19 @@ func main() {
 		Example: sort=udid:desc,general.name:asc.
 	*/
 
-	// Define your sorting criteria and section filters if needed
-	// sortCriteria := []string{"udid:desc,general.name:asc"}        // Example sort criteria
-	// sections := []string{"GENERAL", "DISK_ENCRYPTION", "STORAGE"} // Example sections
+	// Define your sections, sorting criteria and filter. Sections which are not requested are left nil.
+	options := jamfpro.ComputerInventoryListOptions{
+		Sections: []string{
+			jamfpro.ComputerInventorySectionGeneral,
+			jamfpro.ComputerInventorySectionHardware,
+			jamfpro.ComputerInventorySectionExtensionAttributes,
+		},
+		Sort:   []string{rsql.Desc(rsql.ComputerUDID).String(), rsql.Asc(rsql.ComputerGeneralName).String()},
+		Filter: rsql.Eq(rsql.ComputerGeneralPlatform, "Mac").String(),
+	}
 
-	// Call the GetComputersInventory function
-	inventoryList, err := client.GetComputersInventory("")
+	// Call the GetComputersInventoryWithOptions function
+	inventoryList, err := client.GetComputersInventoryWithOptions(options)
 	if err != nil {
 		log.Fatalf("Error fetching computer inventory: %v", err)
 	}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Admin configuration using basic auth, for example with auth_method set to "basic"
+	adminConfigFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/adminconfig.json"
+	// Where the generated OAuth2 configuration is written, and read back on later runs
+	clientConfigFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	adminConfig, err := readConfig(adminConfigFilePath)
+	if err != nil {
+		log.Fatalf("Failed to read admin configuration: %v", err)
+	}
+
+	opts := jamfpro.BootstrapOptions{
+		RoleName:        "Inventory Reporting",
+		Privileges:      []string{"Read Computers", "Read Mobile Devices"},
+		IntegrationName: "Inventory Reporting",
+	}
+
+	// Reuse the credentials written by an earlier run while they still work
+	if existing, err := readConfig(clientConfigFilePath); err == nil {
+		opts.Existing = existing
+	}
+
+	result, err := jamfpro.BootstrapOAuthClient(adminConfig, opts)
+	if err != nil {
+		log.Fatalf("Failed to bootstrap API client: %v", err)
+	}
+
+	fmt.Printf("Role %q (ID %s), changed: %t\n", result.Role.DisplayName, result.Role.ID, result.RoleChanged)
+	fmt.Printf("Integration %q (ID %d), changed: %t\n", result.Integration.DisplayName, result.Integration.ID, result.IntegrationChanged)
+	fmt.Printf("New client credentials generated: %t\n", result.CredentialsGenerated)
+
+	data, err := json.MarshalIndent(result.Config, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal client configuration: %v", err)
+	}
+
+	if err := os.WriteFile(clientConfigFilePath, data, 0600); err != nil {
+		log.Fatalf("Failed to write client configuration: %v", err)
+	}
+
+	fmt.Printf("Client configuration written to %s\n", clientConfigFilePath)
+}
+
+func readConfig(path string) (*jamfpro.ConfigContainer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config jamfpro.ConfigContainer
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
@@ -43,7 +43,7 @@ func GetManagementIDByDeviceName(client *jamfpro.Client, deviceName string) (str
 	}
 
 	for _, inventory := range inventories.Results {
-		if inventory.General.Name == deviceName {
+		if inventory.General != nil && inventory.General.Name == deviceName {
 			return inventory.General.ManagementId, nil
 		}
 	}
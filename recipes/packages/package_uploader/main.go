@@ -62,80 +62,31 @@ func main() {
 		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
 	}
 
-	// Fetch existing packages from Jamf Pro
-	jamfProPackages, err := client.GetPackages("", "")
-	if err != nil {
-		log.Fatalf("Failed to get Jamf Pro packages: %v", err)
-	}
-
-	// Create a map of existing JCDS entries
-	jcdsMap := make(map[string]bool)
-	for _, pkg := range jamfProPackages.Results {
-		key := fmt.Sprintf("%s-%s", pkg.FileName, pkg.MD5)
-		jcdsMap[key] = true
+	// Upload the packages, skipping those which Jamf Pro already holds
+	report, err := client.UploadPackages(pkgFiles, &jamfpro.PackageUploadOptions{
+		Template: &jamfpro.ResourcePackage{
+			CategoryID: "-1",
+			Priority:   3,
+			SWU:        uploader.BoolPtr(false),
+		},
+	})
+	if report == nil {
+		log.Fatalf("Failed to upload packages: %v", err)
 	}
 
-	for _, filePath := range pkgFiles {
-		fileName := filepath.Base(filePath)
-		fileMD5 := uploader.CalculateFileMD5(filePath) // Use the MD5 calculation function
-		jcdsKey := fmt.Sprintf("%s-%s", fileName, fileMD5)
-
-		// Log the start of processing for this file
-		fmt.Println("-------------------------------------------------")
-		fmt.Printf("Processing package: %s\n", fileName)
-		fmt.Printf("Calculated MD5: %s\n", fileMD5)
-
-		// Check JCDS for the package with MD5 using the map
-		fmt.Printf("Checking JCDS for existing package...\n")
-		if _, exists := jcdsMap[jcdsKey]; exists {
-			fmt.Printf("Package %s with MD5 %s already exists in JCDS. Skipping package upload.\n", fileName, fileMD5)
-			fmt.Println("-------------------------------------------------")
-			continue
-		} else {
-			fmt.Printf("Package %s with MD5 %s does not exist in JCDS. Proceeding with package upload.\n", fileName, fileMD5)
-		}
-
-		// Check Jamf Pro for existing package metadata
-		fmt.Printf("Checking Jamf Pro for existing package metadata...\n")
-		if uploader.PackageMetadataExists(jamfProPackages.Results, fileName) {
-			fmt.Printf("Package metadata for %s already exists in Jamf Pro. Skipping metadata creation.\n", fileName)
-			fmt.Println("-------------------------------------------------")
-			continue
-		} else {
-			fmt.Printf("Package metadata for %s does not exist in Jamf Pro. Proceeding with package metadata creation.\n", fileName)
-		}
-
-		// Upload the package
-		fmt.Printf("Uploading package: %s\n", fileName)
-
-		// Create package metadata
-		pkg := jamfpro.ResourcePackage{
-			PackageName:          fileName,
-			FileName:             fileName,
-			CategoryID:           "-1",
-			Priority:             3,
-			FillUserTemplate:     uploader.BoolPtr(false),
-			SWU:                  uploader.BoolPtr(false),
-			RebootRequired:       uploader.BoolPtr(false),
-			OSInstall:            uploader.BoolPtr(false),
-			SuppressUpdates:      uploader.BoolPtr(false),
-			SuppressFromDock:     uploader.BoolPtr(false),
-			SuppressEula:         uploader.BoolPtr(false),
-			SuppressRegistration: uploader.BoolPtr(false),
+	fmt.Println("-------------------------------------------------")
+	for _, result := range report.Results {
+		fmt.Printf("%-8s %s (%s)\n", result.Action, result.FileName, result.Reason)
+		if result.Error != nil {
+			fmt.Printf("         %v\n", result.Error)
 		}
+	}
+	fmt.Println("-------------------------------------------------")
+	fmt.Printf("Created: %d, updated: %d, skipped: %d, failed: %d\n",
+		len(report.ByAction(jamfpro.PackageUploadCreated)), len(report.ByAction(jamfpro.PackageUploadUpdated)),
+		len(report.ByAction(jamfpro.PackageUploadSkipped)), len(report.ByAction(jamfpro.PackageUploadFailed)))
 
-		response, err := client.CreatePackage(pkg)
-		if err != nil {
-			log.Fatalf("Error creating package in Jamf Pro: %v", err)
-		}
-		fmt.Printf("Package created with ID: %s\n", response.ID)
-
-		_, err = client.UploadPackage(response.ID, []string{filePath})
-		if err != nil {
-			log.Fatalf("Error uploading package to Jamf Pro: %v", err)
-		}
-		fmt.Printf("Package %s uploaded successfully.\n", fileName)
-
-		fmt.Println("-------------------------------------------------")
+	if err != nil {
+		log.Fatalf("Some packages failed to upload")
 	}
 }
@@ -0,0 +1,319 @@
+// flatpkg.go
+// Package flatpkg inspects macOS flat installer packages (.pkg) without installer tools, so package
+// metadata can be filled in on any platform.
+//
+// A flat package is a xar archive. A product archive, as built by productbuild, holds a Distribution
+// file and one directory per component package; a component package, as built by pkgbuild, holds a
+// single PackageInfo file. Inspect reads both forms:
+//
+//	info, err := flatpkg.Inspect("Firefox.pkg")
+//	fmt.Println(info.Identifier, info.Version, info.BundleIdentifiers(), info.Certificates)
+//
+// Signing certificates are reported by name only. The signature itself is not verified, so use
+// pkgutil --check-signature where that matters.
+package flatpkg
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Info describes a flat package.
+type Info struct {
+	// Title is the title of a product archive, which may be a localization key.
+	Title string `json:"title,omitempty"`
+	// Identifier and Version are those of the product, or of the first component package when the
+	// Distribution has no product element.
+	Identifier string `json:"identifier"`
+	Version    string `json:"version"`
+	// InstallKBytes is the installed size of all components.
+	InstallKBytes int64 `json:"installKBytes"`
+	// MinimumOSVersion is the lowest macOS version allowed by the Distribution.
+	MinimumOSVersion string `json:"minimumOsVersion,omitempty"`
+	// Architectures lists the host architectures allowed by the Distribution.
+	Architectures []string `json:"architectures,omitempty"`
+	// Distribution is true for product archives.
+	Distribution bool        `json:"distribution"`
+	Components   []Component `json:"components"`
+	// Certificates lists the common names of the signing certificate chain, leaf first. It is empty
+	// for unsigned packages.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// Component is a component package.
+type Component struct {
+	Identifier      string   `json:"identifier"`
+	Version         string   `json:"version"`
+	InstallKBytes   int64    `json:"installKBytes"`
+	InstallLocation string   `json:"installLocation,omitempty"`
+	Bundles         []Bundle `json:"bundles,omitempty"`
+}
+
+// Bundle is an application or other bundle installed by a component package.
+type Bundle struct {
+	Identifier string `json:"identifier"`
+	// Version is the CFBundleShortVersionString, and Build the CFBundleVersion.
+	Version string `json:"version,omitempty"`
+	Build   string `json:"build,omitempty"`
+	Path    string `json:"path"`
+}
+
+// BundleIdentifiers returns the identifiers of the bundles the package installs, in order and without
+// duplicates.
+func (i *Info) BundleIdentifiers() []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, component := range i.Components {
+		for _, bundle := range component.Bundles {
+			if bundle.Identifier != "" && !seen[bundle.Identifier] {
+				seen[bundle.Identifier] = true
+				ids = append(ids, bundle.Identifier)
+			}
+		}
+	}
+	return ids
+}
+
+// distribution is the part of a Distribution file which Inspect reads.
+type distribution struct {
+	Title   string `xml:"title"`
+	Product *struct {
+		ID      string `xml:"id,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"product"`
+	Options struct {
+		HostArchitectures string `xml:"hostArchitectures,attr"`
+	} `xml:"options"`
+	AllowedOSVersions []osVersion `xml:"allowed-os-versions>os-version"`
+	VolumeCheck       []osVersion `xml:"volume-check>allowed-os-versions>os-version"`
+	PkgRefs           []struct {
+		ID            string `xml:"id,attr"`
+		Version       string `xml:"version,attr"`
+		InstallKBytes string `xml:"installKBytes,attr"`
+		Location      string `xml:",chardata"`
+	} `xml:"pkg-ref"`
+}
+
+type osVersion struct {
+	Min string `xml:"min,attr"`
+}
+
+// packageInfo is the part of a PackageInfo file which Inspect reads.
+type packageInfo struct {
+	Identifier      string `xml:"identifier,attr"`
+	Version         string `xml:"version,attr"`
+	InstallLocation string `xml:"install-location,attr"`
+	Payload         struct {
+		InstallKBytes int64 `xml:"installKBytes,attr"`
+	} `xml:"payload"`
+	Bundles        []packageBundle `xml:"bundle"`
+	BundleVersions []packageBundle `xml:"bundle-version>bundle"`
+}
+
+type packageBundle struct {
+	ID           string          `xml:"id,attr"`
+	ShortVersion string          `xml:"CFBundleShortVersionString,attr"`
+	Version      string          `xml:"CFBundleVersion,attr"`
+	Path         string          `xml:"path,attr"`
+	Bundles      []packageBundle `xml:"bundle"`
+}
+
+// Inspect reads the package file at path.
+func Inspect(path string) (*Info, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open package: %w", err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat package: %w", err)
+	}
+
+	archive, err := NewArchive(file, stat.Size())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return InspectArchive(archive)
+}
+
+// InspectArchive reads an open package archive.
+func InspectArchive(a *Archive) (*Info, error) {
+	info := &Info{}
+	for _, der := range a.certificates {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signing certificate: %w", err)
+		}
+		info.Certificates = append(info.Certificates, cert.Subject.CommonName)
+	}
+
+	if a.File("Distribution") == nil {
+		component, err := readComponent(a, "PackageInfo")
+		if err != nil {
+			return nil, err
+		}
+		info.Identifier, info.Version, info.InstallKBytes = component.Identifier, component.Version, component.InstallKBytes
+		info.Components = []Component{*component}
+		return info, nil
+	}
+
+	data, err := a.ReadFile("Distribution")
+	if err != nil {
+		return nil, err
+	}
+	var dist distribution
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&dist); err != nil {
+		return nil, fmt.Errorf("failed to parse Distribution: %w", err)
+	}
+
+	info.Distribution = true
+	info.Title = strings.TrimSpace(dist.Title)
+	if dist.Product != nil {
+		info.Identifier, info.Version = dist.Product.ID, dist.Product.Version
+	}
+	for _, v := range append(dist.AllowedOSVersions, dist.VolumeCheck...) {
+		if v.Min != "" && (info.MinimumOSVersion == "" || compareVersions(v.Min, info.MinimumOSVersion) < 0) {
+			info.MinimumOSVersion = v.Min
+		}
+	}
+	for _, arch := range strings.Split(dist.Options.HostArchitectures, ",") {
+		if arch = strings.TrimSpace(arch); arch != "" {
+			info.Architectures = append(info.Architectures, arch)
+		}
+	}
+
+	// A component is referenced by several pkg-ref elements, only one of which names its location.
+	var order []string
+	refs := make(map[string]*Component)
+	locations := make(map[string]string)
+	for _, ref := range dist.PkgRefs {
+		if ref.ID == "" {
+			continue
+		}
+		component, ok := refs[ref.ID]
+		if !ok {
+			component = &Component{Identifier: ref.ID}
+			refs[ref.ID] = component
+			order = append(order, ref.ID)
+		}
+		if ref.Version != "" {
+			component.Version = ref.Version
+		}
+		if kbytes, err := strconv.ParseInt(ref.InstallKBytes, 10, 64); err == nil {
+			component.InstallKBytes = kbytes
+		}
+		if location := strings.TrimSpace(ref.Location); location != "" {
+			locations[ref.ID] = location
+		}
+	}
+
+	for _, id := range order {
+		component := refs[id]
+		location, ok := locations[id]
+		if !ok {
+			continue
+		}
+		dir, err := componentDir(location)
+		if err != nil {
+			return nil, err
+		}
+		if embedded, err := readComponent(a, dir+"/PackageInfo"); err == nil {
+			component.Bundles = embedded.Bundles
+			component.InstallLocation = embedded.InstallLocation
+			if component.InstallKBytes == 0 {
+				component.InstallKBytes = embedded.InstallKBytes
+			}
+		} else if !errors.Is(err, errFileNotFound) {
+			return nil, err
+		}
+
+		info.Components = append(info.Components, *component)
+		info.InstallKBytes += component.InstallKBytes
+		if info.Identifier == "" && component.Version != "" {
+			info.Identifier, info.Version = component.Identifier, component.Version
+		}
+	}
+
+	return info, nil
+}
+
+// readComponent reads a PackageInfo file.
+func readComponent(a *Archive, name string) (*Component, error) {
+	data, err := a.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var pkgInfo packageInfo
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&pkgInfo); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+
+	component := &Component{
+		Identifier:      pkgInfo.Identifier,
+		Version:         pkgInfo.Version,
+		InstallKBytes:   pkgInfo.Payload.InstallKBytes,
+		InstallLocation: pkgInfo.InstallLocation,
+	}
+	// bundle-version often repeats bundles by identifier alone.
+	seenPaths, seenIDs := make(map[string]bool), make(map[string]bool)
+	var add func([]packageBundle)
+	add = func(bundles []packageBundle) {
+		for _, b := range bundles {
+			if (b.Path != "" && !seenPaths[b.Path]) || (b.Path == "" && !seenIDs[b.ID]) {
+				seenPaths[b.Path], seenIDs[b.ID] = true, true
+				component.Bundles = append(component.Bundles, Bundle{Identifier: b.ID, Version: b.ShortVersion, Build: b.Version, Path: b.Path})
+			}
+			add(b.Bundles)
+		}
+	}
+	add(pkgInfo.Bundles)
+	add(pkgInfo.BundleVersions)
+	return component, nil
+}
+
+// componentDir returns the archive directory of a component from its pkg-ref location, such as
+// "#Firefox%20Core.pkg".
+func componentDir(location string) (string, error) {
+	location = strings.TrimPrefix(strings.TrimPrefix(location, "#"), "file:")
+	dir, err := url.PathUnescape(strings.TrimPrefix(location, "./"))
+	if err != nil {
+		return "", fmt.Errorf("invalid component location %q: %w", location, err)
+	}
+	return dir, nil
+}
+
+// compareVersions compares dotted version strings numerically.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// decodeBase64 decodes base64 which may be wrapped across lines.
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
@@ -0,0 +1,137 @@
+// flatpkg_test.go
+package flatpkg_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/flatpkg"
+)
+
+// The sample packages are written by testdata/generate.go.
+
+func TestInspect(t *testing.T) {
+	tests := []struct {
+		path string
+		want *flatpkg.Info
+	}{
+		{
+			path: "testdata/Signed.pkg",
+			want: &flatpkg.Info{
+				Title:            "Firefox",
+				Identifier:       "org.mozilla.firefox.product",
+				Version:          "128.0",
+				InstallKBytes:    413024,
+				MinimumOSVersion: "10.15",
+				Architectures:    []string{"x86_64", "arm64"},
+				Distribution:     true,
+				Components: []flatpkg.Component{
+					{
+						Identifier:      "org.mozilla.firefox",
+						Version:         "128.0",
+						InstallKBytes:   412000,
+						InstallLocation: "/Applications",
+						Bundles: []flatpkg.Bundle{
+							{Identifier: "org.mozilla.firefox", Version: "128.0", Build: "12824.6.26", Path: "./Firefox.app"},
+							{Identifier: "org.mozilla.plugincontainer", Version: "128.0", Build: "1.0", Path: "./Firefox.app/Contents/MacOS/plugin-container.app"},
+						},
+					},
+					{
+						Identifier:      "org.mozilla.updater",
+						Version:         "1.2",
+						InstallKBytes:   1024,
+						InstallLocation: "/Library/Application Support/Mozilla",
+					},
+				},
+				Certificates: []string{"Developer ID Installer: Example Corp (ABCDE12345)", "Developer ID Certification Authority"},
+			},
+		},
+		{
+			path: "testdata/Component.pkg",
+			want: &flatpkg.Info{
+				Identifier:    "com.example.agent",
+				Version:       "2.4.1",
+				InstallKBytes: 2048,
+				Components: []flatpkg.Component{
+					{
+						Identifier:      "com.example.agent",
+						Version:         "2.4.1",
+						InstallKBytes:   2048,
+						InstallLocation: "/",
+						Bundles: []flatpkg.Bundle{
+							{Identifier: "com.example.agent.app", Version: "2.4.1", Build: "241", Path: "./Library/Example/Example Agent.app"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			info, err := flatpkg.Inspect(tt.path)
+			if err != nil {
+				t.Fatalf("Inspect() returned error: %v", err)
+			}
+			if !reflect.DeepEqual(info, tt.want) {
+				t.Errorf("Inspect() = %+v, want %+v", info, tt.want)
+			}
+		})
+	}
+}
+
+func TestBundleIdentifiers(t *testing.T) {
+	info, err := flatpkg.Inspect("testdata/Signed.pkg")
+	if err != nil {
+		t.Fatalf("Inspect() returned error: %v", err)
+	}
+	want := []string{"org.mozilla.firefox", "org.mozilla.plugincontainer"}
+	if ids := info.BundleIdentifiers(); !reflect.DeepEqual(ids, want) {
+		t.Errorf("BundleIdentifiers() = %v, want %v", ids, want)
+	}
+}
+
+func TestNewArchive(t *testing.T) {
+	data, err := os.ReadFile("testdata/Signed.pkg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := flatpkg.NewArchive(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("NewArchive() returned error: %v", err)
+	}
+	if f := archive.File("Firefox Updater.pkg/Payload"); f == nil || f.Size != 4096 {
+		t.Errorf("File() = %+v, want the 4096 byte updater payload", f)
+	}
+	if _, err := archive.ReadFile("Missing"); err == nil {
+		t.Error("ReadFile() of a missing file returned no error")
+	}
+
+	if _, err := flatpkg.NewArchive(bytes.NewReader([]byte("not a package")), 13); !errors.Is(err, flatpkg.ErrNotFlatPackage) {
+		t.Errorf("NewArchive() of a text file returned %v, want ErrNotFlatPackage", err)
+	}
+
+	// A compressed table of contents length of 2^63 or more must not wrap negative.
+	huge := make([]byte, 92)
+	binary.BigEndian.PutUint32(huge[0:], 0x78617221)
+	binary.BigEndian.PutUint16(huge[4:], 28)
+	binary.BigEndian.PutUint16(huge[6:], 1)
+	binary.BigEndian.PutUint64(huge[8:], 1<<63)
+	binary.BigEndian.PutUint64(huge[16:], 1024)
+	if _, err := flatpkg.NewArchive(bytes.NewReader(huge), int64(len(huge))); err == nil {
+		t.Error("NewArchive() accepted a table of contents longer than the archive")
+	}
+
+	// Corrupting the stored table of contents checksum is detected.
+	corrupt := bytes.Clone(data)
+	heap := 28 + int(binary.BigEndian.Uint64(corrupt[8:16]))
+	corrupt[heap] ^= 0xff
+	if _, err := flatpkg.NewArchive(bytes.NewReader(corrupt), int64(len(corrupt))); err == nil {
+		t.Error("NewArchive() accepted an archive with a bad table of contents checksum")
+	}
+}
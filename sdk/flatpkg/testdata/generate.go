@@ -0,0 +1,269 @@
+//go:build ignore
+
+// generate.go
+// Writes the sample packages used by the flatpkg and jamfpro tests: a signed product archive, as
+// built by productbuild, and an unsigned component package, as built by pkgbuild. The signing
+// certificates are self-signed stand-ins for Apple's.
+//
+// Run from this directory with: go run generate.go
+package main
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"log"
+	"math/big"
+	mathrand "math/rand"
+	"os"
+	"strings"
+	"time"
+)
+
+const distribution = `<?xml version="1.0" encoding="utf-8"?>
+<installer-gui-script minSpecVersion="2">
+    <title>Firefox</title>
+    <options customize="never" require-scripts="false" hostArchitectures="x86_64,arm64"/>
+    <volume-check>
+        <allowed-os-versions>
+            <os-version min="11.0"/>
+            <os-version min="10.15"/>
+        </allowed-os-versions>
+    </volume-check>
+    <choices-outline>
+        <line choice="default">
+            <line choice="org.mozilla.firefox"/>
+        </line>
+    </choices-outline>
+    <choice id="default"/>
+    <choice id="org.mozilla.firefox" visible="false">
+        <pkg-ref id="org.mozilla.firefox"/>
+        <pkg-ref id="org.mozilla.updater"/>
+    </choice>
+    <pkg-ref id="org.mozilla.firefox" version="128.0" installKBytes="412000" onConclusion="none">#Firefox.pkg</pkg-ref>
+    <pkg-ref id="org.mozilla.updater" version="1.2" installKBytes="1024" onConclusion="none">#Firefox%20Updater.pkg</pkg-ref>
+    <product id="org.mozilla.firefox.product" version="128.0"/>
+</installer-gui-script>
+`
+
+const firefoxPackageInfo = `<?xml version="1.0" encoding="utf-8"?>
+<pkg-info overwrite-permissions="true" relocatable="false" identifier="org.mozilla.firefox" postinstall-action="none" version="128.0" format-version="2" generator-version="InstallCmds-834 (22A380)" install-location="/Applications" auth="root">
+    <payload numberOfFiles="120" installKBytes="412000"/>
+    <bundle path="./Firefox.app" id="org.mozilla.firefox" CFBundleShortVersionString="128.0" CFBundleVersion="12824.6.26">
+        <bundle path="./Firefox.app/Contents/MacOS/plugin-container.app" id="org.mozilla.plugincontainer" CFBundleShortVersionString="128.0" CFBundleVersion="1.0"/>
+    </bundle>
+    <bundle-version>
+        <bundle id="org.mozilla.firefox"/>
+    </bundle-version>
+</pkg-info>
+`
+
+const updaterPackageInfo = `<?xml version="1.0" encoding="utf-8"?>
+<pkg-info identifier="org.mozilla.updater" version="1.2" format-version="2" install-location="/Library/Application Support/Mozilla" auth="root">
+    <payload numberOfFiles="3" installKBytes="1024"/>
+</pkg-info>
+`
+
+const componentPackageInfo = `<?xml version="1.0" encoding="utf-8"?>
+<pkg-info overwrite-permissions="true" relocatable="false" identifier="com.example.agent" postinstall-action="none" version="2.4.1" format-version="2" install-location="/" auth="root">
+    <payload numberOfFiles="12" installKBytes="2048"/>
+    <bundle path="./Library/Example/Example Agent.app" id="com.example.agent.app" CFBundleShortVersionString="2.4.1" CFBundleVersion="241"/>
+    <bundle-version>
+        <bundle id="com.example.agent.app"/>
+    </bundle-version>
+</pkg-info>
+`
+
+// entry is a file to be written to a xar archive.
+type entry struct {
+	name string
+	data []byte
+	gzip bool
+}
+
+func main() {
+	random := mathrand.New(mathrand.NewSource(1))
+	payload := func(n int) []byte {
+		b := make([]byte, n)
+		random.Read(b)
+		return b
+	}
+
+	certificates := signingCertificates()
+
+	signed := writeXar("sha1", certificates, []entry{
+		{name: "Distribution", data: []byte(distribution), gzip: true},
+		{name: "Firefox.pkg/PackageInfo", data: []byte(firefoxPackageInfo), gzip: true},
+		{name: "Firefox.pkg/Payload", data: payload(48 * 1024)},
+		{name: "Firefox Updater.pkg/PackageInfo", data: []byte(updaterPackageInfo)},
+		{name: "Firefox Updater.pkg/Payload", data: payload(4 * 1024)},
+	})
+	if err := os.WriteFile("Signed.pkg", signed, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	component := writeXar("sha256", nil, []entry{
+		{name: "PackageInfo", data: []byte(componentPackageInfo), gzip: true},
+		{name: "Bom", data: payload(512)},
+		{name: "Payload", data: payload(16 * 1024)},
+	})
+	if err := os.WriteFile("Component.pkg", component, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeXar builds a xar archive holding entries, with a table of contents checksum of the named
+// algorithm and, when certificates is set, a signature element naming them.
+func writeXar(checksum string, certificates [][]byte, entries []entry) []byte {
+	newHash := func() hash.Hash {
+		if checksum == "sha1" {
+			return sha1.New()
+		}
+		return sha256.New()
+	}
+
+	// The heap starts with the table of contents checksum, followed by the signature.
+	var heap bytes.Buffer
+	heap.Write(make([]byte, newHash().Size()))
+	signatureOffset := heap.Len()
+	if certificates != nil {
+		heap.Write(make([]byte, 256))
+	}
+
+	var toc strings.Builder
+	toc.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n<xar>\n <toc>\n")
+	fmt.Fprintf(&toc, "  <checksum style=%q><offset>0</offset><size>%d</size></checksum>\n", checksum, newHash().Size())
+	if certificates != nil {
+		fmt.Fprintf(&toc, "  <signature style=\"RSA\"><offset>%d</offset><size>256</size>\n", signatureOffset)
+		toc.WriteString("   <KeyInfo xmlns=\"http://www.w3.org/2000/09/xmldsig#\"><X509Data>\n")
+		for _, der := range certificates {
+			encoded := base64.StdEncoding.EncodeToString(der)
+			var wrapped []string
+			for len(encoded) > 64 {
+				wrapped, encoded = append(wrapped, encoded[:64]), encoded[64:]
+			}
+			wrapped = append(wrapped, encoded)
+			fmt.Fprintf(&toc, "    <X509Certificate>%s</X509Certificate>\n", strings.Join(wrapped, "\n"))
+		}
+		toc.WriteString("   </X509Data></KeyInfo>\n  </signature>\n")
+	}
+
+	id := 0
+	dirs := make(map[string]bool)
+	open := ""
+	for _, e := range entries {
+		dir, name := "", e.name
+		if i := strings.LastIndex(e.name, "/"); i >= 0 {
+			dir, name = e.name[:i], e.name[i+1:]
+		}
+		if dir != open {
+			if open != "" {
+				toc.WriteString("  </file>\n")
+			}
+			if dir != "" && !dirs[dir] {
+				id++
+				dirs[dir] = true
+				fmt.Fprintf(&toc, "  <file id=\"%d\"><name>%s</name><type>directory</type>\n", id, dir)
+			}
+			open = dir
+		}
+
+		archived, encoding := e.data, "application/octet-stream"
+		if e.gzip {
+			var b bytes.Buffer
+			w := zlib.NewWriter(&b)
+			w.Write(e.data)
+			w.Close()
+			archived, encoding = b.Bytes(), "application/x-gzip"
+		}
+		sum := sha1.Sum(e.data)
+		archivedSum := sha1.Sum(archived)
+
+		id++
+		fmt.Fprintf(&toc, "  <file id=\"%d\"><name>%s</name><type>file</type><data>"+
+			"<length>%d</length><offset>%d</offset><size>%d</size><encoding style=%q/>"+
+			"<extracted-checksum style=\"sha1\">%s</extracted-checksum>"+
+			"<archived-checksum style=\"sha1\">%s</archived-checksum></data></file>\n",
+			id, name, len(archived), heap.Len(), len(e.data), encoding,
+			hex.EncodeToString(sum[:]), hex.EncodeToString(archivedSum[:]))
+		heap.Write(archived)
+	}
+	if open != "" {
+		toc.WriteString("  </file>\n")
+	}
+	toc.WriteString(" </toc>\n</xar>\n")
+
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	w.Write([]byte(toc.String()))
+	w.Close()
+
+	h := newHash()
+	h.Write(compressed.Bytes())
+	copy(heap.Bytes(), h.Sum(nil))
+
+	headerSize, algorithm := 28, uint32(1)
+	if checksum != "sha1" {
+		headerSize, algorithm = 64, 3
+	}
+	var out bytes.Buffer
+	binary.Write(&out, binary.BigEndian, uint32(0x78617221))
+	binary.Write(&out, binary.BigEndian, uint16(headerSize))
+	binary.Write(&out, binary.BigEndian, uint16(1))
+	binary.Write(&out, binary.BigEndian, uint64(compressed.Len()))
+	binary.Write(&out, binary.BigEndian, uint64(len(toc.String())))
+	binary.Write(&out, binary.BigEndian, algorithm)
+	if headerSize > 28 {
+		name := make([]byte, headerSize-28)
+		copy(name, checksum)
+		out.Write(name)
+	}
+	out.Write(compressed.Bytes())
+	out.Write(heap.Bytes())
+	return out.Bytes()
+}
+
+// signingCertificates returns a leaf and intermediate certificate named like Apple's.
+func signingCertificates() [][]byte {
+	newCertificate := func(serial int64, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			log.Fatal(err)
+		}
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(serial),
+			Subject:               pkix.Name{CommonName: name, Organization: []string{"Example Corp"}},
+			NotBefore:             time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			NotAfter:              time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC),
+			IsCA:                  parent == nil,
+			BasicConstraintsValid: true,
+		}
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return cert, key
+	}
+
+	ca, caKey := newCertificate(1, "Developer ID Certification Authority", nil, nil)
+	leaf, _ := newCertificate(2, "Developer ID Installer: Example Corp (ABCDE12345)", ca, caKey)
+	return [][]byte{leaf.Raw, ca.Raw}
+}
@@ -0,0 +1,286 @@
+// xar.go
+// Reader for the xar archives which hold flat installer packages.
+// Format reference: https://github.com/apple-oss-distributions/xar/blob/main/xar/include/xar.h.in
+package flatpkg
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/zlib"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"strings"
+)
+
+const (
+	xarMagic      = 0x78617221 // "xar!"
+	xarHeaderSize = 28
+
+	// Checksum algorithms recorded in the xar header.
+	xarChecksumNone  = 0
+	xarChecksumSHA1  = 1
+	xarChecksumMD5   = 2
+	xarChecksumOther = 3
+
+	// maxTOCSize and maxFileSize bound what is decompressed into memory, since a package's TOC,
+	// Distribution and PackageInfo are small.
+	maxTOCSize  = 64 << 20
+	maxFileSize = 16 << 20
+)
+
+// ErrNotFlatPackage is returned for files which are not xar archives, such as bundle packages and
+// disk images.
+var ErrNotFlatPackage = errors.New("not a flat package")
+
+// errFileNotFound is returned by ReadFile for paths which are not in the archive.
+var errFileNotFound = errors.New("file not found in archive")
+
+// Archive is an open xar archive.
+type Archive struct {
+	r    io.ReaderAt
+	heap int64
+	// Files lists the archive's files and directories, with paths relative to its root.
+	Files []File
+	// certificates holds the DER certificates of the archive's signature, leaf first.
+	certificates [][]byte
+}
+
+// File is an entry in a xar archive.
+type File struct {
+	Name string
+	// Type is "file", "directory" or "symlink".
+	Type string
+	// Size is the extracted size of a file.
+	Size int64
+
+	offset, archivedSize int64
+	encoding             string
+	checksumStyle        string
+	checksum             string
+}
+
+// xarHeader is the fixed part of the xar header.
+type xarHeader struct {
+	Magic                 uint32
+	Size                  uint16
+	Version               uint16
+	TOCLengthCompressed   uint64
+	TOCLengthUncompressed uint64
+	ChecksumAlgorithm     uint32
+}
+
+type xarTOC struct {
+	Checksum struct {
+		Style  string `xml:"style,attr"`
+		Offset int64  `xml:"offset"`
+		Size   int64  `xml:"size"`
+	} `xml:"toc>checksum"`
+	Signature  *xarSignature `xml:"toc>signature"`
+	XSignature *xarSignature `xml:"toc>x-signature"`
+	Files      []xarFile     `xml:"toc>file"`
+}
+
+type xarSignature struct {
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type xarFile struct {
+	Name  string    `xml:"name"`
+	Type  string    `xml:"type"`
+	Data  *xarData  `xml:"data"`
+	Files []xarFile `xml:"file"`
+}
+
+type xarData struct {
+	Length   int64 `xml:"length"`
+	Offset   int64 `xml:"offset"`
+	Size     int64 `xml:"size"`
+	Encoding struct {
+		Style string `xml:"style,attr"`
+	} `xml:"encoding"`
+	ExtractedChecksum struct {
+		Style string `xml:"style,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"extracted-checksum"`
+}
+
+// NewArchive reads the table of contents of the xar archive in r, which is size bytes long, and
+// checks it against the checksum stored in the archive.
+func NewArchive(r io.ReaderAt, size int64) (*Archive, error) {
+	var header xarHeader
+	if err := binary.Read(io.NewSectionReader(r, 0, xarHeaderSize), binary.BigEndian, &header); err != nil || header.Magic != xarMagic {
+		return nil, ErrNotFlatPackage
+	}
+	// The lengths are checked before any arithmetic, so that huge values cannot wrap negative.
+	if header.Size < xarHeaderSize || header.TOCLengthUncompressed > maxTOCSize ||
+		header.TOCLengthCompressed > maxTOCSize || header.TOCLengthCompressed > uint64(size) ||
+		int64(header.Size)+int64(header.TOCLengthCompressed) > size {
+		return nil, fmt.Errorf("invalid xar header")
+	}
+
+	checksumName := ""
+	switch header.ChecksumAlgorithm {
+	case xarChecksumNone:
+	case xarChecksumSHA1:
+		checksumName = "sha1"
+	case xarChecksumMD5:
+		checksumName = "md5"
+	case xarChecksumOther:
+		name := make([]byte, int(header.Size)-xarHeaderSize)
+		if _, err := r.ReadAt(name, xarHeaderSize); err != nil {
+			return nil, fmt.Errorf("failed to read xar header: %w", err)
+		}
+		checksumName = string(bytes.TrimRight(name, "\x00"))
+	default:
+		return nil, fmt.Errorf("unsupported xar checksum algorithm %d", header.ChecksumAlgorithm)
+	}
+
+	compressed := make([]byte, header.TOCLengthCompressed)
+	if _, err := r.ReadAt(compressed, int64(header.Size)); err != nil {
+		return nil, fmt.Errorf("failed to read xar table of contents: %w", err)
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress xar table of contents: %w", err)
+	}
+	var toc xarTOC
+	if err := xml.NewDecoder(io.LimitReader(zr, maxTOCSize)).Decode(&toc); err != nil {
+		return nil, fmt.Errorf("failed to parse xar table of contents: %w", err)
+	}
+
+	a := &Archive{r: r, heap: int64(header.Size) + int64(header.TOCLengthCompressed)}
+
+	if checksumName != "" {
+		h := newHash(checksumName)
+		if h == nil {
+			return nil, fmt.Errorf("unsupported xar checksum algorithm %q", checksumName)
+		}
+		if toc.Checksum.Size != int64(h.Size()) || toc.Checksum.Offset < 0 {
+			return nil, fmt.Errorf("invalid xar table of contents checksum")
+		}
+		stored := make([]byte, toc.Checksum.Size)
+		if _, err := r.ReadAt(stored, a.heap+toc.Checksum.Offset); err != nil {
+			return nil, fmt.Errorf("failed to read xar table of contents checksum: %w", err)
+		}
+		h.Write(compressed)
+		if !bytes.Equal(h.Sum(nil), stored) {
+			return nil, fmt.Errorf("xar table of contents checksum does not match")
+		}
+	}
+
+	signature := toc.Signature
+	if signature == nil {
+		signature = toc.XSignature
+	}
+	if signature != nil {
+		for _, encoded := range signature.Certificates {
+			der, err := decodeBase64(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode signing certificate: %w", err)
+			}
+			a.certificates = append(a.certificates, der)
+		}
+	}
+
+	a.addFiles("", toc.Files)
+	return a, nil
+}
+
+// addFiles flattens the nested files of the table of contents into a.Files.
+func (a *Archive) addFiles(dir string, files []xarFile) {
+	for _, f := range files {
+		file := File{Name: path.Join(dir, f.Name), Type: f.Type}
+		if f.Data != nil {
+			file.Size = f.Data.Size
+			file.offset = f.Data.Offset
+			file.archivedSize = f.Data.Length
+			file.encoding = f.Data.Encoding.Style
+			file.checksumStyle = strings.ToLower(f.Data.ExtractedChecksum.Style)
+			file.checksum = strings.ToLower(strings.TrimSpace(f.Data.ExtractedChecksum.Value))
+		}
+		a.Files = append(a.Files, file)
+		a.addFiles(file.Name, f.Files)
+	}
+}
+
+// File returns the entry with the given path, or nil.
+func (a *Archive) File(name string) *File {
+	for i := range a.Files {
+		if a.Files[i].Name == name {
+			return &a.Files[i]
+		}
+	}
+	return nil
+}
+
+// ReadFile decompresses the file with the given path and checks its extracted checksum. Files larger
+// than 16 MiB, such as payloads, are refused.
+func (a *Archive) ReadFile(name string) ([]byte, error) {
+	file := a.File(name)
+	if file == nil || file.Type != "file" {
+		return nil, fmt.Errorf("%s: %w", name, errFileNotFound)
+	}
+	if file.Size > maxFileSize {
+		return nil, fmt.Errorf("%s is too large to read (%d bytes)", name, file.Size)
+	}
+
+	archived := io.NewSectionReader(a.r, a.heap+file.offset, file.archivedSize)
+	var r io.Reader
+	switch file.encoding {
+	case "", "application/octet-stream":
+		r = archived
+	case "application/x-gzip":
+		// xar labels zlib streams as gzip.
+		zr, err := zlib.NewReader(archived)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress %s: %w", name, err)
+		}
+		defer zr.Close()
+		r = zr
+	case "application/x-bzip2":
+		r = bzip2.NewReader(archived)
+	default:
+		return nil, fmt.Errorf("%s has unsupported encoding %q", name, file.encoding)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if int64(len(data)) != file.Size {
+		return nil, fmt.Errorf("%s is %d bytes, want %d", name, len(data), file.Size)
+	}
+
+	if h := newHash(file.checksumStyle); h != nil && file.checksum != "" {
+		h.Write(data)
+		if hex.EncodeToString(h.Sum(nil)) != file.checksum {
+			return nil, fmt.Errorf("%s checksum does not match", name)
+		}
+	}
+	return data, nil
+}
+
+// newHash returns the hash with a xar checksum name, or nil.
+func newHash(name string) hash.Hash {
+	switch strings.ToLower(name) {
+	case "sha1":
+		return sha1.New()
+	case "md5":
+		return md5.New()
+	case "sha256":
+		return sha256.New()
+	case "sha512":
+		return sha512.New()
+	}
+	return nil
+}
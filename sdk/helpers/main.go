@@ -39,6 +39,38 @@ func ReadJCDSPackageTypes(filePath string) (io.Reader, int64, error) {
 	return reader, size, nil
 }
 
+// OpenJCDSPackageFile opens a package file for streaming after applying the same checks as
+// ReadJCDSPackageTypes, returning the file and its size. The caller must close the file.
+func OpenJCDSPackageFile(filePath string) (*os.File, int64, error) {
+	allowedExtensions := []string{".pkg", ".dmg", ".zip"}
+
+	cleanedPath := cleanPath(filePath)
+	if !isValidExtension(cleanedPath, allowedExtensions) {
+		return nil, 0, fmt.Errorf("file extension '%s' is not allowed", filepath.Ext(cleanedPath))
+	}
+
+	resolvedPath, err := resolveSymlinks(cleanedPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	file, err := os.Open(resolvedPath)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open Jamf Pro package: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, fmt.Errorf("failed to stat Jamf Pro package: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		file.Close()
+		return nil, 0, fmt.Errorf("package %s is not a regular file", filePath)
+	}
+	return file, info.Size(), nil
+}
+
 // SafeReadCertificateFile reads a certificate file securely after applying multiple checks.
 func SafeReadCertificateFile(filePath string, allowedExtensions []string) ([]byte, error) {
 	cleanedPath := cleanPath(filePath)
@@ -0,0 +1,317 @@
+// api_client_audit.go
+// Audit journal of every mutating SDK request, recording who changed which Jamf Pro object, why, and
+// the object before and after the change.
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditEntry records a single mutating request.
+type AuditEntry struct {
+	Time time.Time `json:"time"`
+	// Operation is the SDK method which sent the request, such as "jamfpro.UpdatePolicyByID".
+	Operation string `json:"operation"`
+	Method    string `json:"method"`
+	Endpoint  string `json:"endpoint"`
+	// ResourceType is the collection the request changed, such as "policies" or "buildings".
+	ResourceType string `json:"resource_type,omitempty"`
+	// ResourceID and ResourceName identify the changed object. When the endpoint does not hold the id,
+	// as for creates and Classic API lookups by name, it is taken from the response.
+	ResourceID   string `json:"resource_id,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+	// Actor and Reason are taken from the request context, see AuditContext.
+	Actor  string `json:"actor,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// Before and After are the object as returned by a GET of the endpoint before and after an update,
+	// and before a delete. Jamf Pro API objects are JSON, and Classic API objects are XML encoded as a
+	// JSON string.
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+	// SnapshotError is set when a snapshot could not be fetched.
+	SnapshotError string `json:"snapshot_error,omitempty"`
+	// StatusCode is the status of the response, and Error is set when the request failed.
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// AuditSink receives the audit entries of a client.
+type AuditSink interface {
+	WriteAuditEntry(entry AuditEntry) error
+}
+
+// AuditSinkFunc adapts a function to AuditSink.
+type AuditSinkFunc func(entry AuditEntry) error
+
+// WriteAuditEntry calls f(entry).
+func (f AuditSinkFunc) WriteAuditEntry(entry AuditEntry) error {
+	return f(entry)
+}
+
+// auditWriter writes entries to a writer as JSON Lines.
+type auditWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewAuditWriter returns a sink which writes each entry to w as a line of JSON.
+func NewAuditWriter(w io.Writer) AuditSink {
+	return &auditWriter{w: w}
+}
+
+func (s *auditWriter) WriteAuditEntry(entry AuditEntry) error {
+	line, err := marshalAuditJSON(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(line)
+	return err
+}
+
+// auditFile appends entries to a file as JSON Lines.
+type auditFile struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewAuditFile returns a sink which appends each entry to the file at path as a line of JSON. The file
+// is opened for every entry, so that it can be rotated while the client is in use.
+func NewAuditFile(path string) AuditSink {
+	return &auditFile{path: path}
+}
+
+func (s *auditFile) WriteAuditEntry(entry AuditEntry) error {
+	line, err := marshalAuditJSON(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	if _, err := file.Write(line); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return file.Close()
+}
+
+// marshalAuditJSON encodes v as a line of JSON, leaving the markup of Classic API snapshots unescaped.
+func marshalAuditJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// auditContextKey carries the actor and reason of the requests sent with a context.
+type auditContextKey struct{}
+
+type auditInfo struct {
+	actor  string
+	reason string
+}
+
+// AuditContext returns a context which attributes the changes made with it to actor, for reason.
+//
+// Example usage:
+//
+//	ctx := jamfpro.AuditContext(ctx, "pipeline/deploy-firefox", "CHG-1234")
+//	err := client.WithContext(ctx).DeletePolicyByID("42")
+func AuditContext(ctx context.Context, actor, reason string) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, auditInfo{actor: actor, reason: reason})
+}
+
+// newAuditSink returns the sink configured by config, or nil when auditing is disabled.
+func newAuditSink(config *ConfigContainer) AuditSink {
+	if config.AuditSink != nil {
+		return config.AuditSink
+	}
+	if config.AuditLogPath != "" {
+		return NewAuditFile(config.AuditLogPath)
+	}
+	return nil
+}
+
+// auditRequest sends req with send and records it in the client's audit sink, unless it only reads.
+// Updates and deletes are preceded by a snapshot of the object, and updates followed by another. A
+// failure to write the entry is logged rather than returned, as the change has already been made.
+func (c *Client) auditRequest(req *Request, send Handler) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return send(req)
+	}
+
+	entry := AuditEntry{
+		Operation: req.Operation,
+		Method:    req.Method,
+		Endpoint:  req.Endpoint,
+	}
+	entry.ResourceType, entry.ResourceID, entry.ResourceName = auditResource(req.Endpoint)
+	if info, ok := req.Context.Value(auditContextKey{}).(auditInfo); ok {
+		entry.Actor, entry.Reason = info.actor, info.reason
+	}
+
+	client := c.WithContext(req.Context)
+	snapshot := req.Method == http.MethodPut || req.Method == http.MethodPatch || req.Method == http.MethodDelete
+
+	var snapshotErrs []error
+	if snapshot {
+		before, err := client.auditSnapshot(req.Endpoint)
+		entry.Before = before
+		snapshotErrs = append(snapshotErrs, err)
+	}
+
+	resp, err := send(req)
+	entry.Time = time.Now().UTC()
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		entry.StatusCode = apiErr.StatusCode
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	afterEndpoint := req.Endpoint
+	if err == nil && entry.ResourceID == "" {
+		entry.ResourceID = responseID(req.Out)
+		// A Classic API update by name may rename the object, so it is fetched again by id.
+		if entry.ResourceID != "" && entry.ResourceType != "" && strings.HasPrefix(req.Endpoint, "/JSSResource/") {
+			afterEndpoint = "/JSSResource/" + entry.ResourceType + "/id/" + entry.ResourceID
+		}
+	}
+	if err == nil && snapshot && req.Method != http.MethodDelete {
+		after, err := client.auditSnapshot(afterEndpoint)
+		entry.After = after
+		snapshotErrs = append(snapshotErrs, err)
+	}
+	if err := errors.Join(snapshotErrs...); err != nil {
+		entry.SnapshotError = err.Error()
+	}
+
+	if err := c.audit.WriteAuditEntry(entry); err != nil {
+		c.Logger().Error("Failed to write audit entry", "operation", entry.Operation, "endpoint", entry.Endpoint, "error", err)
+	}
+	return resp, err
+}
+
+// xmlSnapshot captures a Classic API object as raw XML.
+type xmlSnapshot struct {
+	XMLName xml.Name
+	Inner   string `xml:",innerxml"`
+}
+
+// auditSnapshot fetches the object at endpoint with send, bypassing middleware and the audit journal.
+func (c *Client) auditSnapshot(endpoint string) (json.RawMessage, error) {
+	get := func(out interface{}) error {
+		resp, err := c.send(&Request{Context: c.Context(), Method: http.MethodGet, Endpoint: endpoint, Out: out})
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch snapshot of %s: %w", endpoint, err)
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(endpoint, "/JSSResource/") {
+		var snapshot xmlSnapshot
+		if err := get(&snapshot); err != nil {
+			return nil, err
+		}
+		name := snapshot.XMLName.Local
+		data, err := marshalAuditJSON("<" + name + ">" + snapshot.Inner + "</" + name + ">")
+		return bytes.TrimSuffix(data, []byte("\n")), err
+	}
+
+	var snapshot json.RawMessage
+	if err := get(&snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// auditResource returns the resource type, id and name addressed by endpoint. Classic API lookups by
+// anything other than id, such as serial number, are reported as the id.
+func auditResource(endpoint string) (resourceType, id, name string) {
+	path, _, _ := strings.Cut(endpoint, "?")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if segments[0] == "JSSResource" {
+		if len(segments) > 1 {
+			resourceType = segments[1]
+		}
+		for i := 2; i+1 < len(segments); i++ {
+			if !classicLookupKeys[segments[i]] {
+				continue
+			}
+			value, err := url.PathUnescape(segments[i+1])
+			if err != nil {
+				value = segments[i+1]
+			}
+			if segments[i] == "name" {
+				name = value
+			} else {
+				id = value
+			}
+			break
+		}
+		return resourceType, id, name
+	}
+
+	// Jamf Pro API endpoints are /api/<version>/<resource>/...
+	if len(segments) > 3 && segments[0] == "api" {
+		resourceType = segments[2]
+		_, id, _ = endpointTemplate(strings.Join(segments[3:], "/"))
+		return resourceType, id, ""
+	}
+	if len(segments) > 2 && segments[0] == "api" {
+		resourceType = segments[2]
+	}
+	return resourceType, "", ""
+}
+
+// responseID returns the id field of a create response, or "".
+func responseID(out interface{}) string {
+	value := reflect.ValueOf(out)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+
+	field := value.FieldByName("ID")
+	if !field.IsValid() || field.IsZero() {
+		return ""
+	}
+	return fmt.Sprint(field.Interface())
+}
@@ -0,0 +1,133 @@
+// api_client_audit_test.go
+package jamfpro_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+func TestAuditLog(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	groupID, err := srv.AddResource("/JSSResource/computergroups", jamfpro.ResourceComputerGroup{Name: "All Macs"})
+	if err != nil {
+		t.Fatalf("AddResource() returned error: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithAuditLog(path))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	client = client.WithContext(jamfpro.AuditContext(context.Background(), "pipeline/buildings", "CHG-1234"))
+
+	created, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head Office"})
+	if err != nil {
+		t.Fatalf("CreateBuilding() returned error: %v", err)
+	}
+	if _, err := client.UpdateBuildingByID(created.ID, &jamfpro.ResourceBuilding{Name: "Headquarters"}); err != nil {
+		t.Fatalf("UpdateBuildingByID() returned error: %v", err)
+	}
+	if _, err := client.GetBuildingByID(created.ID); err != nil {
+		t.Fatalf("GetBuildingByID() returned error: %v", err)
+	}
+	if err := client.DeleteBuildingByID(created.ID); err != nil {
+		t.Fatalf("DeleteBuildingByID() returned error: %v", err)
+	}
+	if _, err := client.UpdateComputerGroupByName("All Macs", &jamfpro.ResourceComputerGroup{Name: "Every Mac"}); err != nil {
+		t.Fatalf("UpdateComputerGroupByName() returned error: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open audit log: %v", err)
+	}
+	defer file.Close()
+
+	var entries []jamfpro.AuditEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry jamfpro.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid audit line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	tests := []struct {
+		operation    string
+		method       string
+		resourceType string
+		resourceID   string
+		resourceName string
+		before       string
+		after        string
+	}{
+		{"jamfpro.CreateBuilding", "POST", "buildings", created.ID, "", "", ""},
+		{"jamfpro.UpdateBuildingByID", "PUT", "buildings", created.ID, "", "Head Office", "Headquarters"},
+		{"jamfpro.DeleteBuildingByID", "DELETE", "buildings", created.ID, "", "Headquarters", ""},
+		{"jamfpro.UpdateComputerGroupByName", "PUT", "computergroups", groupID, "All Macs", "<name>All Macs</name>", "<name>Every Mac</name>"},
+	}
+
+	if len(entries) != len(tests) {
+		t.Fatalf("audit log has %d entries, want %d: %+v", len(entries), len(tests), entries)
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			entry := entries[i]
+			if entry.Operation != tt.operation || entry.Method != tt.method || entry.ResourceType != tt.resourceType ||
+				entry.ResourceID != tt.resourceID || entry.ResourceName != tt.resourceName {
+				t.Errorf("entry = %s %s %s id=%q name=%q, want %s %s %s id=%q name=%q",
+					entry.Operation, entry.Method, entry.ResourceType, entry.ResourceID, entry.ResourceName,
+					tt.operation, tt.method, tt.resourceType, tt.resourceID, tt.resourceName)
+			}
+			if entry.Actor != "pipeline/buildings" || entry.Reason != "CHG-1234" {
+				t.Errorf("actor, reason = %q, %q", entry.Actor, entry.Reason)
+			}
+			if entry.Time.IsZero() || entry.Error != "" || entry.SnapshotError != "" {
+				t.Errorf("time = %v, error = %q, snapshot error = %q", entry.Time, entry.Error, entry.SnapshotError)
+			}
+			if !strings.Contains(string(entry.Before), tt.before) || (tt.before == "") != (entry.Before == nil) {
+				t.Errorf("before = %s, want it to contain %q", entry.Before, tt.before)
+			}
+			if !strings.Contains(string(entry.After), tt.after) || (tt.after == "") != (entry.After == nil) {
+				t.Errorf("after = %s, want it to contain %q", entry.After, tt.after)
+			}
+		})
+	}
+}
+
+func TestAuditSinkRecordsFailures(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	var entries []jamfpro.AuditEntry
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithAuditSink(jamfpro.AuditSinkFunc(func(entry jamfpro.AuditEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if err := client.DeleteBuildingByID("404"); err == nil {
+		t.Fatal("DeleteBuildingByID() of a missing building returned no error")
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(entries))
+	}
+	if entries[0].Error == "" || entries[0].StatusCode != 404 || entries[0].SnapshotError == "" {
+		t.Errorf("entry = %+v, want a 404 with its error and snapshot error", entries[0])
+	}
+}
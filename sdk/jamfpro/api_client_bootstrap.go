@@ -0,0 +1,209 @@
+// api_client_bootstrap.go
+// Provisioning of a least-privilege API role, API integration and client credentials, returning a
+// ready OAuth2 client configuration.
+package jamfpro
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BootstrapOptions configures BootstrapOAuthClient.
+type BootstrapOptions struct {
+	// RoleName is the display name of the API role to create or reuse.
+	RoleName string
+	// Privileges are the privileges granted by the role. Each must be listed by GetJamfAPIPrivileges.
+	Privileges []string
+	// IntegrationName is the display name of the API integration to create or reuse.
+	IntegrationName string
+	// AccessTokenLifetime is the lifetime of tokens issued to the integration. Zero keeps the lifetime
+	// of an existing integration, or the Jamf Pro default for a new one.
+	AccessTokenLifetime time.Duration
+	// Existing is the configuration returned by an earlier run. Its client secret is kept when it
+	// belongs to the integration and can still obtain a token, so that running the bootstrap again does
+	// not invalidate credentials which are already deployed.
+	Existing *ConfigContainer
+}
+
+// BootstrapResult describes the outcome of BootstrapOAuthClient.
+type BootstrapResult struct {
+	// Config is the admin configuration switched to OAuth2 with the integration's client credentials.
+	Config *ConfigContainer
+
+	Role        *ResourceAPIRole
+	Integration *ResourceApiIntegration
+
+	// RoleChanged and IntegrationChanged report whether the role and integration were created or
+	// updated, and CredentialsGenerated whether new client credentials were generated.
+	RoleChanged          bool
+	IntegrationChanged   bool
+	CredentialsGenerated bool
+}
+
+// BootstrapOAuthClient logs in with adminConfig, usually basic auth admin credentials, and provisions
+// an API client limited to opts.Privileges: it creates or updates the API role, creates or updates the
+// API integration so that it is enabled and scoped to that role alone, and generates client
+// credentials. Running it again with the same options converges the role and integration on the
+// requested state and, when opts.Existing still works, keeps its credentials.
+//
+// Every privilege is checked against GetJamfAPIPrivileges before anything is changed.
+func BootstrapOAuthClient(adminConfig *ConfigContainer, opts BootstrapOptions) (*BootstrapResult, error) {
+	if opts.RoleName == "" || opts.IntegrationName == "" || len(opts.Privileges) == 0 {
+		return nil, errors.New("bootstrap requires a role name, an integration name and at least one privilege")
+	}
+
+	admin, err := BuildClient(adminConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in with the admin configuration: %w", err)
+	}
+
+	if err := admin.checkPrivileges(opts.Privileges); err != nil {
+		return nil, err
+	}
+
+	result := &BootstrapResult{}
+
+	result.Role, result.RoleChanged, err = admin.ensureApiRole(opts.RoleName, opts.Privileges)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Integration, result.IntegrationChanged, err = admin.ensureApiIntegration(opts.IntegrationName, opts.RoleName, opts.AccessTokenLifetime)
+	if err != nil {
+		return nil, err
+	}
+
+	config := *adminConfig
+	config.AuthMethod = AuthMethodOAuth2
+	config.Username = ""
+	config.Password = ""
+	config.Integration = nil
+	config.Credentials = nil
+	config.CredentialSource = nil
+
+	if existing := opts.Existing; existing != nil && existing.ClientID != "" && existing.ClientID == result.Integration.ClientID {
+		config.ClientID, config.ClientSecret = existing.ClientID, existing.ClientSecret
+		if _, err := BuildClient(&config); err == nil {
+			result.Config = &config
+			return result, nil
+		}
+	}
+
+	credentials, err := admin.RefreshClientCredentialsByApiRoleID(strconv.Itoa(result.Integration.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	config.ClientID, config.ClientSecret = credentials.ClientID, credentials.ClientSecret
+	result.Integration.ClientID = credentials.ClientID
+	result.CredentialsGenerated = true
+	result.Config = &config
+	return result, nil
+}
+
+// checkPrivileges returns an error listing every privilege which Jamf Pro does not offer.
+func (c *Client) checkPrivileges(privileges []string) error {
+	available, err := c.GetJamfAPIPrivileges()
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	for _, privilege := range privileges {
+		if !slices.Contains(available.Privileges, privilege) {
+			unknown = append(unknown, privilege)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown api role privileges: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// ensureApiRole creates the named role, or updates it when its privileges differ, reporting whether
+// anything changed.
+func (c *Client) ensureApiRole(name string, privileges []string) (*ResourceAPIRole, bool, error) {
+	want := &ResourceAPIRole{DisplayName: name, Privileges: privileges}
+
+	role, err := c.GetJamfApiRoleByName(name)
+	if errors.Is(err, ErrNotFound) {
+		role, err = c.CreateJamfApiRole(want)
+		if err != nil {
+			return nil, false, err
+		}
+		return role, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if sameStrings(role.Privileges, privileges) {
+		return role, false, nil
+	}
+
+	id := role.ID
+	role, err = c.UpdateJamfApiRoleByID(id, want)
+	if err != nil {
+		return nil, false, err
+	}
+	role.ID = id
+	return role, true, nil
+}
+
+// ensureApiIntegration creates the named integration, or updates it unless it is already enabled and
+// scoped to roleName alone, reporting whether anything changed.
+func (c *Client) ensureApiIntegration(name, roleName string, lifetime time.Duration) (*ResourceApiIntegration, bool, error) {
+	integration, err := c.GetApiIntegrationByName(name)
+	if errors.Is(err, ErrNotFound) {
+		integration, err = c.CreateApiIntegration(&ResourceApiIntegration{
+			DisplayName:                name,
+			AuthorizationScopes:        []string{roleName},
+			Enabled:                    true,
+			AccessTokenLifetimeSeconds: int(lifetime / time.Second),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		return integration, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	lifetimeSeconds := integration.AccessTokenLifetimeSeconds
+	if lifetime > 0 {
+		lifetimeSeconds = int(lifetime / time.Second)
+	}
+
+	if integration.Enabled && sameStrings(integration.AuthorizationScopes, []string{roleName}) &&
+		integration.AccessTokenLifetimeSeconds == lifetimeSeconds {
+		return integration, false, nil
+	}
+
+	update := *integration
+	update.AuthorizationScopes = []string{roleName}
+	update.Enabled = true
+	update.AccessTokenLifetimeSeconds = lifetimeSeconds
+
+	updated, err := c.UpdateApiIntegrationByID(strconv.Itoa(integration.ID), &update)
+	if err != nil {
+		return nil, false, err
+	}
+	if updated.ID == 0 {
+		updated = &update
+	}
+	return updated, true, nil
+}
+
+// sameStrings reports whether a and b hold the same strings, ignoring order and duplicates.
+func sameStrings(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
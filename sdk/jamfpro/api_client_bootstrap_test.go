@@ -0,0 +1,102 @@
+// api_client_bootstrap_test.go
+package jamfpro_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+func TestBootstrapOAuthClient(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	admin := srv.Config()
+	admin.AuthMethod = jamfpro.AuthMethodBasic
+	admin.ClientID, admin.ClientSecret = "", ""
+	admin.Username, admin.Password = srv.Username, srv.Password
+
+	opts := jamfpro.BootstrapOptions{
+		RoleName:        "Terraform",
+		Privileges:      []string{"Read Buildings", "Create Buildings"},
+		IntegrationName: "Terraform Provider",
+	}
+
+	first, err := jamfpro.BootstrapOAuthClient(admin, opts)
+	if err != nil {
+		t.Fatalf("BootstrapOAuthClient() returned error: %v", err)
+	}
+	if !first.RoleChanged || !first.IntegrationChanged || !first.CredentialsGenerated {
+		t.Errorf("first BootstrapOAuthClient() = %+v, want everything created", first)
+	}
+	if first.Config.AuthMethod != jamfpro.AuthMethodOAuth2 || first.Config.Username != "" || first.Config.Password != "" {
+		t.Errorf("BootstrapOAuthClient() config = %+v, want OAuth2 without the admin credentials", first.Config)
+	}
+
+	client, err := jamfpro.BuildClient(first.Config)
+	if err != nil {
+		t.Fatalf("BuildClient() with the bootstrapped config returned error: %v", err)
+	}
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() with the bootstrapped config returned error: %v", err)
+	}
+
+	// Running again with the earlier result changes nothing and keeps the deployed secret.
+	opts.Existing = first.Config
+	second, err := jamfpro.BootstrapOAuthClient(admin, opts)
+	if err != nil {
+		t.Fatalf("second BootstrapOAuthClient() returned error: %v", err)
+	}
+	if second.RoleChanged || second.IntegrationChanged || second.CredentialsGenerated {
+		t.Errorf("second BootstrapOAuthClient() = %+v, want nothing changed", second)
+	}
+	if second.Config.ClientSecret != first.Config.ClientSecret {
+		t.Error("second BootstrapOAuthClient() replaced a working client secret")
+	}
+	if srv.Len("/api/v1/api-roles") != 1 || srv.Len("/api/v1/api-integrations") != 1 {
+		t.Errorf("server has %d roles and %d integrations, want 1 of each",
+			srv.Len("/api/v1/api-roles"), srv.Len("/api/v1/api-integrations"))
+	}
+
+	// Changing the privileges updates the role in place.
+	opts.Privileges = []string{"Read Buildings"}
+	third, err := jamfpro.BootstrapOAuthClient(admin, opts)
+	if err != nil {
+		t.Fatalf("third BootstrapOAuthClient() returned error: %v", err)
+	}
+	if !third.RoleChanged || third.IntegrationChanged || third.CredentialsGenerated {
+		t.Errorf("third BootstrapOAuthClient() = %+v, want only the role changed", third)
+	}
+
+	// Without the earlier result new credentials are generated, which replace the old secret.
+	opts.Existing = nil
+	fourth, err := jamfpro.BootstrapOAuthClient(admin, opts)
+	if err != nil {
+		t.Fatalf("fourth BootstrapOAuthClient() returned error: %v", err)
+	}
+	if !fourth.CredentialsGenerated || fourth.Config.ClientID != first.Config.ClientID {
+		t.Errorf("fourth BootstrapOAuthClient() = %+v, want new credentials for the same client id", fourth)
+	}
+	if _, err := jamfpro.BuildClient(first.Config); err == nil {
+		t.Error("BuildClient() with the replaced client secret returned no error")
+	}
+}
+
+func TestBootstrapOAuthClientUnknownPrivilege(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	_, err := jamfpro.BootstrapOAuthClient(srv.Config(), jamfpro.BootstrapOptions{
+		RoleName:        "Reporting",
+		Privileges:      []string{"Read Computers", "Read Everything"},
+		IntegrationName: "Reporting",
+	})
+	if err == nil || !strings.Contains(err.Error(), "Read Everything") {
+		t.Errorf("BootstrapOAuthClient() error = %v, want the unknown privilege listed", err)
+	}
+	if got := srv.Len("/api/v1/api-roles"); got != 0 {
+		t.Errorf("server has %d roles after a failed bootstrap, want 0", got)
+	}
+}
@@ -1,16 +1,20 @@
 package jamfpro
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -18,6 +22,40 @@ const jamfLoadBalancerCookieName = "jpro-ingress"
 
 type Client struct {
 	HTTP *httpclient.Client
+
+	// executor sends the requests built by execute. It is the executor of HTTP, so that both share
+	// cookies and the redirect policy.
+	executor httpclient.HTTPExecutor
+
+	// ctx is the context requests are bound to, set via WithContext.
+	ctx context.Context
+
+	// maxConcurrentRequests is the concurrency budget used by parallel pagination.
+	maxConcurrentRequests int
+
+	// parallelPagination enables parallel page prefetching for every paginated list method.
+	parallelPagination bool
+
+	// config is a copy of the configuration the client was built from.
+	config ConfigContainer
+
+	// credentials is the integration built from the configuration, nil when a custom Integration was supplied.
+	credentials *credentialIntegration
+
+	// logger receives the SDK's own structured output.
+	logger *slog.Logger
+
+	// telemetry records spans and metrics, nil unless a tracer or meter provider was configured.
+	telemetry *telemetry
+
+	// middleware wraps every request, see Use.
+	middleware []Middleware
+
+	// plan records mutating requests instead of sending them, nil unless DryRun was configured.
+	plan *Plan
+
+	// audit receives an entry for every mutating request, nil unless auditing was configured.
+	audit AuditSink
 }
 
 type ConfigContainer struct {
@@ -33,6 +71,10 @@ type ConfigContainer struct {
 	Password             string `json:"basic_auth_password"`
 	JamfLoadBalancerLock bool   `json:"jamf_load_balancer_lock"`
 
+	// CredentialSource reads the client secret or password from an environment variable, a file or a
+	// helper command instead of ClientSecret or Password.
+	CredentialSource *CredentialSource `json:"credential_source,omitempty"`
+
 	CustomCookies               []CustomCookie `json:"custom_cookies"`
 	MaxRetryAttempts            int            `json:"max_retry_attempts"`
 	MaxConcurrentRequests       int            `json:"max_concurrent_requests"`
@@ -40,11 +82,46 @@ type ConfigContainer struct {
 	CustomTimeout               int            `json:"custom_timeout_seconds"`
 	TokenRefreshBufferPeriod    int            `json:"token_refresh_buffer_period_seconds"`
 	TotalRetryDuration          int            `json:"total_retry_duration_seconds"`
-	FollowRedirects             bool           `json:"follow_redirects"`
+	FollowRedirects             *bool          `json:"follow_redirects,omitempty"`
 	MaxRedirects                int            `json:"max_redirects"`
 	EnableConcurrencyManagement bool           `json:"enable_concurrency_management"`
 	MandatoryRequestDelay       int            `json:"mandatory_request_delay_milliseconds"`
 	RetryEligiableRequests      bool           `json:"retry_eligiable_requests"`
+	EnableParallelPagination    bool           `json:"enable_parallel_pagination"`
+
+	// DryRun records every mutating request in the client's Plan instead of sending it.
+	DryRun bool `json:"dry_run"`
+
+	// AuditLogPath appends an AuditEntry for every mutating request to the file at the path, as JSON Lines.
+	AuditLogPath string `json:"audit_log_path"`
+
+	// Programmatic overrides which cannot be loaded from a file or the environment. When left nil
+	// BuildClient creates its own.
+
+	// HTTPClient is used for every request, including token requests, for example to configure
+	// proxies or mTLS. It is copied, so the caller's client is never modified.
+	HTTPClient *http.Client `json:"-"`
+	// Transport replaces the transport of HTTPClient, for example to record or replay traffic.
+	Transport http.RoundTripper `json:"-"`
+	// Logger is used instead of building a logger from LogLevel and LogExportPath.
+	Logger *zap.SugaredLogger `json:"-"`
+	// SlogLogger receives all output, including the HTTP client's, unless Logger is also set, in which
+	// case the HTTP client logs to Logger.
+	SlogLogger *slog.Logger `json:"-"`
+	// Integration is used instead of building a Jamf Pro integration from AuthMethod and the credentials.
+	Integration httpclient.APIIntegration `json:"-"`
+	// Credentials supplies the client secret or password instead of ClientSecret or Password, and may
+	// also supply the client id or username.
+	Credentials CredentialProvider `json:"-"`
+	// TracerProvider and MeterProvider enable OpenTelemetry spans and metrics for every request. Either
+	// may be set alone.
+	TracerProvider trace.TracerProvider `json:"-"`
+	MeterProvider  metric.MeterProvider `json:"-"`
+	// AuditSink receives an AuditEntry for every mutating request, instead of AuditLogPath.
+	AuditSink AuditSink `json:"-"`
+
+	// optionProblems are the problems found by options passed to New, reported by Validate.
+	optionProblems []string
 }
 
 type CustomCookie struct {
@@ -53,28 +130,23 @@ type CustomCookie struct {
 }
 
 func BuildClient(config *ConfigContainer) (*Client, error) {
-	var err error
-
-	DefaultLoggerConfig := zap.NewProductionConfig()
-	DefaultLoggerConfig.Level, err = LogLevelStringtoZap(config.LogLevel)
-	if err != nil {
-		return nil, fmt.Errorf("failed to set log level: %v", err)
-	}
-
-	if config.LogExportPath != "" {
-		DefaultLoggerConfig.OutputPaths = append(DefaultLoggerConfig.OutputPaths, config.LogExportPath)
+	if err := config.Validate(); err != nil {
+		return nil, err
 	}
 
-	logger, err := DefaultLoggerConfig.Build()
+	Sugar, err := buildLogger(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build logger: %v", err)
+		return nil, err
 	}
 
-	Sugar := logger.Sugar()
-
-	integration, err := initializeAPIIntegration(config, Sugar)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize integration: %w", err)
+	var credentials *credentialIntegration
+	integration := config.Integration
+	if integration == nil {
+		credentials, err = initializeAPIIntegration(config, Sugar, &httpclient.ProdExecutor{Client: buildHTTPClient(config)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize integration: %w", err)
+		}
+		integration = credentials
 	}
 
 	customCookies, err := handleLoadBalancerLock(config, integration, convertCustomCookies(config.CustomCookies), Sugar)
@@ -94,20 +166,43 @@ func BuildClient(config *ConfigContainer) (*Client, error) {
 		TokenRefreshBufferPeriod:    time.Duration(config.TokenRefreshBufferPeriod) * time.Second,
 		TotalRetryDuration:          time.Duration(config.TotalRetryDuration) * time.Second,
 		MaxRedirects:                config.MaxRedirects,
+		CustomRedirectPolicy:        redirectPolicy(config),
 		EnableConcurrencyManagement: config.EnableConcurrencyManagement,
 		MandatoryRequestDelay:       time.Duration(config.MandatoryRequestDelay) * time.Millisecond,
 		RetryEligiableRequests:      config.RetryEligiableRequests,
 	}
 
-	httpClientConfig.HTTPExecutor = &httpclient.ProdExecutor{Client: &http.Client{}}
+	telemetry, err := newTelemetry(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize telemetry: %w", err)
+	}
+
+	executor := newProdExecutor(buildHTTPClient(config))
+	httpClientConfig.HTTPExecutor = executor
 
 	httpClient, err := httpClientConfig.Build()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build HTTP client: %w", err)
 	}
 
+	var plan *Plan
+	if config.DryRun {
+		plan = &Plan{}
+	}
+
 	// Wrap into SDK & return
-	return &Client{HTTP: httpClient}, nil
+	return &Client{
+		HTTP:                  httpClient,
+		executor:              executor,
+		maxConcurrentRequests: config.MaxConcurrentRequests,
+		parallelPagination:    config.EnableParallelPagination,
+		config:                *config,
+		credentials:           credentials,
+		logger:                buildSlogLogger(config, Sugar),
+		telemetry:             telemetry,
+		plan:                  plan,
+		audit:                 newAuditSink(config),
+	}, nil
 }
 
 // BuildClientWithConfigFile initializes a new Jamf Pro client using a configuration file for the HTTP client, logger, and integration.
@@ -131,37 +226,79 @@ func BuildClientWithEnv() (*Client, error) {
 
 }
 
-// initializeAPIIntegration initializes the API integration based on the configuration
-func initializeAPIIntegration(config *ConfigContainer, Sugar *zap.SugaredLogger) (httpclient.APIIntegration, error) {
-	var integration *jamfprointegration.Integration
+// buildLogger returns the configured logger, a logger writing to the configured slog logger, or builds
+// one from the log level and export path.
+func buildLogger(config *ConfigContainer) (*zap.SugaredLogger, error) {
+	if config.Logger != nil {
+		return config.Logger, nil
+	}
+
+	if config.SlogLogger != nil {
+		return zap.New(newSlogCore(config.SlogLogger.Handler())).Sugar(), nil
+	}
+
 	var err error
+	DefaultLoggerConfig := zap.NewProductionConfig()
+	DefaultLoggerConfig.Level, err = LogLevelStringtoZap(config.LogLevel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set log level: %w", err)
+	}
 
-	prodExecutor := &httpclient.ProdExecutor{Client: &http.Client{}}
-	switch config.AuthMethod {
-	case "oauth2":
-		integration, err = jamfprointegration.BuildWithOAuth(
-			config.InstanceDomain,
-			Sugar,
-			time.Duration(config.TokenRefreshBufferPeriod)*time.Second,
-			config.ClientID,
-			config.ClientSecret,
-			config.HideSensitiveData,
-			prodExecutor,
-		)
-	case "basic":
-		integration, err = jamfprointegration.BuildWithBasicAuth(
-			config.InstanceDomain,
-			Sugar,
-			time.Duration(config.TokenRefreshBufferPeriod)*time.Second,
-			config.Username,
-			config.Password,
-			config.HideSensitiveData,
-			prodExecutor,
-		)
-	default:
-		return nil, fmt.Errorf("invalid auth method supplied")
+	if config.LogExportPath != "" {
+		DefaultLoggerConfig.OutputPaths = append(DefaultLoggerConfig.OutputPaths, config.LogExportPath)
 	}
 
+	logger, err := DefaultLoggerConfig.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build logger: %w", err)
+	}
+
+	return logger.Sugar(), nil
+}
+
+// redirectPolicy returns the redirect policy for FollowRedirects and MaxRedirects, or nil to keep the
+// default of net/http when neither is set. When redirects are not followed the redirect response
+// itself is returned.
+func redirectPolicy(config *ConfigContainer) *func(req *http.Request, via []*http.Request) error {
+	if config.FollowRedirects == nil && config.MaxRedirects == 0 {
+		return nil
+	}
+
+	follow := config.FollowRedirects == nil || *config.FollowRedirects
+	maxRedirects := config.MaxRedirects
+	policy := func(req *http.Request, via []*http.Request) error {
+		if !follow {
+			return http.ErrUseLastResponse
+		}
+		if maxRedirects > 0 && len(via) > maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		return nil
+	}
+	return &policy
+}
+
+// buildHTTPClient returns a copy of the configured http client, or a new one, using the configured
+// transport when one is given. A fresh copy is returned on every call because the integration and
+// the http client each set their own cookie jar and redirect policy.
+func buildHTTPClient(config *ConfigContainer) *http.Client {
+	client := &http.Client{}
+	if config.HTTPClient != nil {
+		copied := *config.HTTPClient
+		client = &copied
+	}
+
+	if config.Transport != nil {
+		client.Transport = config.Transport
+	}
+
+	return client
+}
+
+// initializeAPIIntegration initializes the API integration based on the configuration, using the
+// configured credential provider when there is one.
+func initializeAPIIntegration(config *ConfigContainer, Sugar *zap.SugaredLogger, prodExecutor *httpclient.ProdExecutor) (*credentialIntegration, error) {
+	integration, err := newCredentialIntegration(context.Background(), config, Sugar, prodExecutor)
 	if err != nil {
 		return nil, err
 	}
@@ -173,19 +310,19 @@ func initializeAPIIntegration(config *ConfigContainer, Sugar *zap.SugaredLogger)
 func loadConfigFromJSONFile(configFilePath string) (*ConfigContainer, error) {
 	file, err := os.Open(configFilePath)
 	if err != nil {
-		return nil, fmt.Errorf("could not open file: %v", err)
+		return nil, fmt.Errorf("could not open file: %w", err)
 	}
 	defer file.Close()
 
 	byteValue, err := io.ReadAll(file)
 	if err != nil {
-		return nil, fmt.Errorf("could not read file: %v", err)
+		return nil, fmt.Errorf("could not read file: %w", err)
 	}
 
 	var config ConfigContainer
 	err = json.Unmarshal(byteValue, &config)
 	if err != nil {
-		return nil, fmt.Errorf("could not unmarshal JSON: %v", err)
+		return nil, fmt.Errorf("could not unmarshal JSON: %w", err)
 	}
 
 	return &config, nil
@@ -194,7 +331,7 @@ func loadConfigFromJSONFile(configFilePath string) (*ConfigContainer, error) {
 // loadConfigFromEnv loads the configuration from environment variables
 func loadConfigFromEnv() (*ConfigContainer, error) {
 	config := &ConfigContainer{
-		LogLevel: getEnv("LOG_LEVEL", "warning"),
+		LogLevel: getEnv("LOG_LEVEL", "warn"),
 		// ExportLogs:                  getEnvAsBool("EXPORT_LOGS", false),
 		HideSensitiveData:           getEnvAsBool("HIDE_SENSITIVE_DATA", true),
 		InstanceDomain:              getEnv("INSTANCE_DOMAIN", ""),
@@ -210,28 +347,43 @@ func loadConfigFromEnv() (*ConfigContainer, error) {
 		TokenRefreshBufferPeriod:    getEnvAsInt("TOKEN_REFRESH_BUFFER_PERIOD_SECONDS", 300),
 		TotalRetryDuration:          getEnvAsInt("TOTAL_RETRY_DURATION_SECONDS", 60),
 		CustomTimeout:               getEnvAsInt("CUSTOM_TIMEOUT_SECONDS", 60),
-		FollowRedirects:             getEnvAsBool("FOLLOW_REDIRECTS", true),
+		FollowRedirects:             BoolPtr(getEnvAsBool("FOLLOW_REDIRECTS", true)),
 		MaxRedirects:                getEnvAsInt("MAX_REDIRECTS", 5),
 		EnableConcurrencyManagement: getEnvAsBool("ENABLE_CONCURRENCY_MANAGEMENT", true),
-		CustomCookies:               convertCustomCookiesFromEnv(getEnv("CUSTOM_COOKIES", "")),
 		MandatoryRequestDelay:       getEnvAsInt("MANDATORY_REQUEST_DELAY_MILLISECONDS", 0),
 		RetryEligiableRequests:      getEnvAsBool("RETRY_ELIGIABLE_REQUESTS", true),
+		EnableParallelPagination:    getEnvAsBool("ENABLE_PARALLEL_PAGINATION", false),
+		DryRun:                      getEnvAsBool("DRY_RUN", false),
+		AuditLogPath:                getEnv("AUDIT_LOG_PATH", ""),
+	}
+
+	customCookies, err := convertCustomCookiesFromEnv(getEnv("CUSTOM_COOKIES", ""))
+	if err != nil {
+		return nil, err
 	}
+	config.CustomCookies = customCookies
+
+	// The secret can be read from a file or a helper command instead of CLIENT_SECRET or BASIC_AUTH_PASSWORD
+	if path := getEnv("CREDENTIALS_FILE", ""); path != "" {
+		config.CredentialSource = &CredentialSource{File: path}
+	} else if command := strings.Fields(getEnv("CREDENTIALS_COMMAND", "")); len(command) > 0 {
+		config.CredentialSource = &CredentialSource{Command: command[0], Args: command[1:]}
+	}
+
 	return config, nil
 }
 
 // convertCustomCookiesFromEnv converts environment variable string to custom cookie configuration
-func convertCustomCookiesFromEnv(customCookiesStr string) []CustomCookie {
+func convertCustomCookiesFromEnv(customCookiesStr string) ([]CustomCookie, error) {
 	var customCookies []CustomCookie
 	if customCookiesStr == "" {
-		return customCookies
+		return customCookies, nil
 	}
 	err := json.Unmarshal([]byte(customCookiesStr), &customCookies)
 	if err != nil {
-		fmt.Printf("Error parsing custom cookies from environment: %v\n", err)
-		return nil
+		return nil, fmt.Errorf("failed to parse CUSTOM_COOKIES: %w", err)
 	}
-	return customCookies
+	return customCookies, nil
 }
 
 // getEnv gets the environment variable or returns a default value
@@ -281,14 +433,20 @@ func convertCustomCookies(customCookies []CustomCookie) []*http.Cookie {
 	return cookies
 }
 
+// sessionCookieIntegration is an integration which can report the session cookies of the load
+// balancer, as jamfprointegration.Integration and credentialIntegration do.
+type sessionCookieIntegration interface {
+	GetSessionCookies() ([]*http.Cookie, error)
+}
+
 // handleLoadBalancerLock handles the load balancer lock by adding appropriate cookies if enabled
 func handleLoadBalancerLock(config *ConfigContainer, integration httpclient.APIIntegration, customCookies []*http.Cookie, Sugar *zap.SugaredLogger) ([]*http.Cookie, error) {
 	if config.JamfLoadBalancerLock {
-		jamfIntegration, ok := integration.(*jamfprointegration.Integration)
+		sessionIntegration, ok := integration.(sessionCookieIntegration)
 		if !ok {
-			return nil, fmt.Errorf("integration is not of type *jamfprointegration.Integration")
+			return nil, fmt.Errorf("integration %T does not provide session cookies for the load balancer lock", integration)
 		}
-		cookies, err := jamfIntegration.GetSessionCookies()
+		cookies, err := sessionIntegration.GetSessionCookies()
 		if err != nil {
 			Sugar.Error("Failed to get session cookies for load balancer lock", zap.Error(err))
 			return customCookies, nil
@@ -0,0 +1,144 @@
+// api_client_builders_test.go
+package jamfpro_test
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+	"go.uber.org/zap"
+)
+
+// countingTransport counts token and API requests before passing them on.
+type countingTransport struct {
+	tokenRequests atomic.Int32
+	apiRequests   atomic.Int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasPrefix(req.URL.Path, "/api/oauth/") {
+		t.tokenRequests.Add(1)
+	} else {
+		t.apiRequests.Add(1)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestBuildClientCustomTransport(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	transport := &countingTransport{}
+	callerClient := &http.Client{}
+
+	config := srv.Config()
+	config.LogLevel = ""
+	config.Logger = zap.NewNop().Sugar()
+	config.HTTPClient = callerClient
+	config.Transport = transport
+
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Fatalf("GetBuildings() returned error: %v", err)
+	}
+
+	if transport.tokenRequests.Load() == 0 || transport.apiRequests.Load() == 0 {
+		t.Errorf("transport saw %d token and %d API requests, want both to use the custom transport",
+			transport.tokenRequests.Load(), transport.apiRequests.Load())
+	}
+
+	if callerClient.Transport != nil || callerClient.Jar != nil {
+		t.Error("BuildClient() modified the caller's http client")
+	}
+}
+
+func TestBuildClientCustomIntegration(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	logger := zap.NewNop().Sugar()
+	integration, err := jamfprointegration.BuildWithOAuth(srv.URL, logger, time.Minute, srv.ClientID, srv.ClientSecret, true,
+		&httpclient.ProdExecutor{Client: &http.Client{}})
+	if err != nil {
+		t.Fatalf("BuildWithOAuth() returned error: %v", err)
+	}
+
+	client, err := jamfpro.BuildClient(&jamfpro.ConfigContainer{Logger: logger, Integration: integration})
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() returned error: %v", err)
+	}
+}
+
+// loadBalancerTransport answers API requests from two load balancer nodes in turn, and records the
+// load balancer cookie each request carries.
+type loadBalancerTransport struct {
+	responses atomic.Int32
+	cookies   []string
+	mu        sync.Mutex
+}
+
+func (t *loadBalancerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if cookie, err := req.Cookie("jpro-ingress"); err == nil {
+		t.mu.Lock()
+		t.cookies = append(t.cookies, cookie.Value)
+		t.mu.Unlock()
+	}
+
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err == nil && !strings.HasPrefix(req.URL.Path, "/api/oauth/") {
+		node := []string{"node-b", "node-a"}[t.responses.Add(1)%2]
+		resp.Header.Add("Set-Cookie", (&http.Cookie{Name: "jpro-ingress", Value: node}).String())
+	}
+	return resp, err
+}
+
+func TestBuildClientLoadBalancerLock(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	transport := &loadBalancerTransport{}
+	config := srv.Config()
+	config.LogLevel = ""
+	config.Logger = zap.NewNop().Sugar()
+	config.Transport = transport
+	config.JamfLoadBalancerLock = true
+
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() with the load balancer lock returned error: %v", err)
+	}
+
+	transport.mu.Lock()
+	transport.cookies = nil
+	transport.mu.Unlock()
+
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Fatalf("GetBuildings() returned error: %v", err)
+	}
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	if len(transport.cookies) == 0 {
+		t.Fatal("requests did not carry the load balancer cookie")
+	}
+	for _, cookie := range transport.cookies {
+		if cookie != "node-a" {
+			t.Errorf("request was sent to load balancer node %q, want node-a", cookie)
+		}
+	}
+}
@@ -0,0 +1,447 @@
+// api_client_credentials.go
+// Credential providers, which supply the client secret or password used to obtain tokens from an
+// external source instead of plaintext configuration, and pick up secrets rotated at the source.
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"go.uber.org/zap"
+)
+
+// Credentials are the identity and secret used to obtain tokens: a client id and secret for oauth2,
+// or a username and password for basic auth.
+type Credentials struct {
+	// ID is the client id or username. When empty, ClientID or Username from the configuration is used.
+	ID string `json:"id,omitempty"`
+	// Secret is the client secret or password.
+	Secret string `json:"secret"`
+	// ExpiresAt is when the provider should be asked for new credentials. Zero means they do not expire.
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// CredentialProvider supplies the credentials used to obtain tokens. A client calls it before its
+// requests, one call at a time, so implementations should cache and only go back to their source when
+// the credentials may have changed.
+// When the credentials returned differ from those in use, the next request obtains a new token with them.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialProviderFunc adapts a function to a CredentialProvider.
+type CredentialProviderFunc func(ctx context.Context) (Credentials, error)
+
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a provider which always supplies the same credentials.
+func StaticCredentials(id, secret string) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		return Credentials{ID: id, Secret: secret}, nil
+	})
+}
+
+// EnvCredentials returns a provider which reads the secret from the environment variable secretVar
+// on every call.
+func EnvCredentials(secretVar string) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		secret, ok := os.LookupEnv(secretVar)
+		if !ok || secret == "" {
+			return Credentials{}, fmt.Errorf("environment variable %s is not set", secretVar)
+		}
+		return Credentials{Secret: secret}, nil
+	})
+}
+
+// FileCredentialProvider reads the secret from a file, such as a mounted Kubernetes secret, and reads
+// it again whenever the file changes. The file holds either the secret alone, with surrounding
+// whitespace ignored, or a JSON object in the Credentials format.
+type FileCredentialProvider struct {
+	Path string
+
+	// CheckInterval is how often the file is checked for changes, so that it is not read on every
+	// request. Defaults to 5 seconds.
+	CheckInterval time.Duration
+
+	mu        sync.Mutex
+	modTime   time.Time
+	size      int64
+	cached    Credentials
+	checkedAt time.Time
+}
+
+// NewFileCredentialProvider returns a provider which reads the secret from path.
+func NewFileCredentialProvider(path string) *FileCredentialProvider {
+	return &FileCredentialProvider{Path: path}
+}
+
+func (p *FileCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	checkInterval := p.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = 5 * time.Second
+	}
+	if p.cached.Secret != "" && time.Since(p.checkedAt) < checkInterval {
+		return p.cached, nil
+	}
+
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+	if p.cached.Secret != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		p.checkedAt = time.Now()
+		return p.cached, nil
+	}
+
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	credentials, err := parseCredentials(data)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("invalid credentials file %s: %w", p.Path, err)
+	}
+
+	p.cached, p.modTime, p.size, p.checkedAt = credentials, info.ModTime(), info.Size(), time.Now()
+	return credentials, nil
+}
+
+// ExecCredentialProvider runs an external helper command which prints the credentials to stdout as a
+// JSON object in the Credentials format, in the style of kubectl exec credential plugins:
+//
+//	{"secret": "...", "expiresAt": "2024-01-01T00:00:00Z"}
+//
+// The credentials are cached until ExpiresAt, or for RefreshInterval when the helper gives no expiry.
+// The helper inherits the environment of the process, with Env added.
+type ExecCredentialProvider struct {
+	Command string
+	Args    []string
+	Env     []string
+
+	// Timeout limits how long the helper may run. Defaults to 30 seconds.
+	Timeout time.Duration
+	// RefreshInterval is how long credentials without an expiry are cached. Defaults to 5 minutes.
+	RefreshInterval time.Duration
+
+	mu          sync.Mutex
+	cached      Credentials
+	cachedUntil time.Time
+}
+
+// NewExecCredentialProvider returns a provider which runs command with args.
+func NewExecCredentialProvider(command string, args ...string) *ExecCredentialProvider {
+	return &ExecCredentialProvider{Command: command, Args: args}
+}
+
+func (p *ExecCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cached.Secret != "" && time.Now().Before(p.cachedUntil) {
+		return p.cached, nil
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Command, p.Args...)
+	cmd.Env = append(os.Environ(), p.Env...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return Credentials{}, fmt.Errorf("credential helper %s failed: %w: %s", p.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var credentials Credentials
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		return Credentials{}, fmt.Errorf("credential helper %s printed invalid JSON: %w", p.Command, err)
+	}
+	if credentials.Secret == "" {
+		return Credentials{}, fmt.Errorf("credential helper %s printed no secret", p.Command)
+	}
+
+	refreshInterval := p.RefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = 5 * time.Minute
+	}
+
+	p.cached = credentials
+	p.cachedUntil = time.Now().Add(refreshInterval)
+	if !credentials.ExpiresAt.IsZero() {
+		p.cachedUntil = credentials.ExpiresAt
+	}
+	return credentials, nil
+}
+
+// parseCredentials parses a JSON credentials object, or a bare secret.
+func parseCredentials(data []byte) (Credentials, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return Credentials{}, errors.New("no secret found")
+	}
+
+	if trimmed[0] != '{' {
+		return Credentials{Secret: string(trimmed)}, nil
+	}
+
+	var credentials Credentials
+	if err := json.Unmarshal(trimmed, &credentials); err != nil {
+		return Credentials{}, err
+	}
+	if credentials.Secret == "" {
+		return Credentials{}, errors.New("no secret found")
+	}
+	return credentials, nil
+}
+
+// CredentialSource configures a built-in credential provider from a configuration file. Exactly one
+// of Env, File and Command is set.
+type CredentialSource struct {
+	// Env is the name of an environment variable holding the secret.
+	Env string `json:"env,omitempty"`
+	// File is the path of a file holding the secret.
+	File string `json:"file,omitempty"`
+	// Command is a helper command printing the credentials as JSON, run with Args.
+	Command string   `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+}
+
+// provider returns the credential provider configured by the source. Helper commands are told which
+// instance and auth method the credentials are for through the JAMF_INSTANCE_DOMAIN and
+// JAMF_AUTH_METHOD environment variables.
+func (s *CredentialSource) provider(config *ConfigContainer) CredentialProvider {
+	switch {
+	case s.Env != "":
+		return EnvCredentials(s.Env)
+	case s.File != "":
+		return NewFileCredentialProvider(s.File)
+	default:
+		provider := NewExecCredentialProvider(s.Command, s.Args...)
+		provider.Env = []string{
+			"JAMF_INSTANCE_DOMAIN=" + config.InstanceDomain,
+			"JAMF_AUTH_METHOD=" + config.AuthMethod,
+		}
+		return provider
+	}
+}
+
+// credentialProvider returns the provider of the configuration's credentials: Credentials, then
+// CredentialSource, then the plaintext ClientID and ClientSecret, or Username and Password.
+func credentialProvider(config *ConfigContainer) CredentialProvider {
+	switch {
+	case config.Credentials != nil:
+		return config.Credentials
+	case config.CredentialSource != nil:
+		return config.CredentialSource.provider(config)
+	case config.AuthMethod == AuthMethodBasic:
+		return StaticCredentials(config.Username, config.Password)
+	default:
+		return StaticCredentials(config.ClientID, config.ClientSecret)
+	}
+}
+
+// credentialIntegration is the Jamf Pro integration used by clients built from a configuration. Before
+// each request it asks its provider for the current credentials and, when they have changed, replaces
+// the authentication of the wrapped integration so that the next token is obtained with them.
+type credentialIntegration struct {
+	provider          CredentialProvider
+	authMethod        string
+	defaultID         string
+	bufferPeriod      time.Duration
+	hideSensitiveData bool
+	executor          httpclient.HTTPExecutor
+	sugar             *zap.SugaredLogger
+
+	// refreshing lets a single request at a time ask the provider for credentials, which may run a
+	// helper command, while concurrent requests carry on with the current ones.
+	refreshing atomic.Bool
+
+	// mu guards the credentials in use and the wrapped integration, which is not safe for
+	// concurrent use.
+	mu      sync.Mutex
+	current Credentials
+	inner   *jamfprointegration.Integration
+
+	// replaced are the credentials replaced by switchCredentials. The provider may keep returning them
+	// until its source is updated, so they are ignored rather than switched back to.
+	replaced Credentials
+}
+
+// newCredentialIntegration obtains the initial credentials and builds the wrapped integration with
+// them, which also obtains the first token.
+func newCredentialIntegration(ctx context.Context, config *ConfigContainer, Sugar *zap.SugaredLogger, executor httpclient.HTTPExecutor) (*credentialIntegration, error) {
+	i := &credentialIntegration{
+		provider:          credentialProvider(config),
+		authMethod:        config.AuthMethod,
+		defaultID:         config.ClientID,
+		bufferPeriod:      time.Duration(config.TokenRefreshBufferPeriod) * time.Second,
+		hideSensitiveData: config.HideSensitiveData,
+		executor:          executor,
+		sugar:             Sugar,
+	}
+	if config.AuthMethod == AuthMethodBasic {
+		i.defaultID = config.Username
+	}
+
+	credentials, err := i.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch config.AuthMethod {
+	case AuthMethodOAuth2:
+		i.inner, err = jamfprointegration.BuildWithOAuth(config.InstanceDomain, Sugar, i.bufferPeriod, credentials.ID, credentials.Secret, i.hideSensitiveData, executor)
+	case AuthMethodBasic:
+		i.inner, err = jamfprointegration.BuildWithBasicAuth(config.InstanceDomain, Sugar, i.bufferPeriod, credentials.ID, credentials.Secret, i.hideSensitiveData, executor)
+	default:
+		return nil, fmt.Errorf("invalid auth method supplied")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	i.current = credentials
+	return i, nil
+}
+
+// credentials asks the provider for the current credentials, filling in the configured id.
+func (i *credentialIntegration) credentials(ctx context.Context) (Credentials, error) {
+	credentials, err := i.provider.Credentials(ctx)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to obtain credentials: %w", err)
+	}
+	if credentials.ID == "" {
+		credentials.ID = i.defaultID
+	}
+	if credentials.ID == "" || credentials.Secret == "" {
+		return Credentials{}, errors.New("failed to obtain credentials: credential provider returned an empty id or secret")
+	}
+	return credentials, nil
+}
+
+// refresh replaces the authentication of the wrapped integration when the provider's credentials have
+// changed. The provider is asked without holding i.mu, and while one request is asking, others skip
+// the refresh and use the current credentials. A provider error is logged and the current credentials
+// are kept, since their token may still be valid.
+func (i *credentialIntegration) refresh(ctx context.Context) {
+	if !i.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+	defer i.refreshing.Store(false)
+
+	credentials, err := i.credentials(ctx)
+	if err != nil {
+		i.sugar.Warnw("Keeping current credentials", "error", err)
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if credentials.ID == i.current.ID && credentials.Secret == i.current.Secret {
+		return
+	}
+	if credentials.ID == i.replaced.ID && credentials.Secret == i.replaced.Secret {
+		return
+	}
+
+	i.sugar.Infow("Credentials changed, a new token will be obtained", "auth_method", i.authMethod)
+	i.setCredentials(credentials)
+}
+
+// clientID returns the client id or username in use.
+func (i *credentialIntegration) clientID() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.current.ID
+}
+
+// switchCredentials replaces the credentials in use. Requests already sent keep their token, and the
+// next request obtains a token with the new credentials.
+func (i *credentialIntegration) switchCredentials(credentials Credentials) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.replaced = i.current
+	i.setCredentials(credentials)
+}
+
+// setCredentials replaces the authentication of the wrapped integration. The caller must hold i.mu.
+func (i *credentialIntegration) setCredentials(credentials Credentials) {
+	if i.authMethod == AuthMethodBasic {
+		i.inner.BuildBasicAuth(credentials.ID, credentials.Secret, i.bufferPeriod, i.hideSensitiveData, i.executor)
+	} else {
+		i.inner.BuildOAuth(credentials.ID, credentials.Secret, i.bufferPeriod, i.hideSensitiveData, i.executor)
+	}
+	i.current = credentials
+}
+
+func (i *credentialIntegration) GetFQDN() string {
+	return i.inner.GetFQDN()
+}
+
+func (i *credentialIntegration) ConstructURL(endpoint string) string {
+	return i.inner.ConstructURL(endpoint)
+}
+
+func (i *credentialIntegration) GetAuthMethodDescriptor() string {
+	return i.inner.GetAuthMethodDescriptor()
+}
+
+func (i *credentialIntegration) CheckRefreshToken() error {
+	i.refresh(context.Background())
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.inner.CheckRefreshToken()
+}
+
+func (i *credentialIntegration) PrepRequestParamsAndAuth(req *http.Request) error {
+	i.refresh(req.Context())
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.inner.PrepRequestParamsAndAuth(req)
+}
+
+func (i *credentialIntegration) PrepRequestBody(body interface{}, method string, endpoint string) ([]byte, error) {
+	return i.inner.PrepRequestBody(body, method, endpoint)
+}
+
+func (i *credentialIntegration) MarshalMultipartRequest(fields map[string]string, files map[string]string) ([]byte, string, error) {
+	return i.inner.MarshalMultipartRequest(fields, files)
+}
+
+func (i *credentialIntegration) GetSessionCookies() ([]*http.Cookie, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.inner.GetSessionCookies()
+}
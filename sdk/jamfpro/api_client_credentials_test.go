@@ -0,0 +1,181 @@
+// api_client_credentials_test.go
+package jamfpro_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+	"go.uber.org/zap"
+)
+
+func TestFileCredentialRotation(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "client-secret")
+	if err := os.WriteFile(path, []byte(srv.ClientSecret+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	transport := &countingTransport{}
+	config := srv.Config()
+	config.ClientSecret = ""
+	config.Credentials = &jamfpro.FileCredentialProvider{Path: path, CheckInterval: time.Millisecond}
+	config.Transport = transport
+
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Fatalf("GetBuildings() returned error: %v", err)
+	}
+	if got := transport.tokenRequests.Load(); got != 1 {
+		t.Fatalf("transport saw %d token requests before rotation, want 1", got)
+	}
+
+	srv.SetClientSecret("rotated-client-secret")
+	if err := os.WriteFile(path, []byte("rotated-client-secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Fatalf("GetBuildings() after rotation returned error: %v", err)
+	}
+	if got := transport.tokenRequests.Load(); got != 2 {
+		t.Errorf("transport saw %d token requests after rotation, want a new token", got)
+	}
+}
+
+func TestFileCredentialProviderCheckInterval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client-secret")
+	if err := os.WriteFile(path, []byte("first"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &jamfpro.FileCredentialProvider{Path: path, CheckInterval: time.Hour}
+	if credentials, err := provider.Credentials(context.Background()); err != nil || credentials.Secret != "first" {
+		t.Fatalf("Credentials() = %+v, %v, want the file's secret", credentials, err)
+	}
+
+	if err := os.WriteFile(path, []byte("second-secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if credentials, err := provider.Credentials(context.Background()); err != nil || credentials.Secret != "first" {
+		t.Errorf("Credentials() within the check interval = %+v, %v, want the cached secret", credentials, err)
+	}
+}
+
+func TestCredentialRefreshDoesNotBlockRequests(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	var block atomic.Bool
+	entered, release := make(chan struct{}), make(chan struct{})
+	provider := jamfpro.CredentialProviderFunc(func(ctx context.Context) (jamfpro.Credentials, error) {
+		if block.CompareAndSwap(true, false) {
+			close(entered)
+			<-release
+		}
+		return jamfpro.Credentials{ID: srv.ClientID, Secret: srv.ClientSecret}, nil
+	})
+
+	client, err := jamfpro.New(
+		jamfpro.WithInstanceDomain(srv.URL),
+		jamfpro.WithOAuth("", ""),
+		jamfpro.WithCredentials(provider),
+		jamfpro.WithConcurrency(2),
+		jamfpro.WithLogger(zap.NewNop().Sugar()),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	block.Store(true)
+	slow := make(chan error, 1)
+	go func() {
+		_, err := client.GetBuildings("")
+		slow <- err
+	}()
+	<-entered
+
+	fast := make(chan error, 1)
+	go func() {
+		_, err := client.GetBuildings("")
+		fast <- err
+	}()
+	select {
+	case err := <-fast:
+		if err != nil {
+			t.Errorf("GetBuildings() during a refresh returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("GetBuildings() waited for another request's credential refresh")
+	}
+
+	close(release)
+	if err := <-slow; err != nil {
+		t.Errorf("GetBuildings() which refreshed the credentials returned error: %v", err)
+	}
+}
+
+func TestExecCredentialProvider(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	provider := jamfpro.NewExecCredentialProvider("sh", "-c",
+		`printf '{"id": "%s", "secret": "%s"}' "$HELPER_ID" "$HELPER_SECRET"`)
+	provider.Env = []string{"HELPER_ID=" + srv.ClientID, "HELPER_SECRET=" + srv.ClientSecret}
+
+	credentials, err := provider.Credentials(context.Background())
+	if err != nil {
+		t.Fatalf("Credentials() returned error: %v", err)
+	}
+	if credentials.ID != srv.ClientID || credentials.Secret != srv.ClientSecret {
+		t.Errorf("Credentials() = %+v, want the helper's output", credentials)
+	}
+
+	client, err := jamfpro.New(
+		jamfpro.WithInstanceDomain(srv.URL),
+		jamfpro.WithOAuth("", ""),
+		jamfpro.WithCredentials(provider),
+		jamfpro.WithLogger(zap.NewNop().Sugar()),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() returned error: %v", err)
+	}
+
+	failing := jamfpro.NewExecCredentialProvider("sh", "-c", "echo 'vault sealed' >&2; exit 1")
+	failing.Timeout = 5 * time.Second
+	if _, err := failing.Credentials(context.Background()); err == nil {
+		t.Error("Credentials() with a failing helper returned no error")
+	}
+}
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv("JAMFPRO_TEST_SECRET", "")
+	if _, err := jamfpro.EnvCredentials("JAMFPRO_TEST_SECRET").Credentials(context.Background()); err == nil {
+		t.Error("Credentials() with an empty variable returned no error")
+	}
+
+	t.Setenv("JAMFPRO_TEST_SECRET", "secret")
+	credentials, err := jamfpro.EnvCredentials("JAMFPRO_TEST_SECRET").Credentials(context.Background())
+	if err != nil || credentials.Secret != "secret" {
+		t.Errorf("Credentials() = %+v, %v, want the variable's value", credentials, err)
+	}
+}
@@ -0,0 +1,193 @@
+// api_client_dryrun.go
+// Dry-run mode, in which every mutating request is recorded in a plan instead of being sent, so that
+// a script can be previewed against production before it is run.
+package jamfpro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// PlannedOperation is a mutating request recorded by a dry-run client instead of being sent.
+type PlannedOperation struct {
+	// Operation is the SDK method which would have sent the request, such as "jamfpro.CreatePolicy".
+	Operation string `json:"operation"`
+	Method    string `json:"method"`
+	Endpoint  string `json:"endpoint"`
+	// ContentType and Body are the request body exactly as it would have been sent: XML for the
+	// Classic API and JSON for the Jamf Pro API.
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+	// Files and Fields are the contents of a multipart upload or JCDS upload.
+	Files  []string          `json:"files,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// Plan is the list of operations recorded by a dry-run client, in the order they were requested. It is
+// safe for concurrent use, and marshals to JSON as {"operations": [...]}.
+type Plan struct {
+	mu         sync.Mutex
+	operations []PlannedOperation
+}
+
+// Operations returns a copy of the recorded operations.
+func (p *Plan) Operations() []PlannedOperation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedOperation(nil), p.operations...)
+}
+
+func (p *Plan) add(op PlannedOperation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.operations = append(p.operations, op)
+}
+
+// MarshalJSON returns the machine-readable plan.
+func (p *Plan) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Operations []PlannedOperation `json:"operations"`
+	}{Operations: p.Operations()})
+}
+
+// WriteText writes the human-readable plan to w.
+func (p *Plan) WriteText(w io.Writer) error {
+	operations := p.Operations()
+
+	var b strings.Builder
+	switch len(operations) {
+	case 0:
+		b.WriteString("No changes planned.\n")
+	case 1:
+		b.WriteString("1 change planned:\n")
+	default:
+		fmt.Fprintf(&b, "%d changes planned:\n", len(operations))
+	}
+
+	for i, op := range operations {
+		fmt.Fprintf(&b, "\n%d. %s\n   %s %s\n", i+1, op.Operation, op.Method, op.Endpoint)
+		for _, file := range op.Files {
+			fmt.Fprintf(&b, "   file: %s\n", file)
+		}
+		for _, name := range sortedKeys(op.Fields) {
+			fmt.Fprintf(&b, "   field %s: %s\n", name, op.Fields[name])
+		}
+		if op.Body != "" {
+			body := op.Body
+			var indented bytes.Buffer
+			if op.ContentType == "application/json" && json.Indent(&indented, []byte(body), "", "  ") == nil {
+				body = indented.String()
+			}
+			b.WriteString("   " + strings.ReplaceAll(body, "\n", "\n   ") + "\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// String returns the human-readable plan.
+func (p *Plan) String() string {
+	var b strings.Builder
+	p.WriteText(&b)
+	return b.String()
+}
+
+// Plan returns the plan of a client built with DryRun, and nil otherwise.
+func (c *Client) Plan() *Plan {
+	return c.plan
+}
+
+// planRequest records req in the client's plan and returns a synthetic success, unless req only
+// reads, in which case it is sent with send.
+func (c *Client) planRequest(req *Request, send Handler) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return send(req)
+	}
+
+	op := PlannedOperation{Operation: req.Operation, Method: req.Method, Endpoint: req.Endpoint}
+	switch body := req.Body.(type) {
+	case nil:
+	case *MultipartBody:
+		for _, name := range sortedKeys(body.Files) {
+			op.Files = append(op.Files, body.Files[name]...)
+		}
+		op.Fields = body.Fields
+	default:
+		data, err := (*c.HTTP.Integration).PrepRequestBody(body, req.Method, req.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal planned request body: %w", err)
+		}
+		op.Body = string(data)
+		op.ContentType = "application/json"
+		if strings.Contains(req.Endpoint, "/JSSResource") {
+			op.ContentType = "application/xml"
+		}
+	}
+
+	c.plan.add(op)
+	return plannedResponse(req.Method), nil
+}
+
+// plannedResponseHeader marks the synthetic responses of planned requests.
+const plannedResponseHeader = "X-Jamfpro-Planned"
+
+// plannedResponse returns the response a successful request with method usually receives. Methods
+// which expect another status, such as a POST answered with 204, accept it with isPlannedResponse.
+func plannedResponse(method string) *http.Response {
+	status := http.StatusOK
+	switch method {
+	case http.MethodDelete:
+		status = http.StatusNoContent
+	case http.MethodPost:
+		status = http.StatusCreated
+	}
+	header := http.Header{}
+	header.Set(plannedResponseHeader, "true")
+	return &http.Response{
+		StatusCode: status,
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Header:     header,
+		Body:       http.NoBody,
+	}
+}
+
+// isPlannedResponse reports whether resp was returned for a request recorded by a dry-run client.
+func isPlannedResponse(resp *http.Response) bool {
+	return resp != nil && resp.Header.Get(plannedResponseHeader) != ""
+}
+
+// planJCDS2 records a JCDS 2.0 upload or deletion, which is sent to S3 rather than through doRequest.
+// Files to be uploaded must exist, so that a missing file is reported by the dry run.
+func (c *Client) planJCDS2(operation, method, filePath string) error {
+	if method == http.MethodPut {
+		if _, err := os.Stat(filePath); err != nil {
+			return fmt.Errorf("failed to read package file: %w", err)
+		}
+	}
+
+	c.plan.add(PlannedOperation{
+		Operation: operation,
+		Method:    method,
+		Endpoint:  uriJCDS2 + "/files/" + filepath.Base(filePath),
+		Files:     []string{filePath},
+	})
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,117 @@
+// api_client_dryrun_test.go
+package jamfpro_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+func TestDryRun(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	id, err := srv.AddResource("/api/v1/buildings", jamfpro.ResourceBuilding{Name: "Head Office"})
+	if err != nil {
+		t.Fatalf("AddResource() returned error: %v", err)
+	}
+
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithDryRun())
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	// Reads are still sent to the server.
+	buildings, err := client.GetBuildings("")
+	if err != nil {
+		t.Fatalf("GetBuildings() returned error: %v", err)
+	}
+	if buildings.TotalCount != 1 {
+		t.Errorf("GetBuildings() returned %d buildings, want 1", buildings.TotalCount)
+	}
+
+	if _, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Annex"}); err != nil {
+		t.Errorf("CreateBuilding() returned error: %v", err)
+	}
+	if _, err := client.CreateComputerGroup(&jamfpro.ResourceComputerGroup{Name: "All Macs"}); err != nil {
+		t.Errorf("CreateComputerGroup() returned error: %v", err)
+	}
+	if err := client.DeleteBuildingByID(id); err != nil {
+		t.Errorf("DeleteBuildingByID() returned error: %v", err)
+	}
+	// Jamf Pro answers bulk deletions with 204, which the planned 201 stands in for.
+	if err := client.DeleteMultiplePackagesByID([]string{"1", "2"}); err != nil {
+		t.Errorf("DeleteMultiplePackagesByID() returned error: %v", err)
+	}
+
+	pkg := filepath.Join(t.TempDir(), "Firefox.pkg")
+	if err := os.WriteFile(pkg, []byte("xar!"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateJCDS2PackageV2(pkg); err != nil {
+		t.Errorf("CreateJCDS2PackageV2() returned error: %v", err)
+	}
+	if _, err := client.CreateJCDS2PackageV2(filepath.Join(t.TempDir(), "missing.pkg")); err == nil {
+		t.Error("CreateJCDS2PackageV2() of a missing file returned no error")
+	}
+
+	if n := srv.Len("/api/v1/buildings"); n != 1 {
+		t.Errorf("server holds %d buildings, want 1", n)
+	}
+	if n := srv.Len("/JSSResource/computergroups"); n != 0 {
+		t.Errorf("server holds %d computer groups, want 0", n)
+	}
+
+	want := []jamfpro.PlannedOperation{
+		{Operation: "jamfpro.CreateBuilding", Method: "POST", Endpoint: "/api/v1/buildings", ContentType: "application/json"},
+		{Operation: "jamfpro.CreateComputerGroup", Method: "POST", Endpoint: "/JSSResource/computergroups", ContentType: "application/xml"},
+		{Operation: "jamfpro.DeleteBuildingByID", Method: "DELETE", Endpoint: "/api/v1/buildings/" + id},
+		{Operation: "jamfpro.DeleteMultiplePackagesByID", Method: "POST", Endpoint: "/api/v1/packages/delete-multiple", ContentType: "application/json"},
+		{Operation: "jamfpro.CreateJCDS2PackageV2", Method: "PUT", Endpoint: "/api/v1/jcds/files/Firefox.pkg"},
+	}
+
+	operations := client.Plan().Operations()
+	if len(operations) != len(want) {
+		t.Fatalf("plan has %d operations, want %d: %+v", len(operations), len(want), operations)
+	}
+	for i, op := range operations {
+		if op.Operation != want[i].Operation || op.Method != want[i].Method || op.Endpoint != want[i].Endpoint || op.ContentType != want[i].ContentType {
+			t.Errorf("operation %d = %s %s %s %s, want %s %s %s %s", i,
+				op.Operation, op.Method, op.Endpoint, op.ContentType,
+				want[i].Operation, want[i].Method, want[i].Endpoint, want[i].ContentType)
+		}
+	}
+
+	if !strings.Contains(operations[0].Body, `"name":"Annex"`) {
+		t.Errorf("JSON body = %s", operations[0].Body)
+	}
+	if !strings.Contains(operations[1].Body, "<name>All Macs</name>") {
+		t.Errorf("XML body = %s", operations[1].Body)
+	}
+
+	text := client.Plan().String()
+	for _, s := range []string{"5 changes planned", "jamfpro.CreateBuilding", "POST /api/v1/buildings", "file: " + pkg} {
+		if !strings.Contains(text, s) {
+			t.Errorf("text plan does not contain %q:\n%s", s, text)
+		}
+	}
+
+	data, err := json.Marshal(client.Plan())
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	var decoded struct {
+		Operations []jamfpro.PlannedOperation `json:"operations"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if len(decoded.Operations) != len(want) {
+		t.Errorf("JSON plan has %d operations, want %d", len(decoded.Operations), len(want))
+	}
+}
@@ -0,0 +1,171 @@
+// api_client_logging.go
+// Structured logging through log/slog. The SDK logs through a *slog.Logger, and the HTTP client, which
+// logs through zap, is bridged to the same destination, so that a single logger or handler receives
+// all output and nothing is written to stdout.
+package jamfpro
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// discardLogger is used by clients which were not built with BuildClient.
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// Logger returns the logger the client writes its structured output to.
+func (c *Client) Logger() *slog.Logger {
+	if c.logger == nil {
+		return discardLogger
+	}
+	return c.logger
+}
+
+// buildSlogLogger returns the configured slog logger, or one writing to the zap logger.
+func buildSlogLogger(config *ConfigContainer, sugar *zap.SugaredLogger) *slog.Logger {
+	if config.SlogLogger != nil {
+		return config.SlogLogger
+	}
+	return slog.New(&zapHandler{core: sugar.Desugar().Core()})
+}
+
+// zapToSlogLevel maps a zap level to the closest slog level.
+func zapToSlogLevel(level zapcore.Level) slog.Level {
+	switch {
+	case level <= zapcore.DebugLevel:
+		return slog.LevelDebug
+	case level == zapcore.InfoLevel:
+		return slog.LevelInfo
+	case level == zapcore.WarnLevel:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
+
+// slogToZapLevel maps a slog level to the closest zap level.
+func slogToZapLevel(level slog.Level) zapcore.Level {
+	switch {
+	case level < slog.LevelInfo:
+		return zapcore.DebugLevel
+	case level < slog.LevelWarn:
+		return zapcore.InfoLevel
+	case level < slog.LevelError:
+		return zapcore.WarnLevel
+	default:
+		return zapcore.ErrorLevel
+	}
+}
+
+// slogCore is a zap core which writes to a slog handler.
+type slogCore struct {
+	handler slog.Handler
+}
+
+func newSlogCore(handler slog.Handler) zapcore.Core {
+	return &slogCore{handler: handler}
+}
+
+func (c *slogCore) Enabled(level zapcore.Level) bool {
+	return c.handler.Enabled(context.Background(), zapToSlogLevel(level))
+}
+
+func (c *slogCore) With(fields []zapcore.Field) zapcore.Core {
+	return &slogCore{handler: c.handler.WithAttrs(fieldsToAttrs(fields))}
+}
+
+func (c *slogCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *slogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	record := slog.NewRecord(entry.Time, zapToSlogLevel(entry.Level), entry.Message, 0)
+	record.AddAttrs(fieldsToAttrs(fields)...)
+	return c.handler.Handle(context.Background(), record)
+}
+
+func (c *slogCore) Sync() error {
+	return nil
+}
+
+// fieldsToAttrs converts zap fields to slog attributes, keeping their order.
+func fieldsToAttrs(fields []zapcore.Field) []slog.Attr {
+	encoder := zapcore.NewMapObjectEncoder()
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, field := range fields {
+		field.AddTo(encoder)
+		if value, ok := encoder.Fields[field.Key]; ok {
+			attrs = append(attrs, slog.Any(field.Key, value))
+			delete(encoder.Fields, field.Key)
+		}
+	}
+	return attrs
+}
+
+// zapHandler is a slog handler which writes to a zap core. Groups are flattened into dotted keys.
+type zapHandler struct {
+	core   zapcore.Core
+	prefix string
+}
+
+func (h *zapHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.core.Enabled(slogToZapLevel(level))
+}
+
+func (h *zapHandler) Handle(_ context.Context, record slog.Record) error {
+	entry := zapcore.Entry{Level: slogToZapLevel(record.Level), Time: record.Time, Message: record.Message}
+	checked := h.core.Check(entry, nil)
+	if checked == nil {
+		return nil
+	}
+
+	fields := make([]zapcore.Field, 0, record.NumAttrs())
+	record.Attrs(func(attr slog.Attr) bool {
+		fields = appendAttrFields(fields, h.prefix, attr)
+		return true
+	})
+
+	checked.Write(fields...)
+	return nil
+}
+
+func (h *zapHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var fields []zapcore.Field
+	for _, attr := range attrs {
+		fields = appendAttrFields(fields, h.prefix, attr)
+	}
+	return &zapHandler{core: h.core.With(fields), prefix: h.prefix}
+}
+
+func (h *zapHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &zapHandler{core: h.core, prefix: h.prefix + name + "."}
+}
+
+// appendAttrFields converts a slog attribute to zap fields, flattening groups.
+func appendAttrFields(fields []zapcore.Field, prefix string, attr slog.Attr) []zapcore.Field {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return fields
+	}
+
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			prefix += attr.Key + "."
+		}
+		for _, member := range attr.Value.Group() {
+			fields = appendAttrFields(fields, prefix, member)
+		}
+		return fields
+	}
+
+	return append(fields, zap.Any(prefix+attr.Key, attr.Value.Any()))
+}
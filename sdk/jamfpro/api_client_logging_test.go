@@ -0,0 +1,107 @@
+// api_client_logging_test.go
+package jamfpro_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// captureStdout returns everything written to os.Stdout while fn runs.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- string(data)
+	}()
+
+	fn()
+	w.Close()
+	return <-done
+}
+
+func TestSlogHandler(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+
+	stdout := captureStdout(t, func() {
+		client, err := jamfpro.New(
+			jamfpro.WithConfig(srv.Config()),
+			jamfpro.WithLogger(nil),
+			jamfpro.WithSlogHandler(handler),
+		)
+		if err != nil {
+			t.Fatalf("New() returned error: %v", err)
+		}
+		if _, err := client.GetBuildings(""); err != nil {
+			t.Fatalf("GetBuildings() returned error: %v", err)
+		}
+		client.Logger().Info("done", "component", "test")
+	})
+
+	if stdout != "" {
+		t.Errorf("client wrote %q to stdout", stdout)
+	}
+
+	var messages []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("handler received a line which is not JSON: %q", line)
+		}
+		messages = append(messages, record["msg"].(string))
+	}
+
+	joined := strings.Join(messages, "\n")
+	if !strings.Contains(joined, "Token obtained successfully") {
+		t.Errorf("handler received %q, want the HTTP client's token message", messages)
+	}
+	if !strings.Contains(buf.String(), `"msg":"done","component":"test"`) {
+		t.Errorf("handler received %q, want the SDK's structured message", buf.String())
+	}
+}
+
+func TestZapLoggerReceivesSDKOutput(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	client, err := jamfpro.New(
+		jamfpro.WithConfig(srv.Config()),
+		jamfpro.WithLogger(zap.New(core).Sugar()),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	client.Logger().WithGroup("upload").Info("Uploaded package file", "package_id", "7")
+
+	entries := logs.FilterMessage("Uploaded package file").All()
+	if len(entries) != 1 || entries[0].ContextMap()["upload.package_id"] != "7" {
+		t.Errorf("zap logger received %+v, want the SDK's message with its fields", entries)
+	}
+}
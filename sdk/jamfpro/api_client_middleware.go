@@ -0,0 +1,172 @@
+// api_client_middleware.go
+// Middleware which observes or changes every SDK request, on both the Classic API and the Jamf Pro
+// API, before it reaches the http client.
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Request is an SDK request as seen by middleware. Middleware may change any field before calling
+// the next handler.
+type Request struct {
+	// Context is the context the request is bound to, see Client.WithContext.
+	Context context.Context
+	// Operation is the name of the SDK operation sending the request, such as "jamfpro.GetPolicyByID".
+	Operation string
+	Method    string
+	// Endpoint is the path and query of the request, such as "/JSSResource/policies/id/42".
+	Endpoint string
+	// Body is the value encoded as the request body, XML for the Classic API and JSON for the Jamf Pro
+	// API, or a *MultipartBody for uploads. It is nil for requests without a body.
+	Body interface{}
+	// Out is the value the response body is decoded into. It holds the decoded response once the next
+	// handler has returned without error.
+	Out interface{}
+	// Header is added to every HTTP attempt of the request.
+	Header http.Header
+}
+
+// MultipartBody is the Body of a multipart upload, such as a package or an icon.
+type MultipartBody struct {
+	// Files maps form field names to the paths of the files uploaded in them.
+	Files        map[string][]string
+	Fields       map[string]string
+	ContentTypes map[string]string
+	PartHeaders  map[string]http.Header
+}
+
+// Handler sends a request and returns the raw response, whose body has already been decoded into
+// req.Out.
+type Handler func(req *Request) (*http.Response, error)
+
+// Middleware wraps a handler. It can inspect or change the request, return without calling next, for
+// example to block writes, and inspect the response.
+type Middleware = func(next Handler) Handler
+
+// Use adds middleware to the client. Middleware runs in the order it was added, the first added
+// being the outermost, for every request sent by the client and by clients later derived from it
+// with WithContext. Use must not be called concurrently with requests.
+//
+// Example usage:
+//
+//	client.Use(func(next jamfpro.Handler) jamfpro.Handler {
+//		return func(req *jamfpro.Request) (*http.Response, error) {
+//			if req.Method != http.MethodGet {
+//				return nil, fmt.Errorf("%s is blocked in read-only mode", req.Operation)
+//			}
+//			req.Header.Set("X-Correlation-ID", correlationID(req.Context))
+//			return next(req)
+//		}
+//	})
+func (c *Client) Use(middleware ...Middleware) {
+	// Clip so that clients already derived with WithContext keep their own chain.
+	c.middleware = append(slices.Clip(c.middleware), middleware...)
+}
+
+// handle runs req through the client's middleware, the plan of a dry run and the audit journal,
+// ending with send.
+func (c *Client) handle(req *Request, send Handler) (*http.Response, error) {
+	if c.telemetry != nil && operationFrom(c.Context()) == nil {
+		// A request sent outside an operation is traced as one of its own.
+		client, done := c.startOperation("Request")
+		defer done()
+		c = client
+	}
+
+	req.Context = c.Context()
+	req.Header = http.Header{}
+	if len(c.middleware) > 0 || c.telemetry != nil || c.plan != nil || c.audit != nil {
+		req.Operation = operationName(req.Context)
+	}
+
+	handler := send
+	if c.audit != nil {
+		next := handler
+		handler = func(req *Request) (*http.Response, error) {
+			return c.auditRequest(req, next)
+		}
+	}
+	if c.plan != nil {
+		// Dry runs are applied after middleware, so that middleware sees planned requests too, and
+		// before auditing, as planned requests change nothing.
+		next := handler
+		handler = func(req *Request) (*http.Response, error) {
+			return c.planRequest(req, next)
+		}
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		handler = c.middleware[i](handler)
+	}
+	return handler(req)
+}
+
+// operationContextKey carries the operation a request belongs to.
+type operationContextKey struct{}
+
+// operation is an SDK call in progress, such as a GetBuildings which fetches three pages. It is carried
+// on the context of the call's requests, including those sent from other goroutines by parallel
+// pagination, so that every request and retry is attributed to it.
+type operation struct {
+	name      string
+	telemetry *telemetry
+	span      trace.Span
+	start     time.Time
+
+	mu         sync.Mutex
+	method     string
+	template   string
+	resourceID string
+	requests   int
+	attempts   int
+	status     int
+	err        error
+}
+
+// startOperation returns a copy of the client bound to a new operation named "jamfpro." + name, and
+// the function which ends it. Every exported method which sends requests starts one:
+//
+//	c, done := c.startOperation("GetBuildingByID")
+//	defer done()
+//
+// An operation started within another, as when UploadPackages calls CreatePackage, is its child.
+func (c *Client) startOperation(name string) (*Client, func()) {
+	op := &operation{name: "jamfpro." + name, telemetry: c.telemetry, start: time.Now()}
+
+	ctx := c.Context()
+	if c.telemetry != nil {
+		ctx, op.span = c.telemetry.tracer.Start(ctx, op.name, trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(AttributeOperation.String(op.name)))
+	}
+	return c.WithContext(context.WithValue(ctx, operationContextKey{}, op)), op.end
+}
+
+// continueOperation is startOperation for entry points which may be called within an operation, such
+// as a Paginator used by a list method. It returns the client unchanged when it already has one.
+func (c *Client) continueOperation(name string) (*Client, func()) {
+	if operationFrom(c.Context()) != nil {
+		return c, func() {}
+	}
+	return c.startOperation(name)
+}
+
+// operationFrom returns the operation ctx belongs to, or nil.
+func operationFrom(ctx context.Context) *operation {
+	op, _ := ctx.Value(operationContextKey{}).(*operation)
+	return op
+}
+
+// operationName returns the name of the operation a request belongs to, for example
+// "jamfpro.GetPolicyByID".
+func operationName(ctx context.Context) string {
+	if op := operationFrom(ctx); op != nil {
+		return op.name
+	}
+	return "jamfpro.Request"
+}
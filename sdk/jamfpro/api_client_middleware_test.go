@@ -0,0 +1,143 @@
+// api_client_middleware_test.go
+package jamfpro_test
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+// headerTransport records the given header of every request it sends.
+type headerTransport struct {
+	name string
+
+	mu     sync.Mutex
+	values []string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if value := req.Header.Get(t.name); value != "" {
+		t.mu.Lock()
+		t.values = append(t.values, value)
+		t.mu.Unlock()
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestMiddleware(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	if _, err := srv.AddResource("/JSSResource/computergroups", jamfpro.ResourceComputerGroup{Name: "All Macs"}); err != nil {
+		t.Fatalf("AddResource() returned error: %v", err)
+	}
+
+	transport := &headerTransport{name: "X-Correlation-ID"}
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	var seen []*jamfpro.Request
+	var order []string
+	client.Use(
+		func(next jamfpro.Handler) jamfpro.Handler {
+			return func(req *jamfpro.Request) (*http.Response, error) {
+				order = append(order, "outer")
+				req.Header.Set("X-Correlation-ID", "job-42")
+				return next(req)
+			}
+		},
+		func(next jamfpro.Handler) jamfpro.Handler {
+			return func(req *jamfpro.Request) (*http.Response, error) {
+				order = append(order, "inner")
+				resp, err := next(req)
+				seen = append(seen, req)
+				return resp, err
+			}
+		},
+	)
+
+	created, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head Office"})
+	if err != nil {
+		t.Fatalf("CreateBuilding() returned error: %v", err)
+	}
+	group, err := client.GetComputerGroupByName("All Macs")
+	if err != nil {
+		t.Fatalf("GetComputerGroupByName() returned error: %v", err)
+	}
+
+	if len(seen) != 2 {
+		t.Fatalf("middleware saw %d requests, want 2", len(seen))
+	}
+
+	tests := []struct {
+		req       *jamfpro.Request
+		operation string
+		method    string
+		endpoint  string
+	}{
+		{seen[0], "jamfpro.CreateBuilding", http.MethodPost, "/api/v1/buildings"},
+		{seen[1], "jamfpro.GetComputerGroupByName", http.MethodGet, "/JSSResource/computergroups/name/All Macs"},
+	}
+	for _, tt := range tests {
+		if tt.req.Operation != tt.operation || tt.req.Method != tt.method || tt.req.Endpoint != tt.endpoint {
+			t.Errorf("request = %s %s %s, want %s %s %s", tt.req.Operation, tt.req.Method, tt.req.Endpoint, tt.operation, tt.method, tt.endpoint)
+		}
+	}
+
+	if body, ok := seen[0].Body.(*jamfpro.ResourceBuilding); !ok || body.Name != "Head Office" {
+		t.Errorf("JSON request body = %#v", seen[0].Body)
+	}
+	if out, ok := seen[0].Out.(*jamfpro.ResponseBuildingCreate); !ok || out.ID != created.ID {
+		t.Errorf("JSON response = %#v, want id %s", seen[0].Out, created.ID)
+	}
+	if out, ok := seen[1].Out.(*jamfpro.ResourceComputerGroup); !ok || out.Name != group.Name {
+		t.Errorf("XML response = %#v, want %s", seen[1].Out, group.Name)
+	}
+
+	if want := []string{"outer", "inner", "outer", "inner"}; !slices.Equal(order, want) {
+		t.Errorf("middleware order = %v, want %v", order, want)
+	}
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	if len(transport.values) != 2 || transport.values[0] != "job-42" {
+		t.Errorf("X-Correlation-ID sent = %v, want job-42 on both requests", transport.values)
+	}
+}
+
+func TestMiddlewareBlocksWrites(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+
+	errReadOnly := errors.New("read-only")
+	client.Use(func(next jamfpro.Handler) jamfpro.Handler {
+		return func(req *jamfpro.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				return nil, errReadOnly
+			}
+			return next(req)
+		}
+	})
+
+	if _, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head Office"}); !errors.Is(err, errReadOnly) {
+		t.Errorf("CreateBuilding() error = %v, want %v", err, errReadOnly)
+	}
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() returned error: %v", err)
+	}
+	if n := srv.Len("/api/v1/buildings"); n != 0 {
+		t.Errorf("server holds %d buildings, want 0", n)
+	}
+}
@@ -0,0 +1,300 @@
+// api_client_options.go
+// Functional options for building a client with New.
+package jamfpro
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Option configures a client built with New.
+type Option func(*ConfigContainer)
+
+// New builds a Jamf Pro client from options. Options are applied in order on top of the defaults
+// used by BuildClientWithEnv, then the resulting configuration is validated as a whole so that every
+// problem is reported in a single *ValidationError before any request is sent.
+//
+// Example usage:
+//
+//	client, err := jamfpro.New(
+//		jamfpro.WithInstanceDomain("https://yourserver.jamfcloud.com"),
+//		jamfpro.WithOAuth(clientID, clientSecret),
+//		jamfpro.WithRetry(3, time.Minute),
+//		jamfpro.WithConcurrency(5),
+//	)
+func New(opts ...Option) (*Client, error) {
+	config := defaultConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return BuildClient(config)
+}
+
+// defaultConfig returns the configuration New starts from.
+func defaultConfig() *ConfigContainer {
+	return &ConfigContainer{
+		LogLevel:                    "warn",
+		HideSensitiveData:           true,
+		MaxRetryAttempts:            3,
+		MaxConcurrentRequests:       1,
+		TokenRefreshBufferPeriod:    300,
+		TotalRetryDuration:          60,
+		CustomTimeout:               60,
+		FollowRedirects:             TruePtr(),
+		MaxRedirects:                5,
+		EnableConcurrencyManagement: true,
+		RetryEligiableRequests:      true,
+	}
+}
+
+// WithConfig replaces the configuration built so far with a copy of config, so that a configuration
+// loaded from a file can be adjusted with further options.
+func WithConfig(config *ConfigContainer) Option {
+	return func(c *ConfigContainer) {
+		if config == nil {
+			c.addOptionProblem("WithConfig was given a nil configuration")
+			return
+		}
+		problems := c.optionProblems
+		*c = *config
+		c.optionProblems = append(problems, config.optionProblems...)
+	}
+}
+
+// addOptionProblem records a problem with an option, which Validate reports with the rest.
+func (c *ConfigContainer) addOptionProblem(format string, args ...interface{}) {
+	c.optionProblems = append(c.optionProblems, fmt.Sprintf(format, args...))
+}
+
+// wholeUnits converts d to a whole number of unit, recording a problem for name when d is not one.
+func (c *ConfigContainer) wholeUnits(name string, d, unit time.Duration) int {
+	if d%unit != 0 {
+		c.addOptionProblem("%s must be a whole number of %s, got %v", name, unitName(unit), d)
+	}
+	return int(d / unit)
+}
+
+func unitName(unit time.Duration) string {
+	if unit == time.Millisecond {
+		return "milliseconds"
+	}
+	return "seconds"
+}
+
+// WithInstanceDomain sets the Jamf Pro instance, such as https://yourserver.jamfcloud.com.
+func WithInstanceDomain(instanceDomain string) Option {
+	return func(c *ConfigContainer) {
+		c.InstanceDomain = instanceDomain
+	}
+}
+
+// WithOAuth authenticates with an API client id and secret.
+func WithOAuth(clientID, clientSecret string) Option {
+	return func(c *ConfigContainer) {
+		c.AuthMethod = AuthMethodOAuth2
+		c.ClientID = clientID
+		c.ClientSecret = clientSecret
+	}
+}
+
+// WithBasicAuth authenticates with a username and password.
+func WithBasicAuth(username, password string) Option {
+	return func(c *ConfigContainer) {
+		c.AuthMethod = AuthMethodBasic
+		c.Username = username
+		c.Password = password
+	}
+}
+
+// WithCredentials obtains the client secret or password, and optionally the client id or username,
+// from provider, such as NewFileCredentialProvider or NewExecCredentialProvider. Use it together
+// with WithOAuth or WithBasicAuth, passing an empty secret or password.
+func WithCredentials(provider CredentialProvider) Option {
+	return func(c *ConfigContainer) {
+		c.Credentials = provider
+	}
+}
+
+// WithRetry retries eligible requests up to maxAttempts times within totalDuration.
+// A maxAttempts of 0 disables retries.
+func WithRetry(maxAttempts int, totalDuration time.Duration) Option {
+	return func(c *ConfigContainer) {
+		c.RetryEligiableRequests = maxAttempts > 0
+		c.MaxRetryAttempts = maxAttempts
+		c.TotalRetryDuration = c.wholeUnits("total retry duration", totalDuration, time.Second)
+	}
+}
+
+// WithConcurrency limits the number of requests in flight at once. This is also the budget used by
+// parallel pagination.
+func WithConcurrency(maxConcurrentRequests int) Option {
+	return func(c *ConfigContainer) {
+		c.EnableConcurrencyManagement = true
+		c.MaxConcurrentRequests = maxConcurrentRequests
+	}
+}
+
+// WithDynamicRateLimiting adjusts concurrency based on the response times of the server.
+func WithDynamicRateLimiting() Option {
+	return func(c *ConfigContainer) {
+		c.EnableDynamicRateLimiting = true
+	}
+}
+
+// WithParallelPagination prefetches the pages of every paginated list method concurrently.
+func WithParallelPagination() Option {
+	return func(c *ConfigContainer) {
+		c.EnableParallelPagination = true
+	}
+}
+
+// WithTimeout sets the timeout of each request, a whole number of seconds.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *ConfigContainer) {
+		c.CustomTimeout = c.wholeUnits("timeout", timeout, time.Second)
+	}
+}
+
+// WithTokenRefreshBuffer renews tokens once they are within buffer, a whole number of seconds, of
+// expiring.
+func WithTokenRefreshBuffer(buffer time.Duration) Option {
+	return func(c *ConfigContainer) {
+		c.TokenRefreshBufferPeriod = c.wholeUnits("token refresh buffer", buffer, time.Second)
+	}
+}
+
+// WithRequestDelay waits for delay, a whole number of milliseconds, after every request.
+func WithRequestDelay(delay time.Duration) Option {
+	return func(c *ConfigContainer) {
+		c.MandatoryRequestDelay = c.wholeUnits("request delay", delay, time.Millisecond)
+	}
+}
+
+// WithRedirects sets whether redirects are followed, and how many are followed at most.
+func WithRedirects(follow bool, maxRedirects int) Option {
+	return func(c *ConfigContainer) {
+		c.FollowRedirects = BoolPtr(follow)
+		c.MaxRedirects = maxRedirects
+	}
+}
+
+// WithLoadBalancerLock pins every request to a single Jamf Cloud load balancer node.
+func WithLoadBalancerLock() Option {
+	return func(c *ConfigContainer) {
+		c.JamfLoadBalancerLock = true
+	}
+}
+
+// WithCustomCookies sends cookies with every request.
+func WithCustomCookies(cookies ...CustomCookie) Option {
+	return func(c *ConfigContainer) {
+		c.CustomCookies = append(c.CustomCookies, cookies...)
+	}
+}
+
+// WithLogger uses logger instead of building one from the log level.
+func WithLogger(logger *zap.SugaredLogger) Option {
+	return func(c *ConfigContainer) {
+		c.Logger = logger
+	}
+}
+
+// WithSlogLogger sends all output, including the HTTP client's, to logger.
+func WithSlogLogger(logger *slog.Logger) Option {
+	return func(c *ConfigContainer) {
+		c.SlogLogger = logger
+	}
+}
+
+// WithSlogHandler sends all output, including the HTTP client's, to handler.
+func WithSlogHandler(handler slog.Handler) Option {
+	return func(c *ConfigContainer) {
+		c.SlogLogger = slog.New(handler)
+	}
+}
+
+// WithLogLevel sets the level of the default logger: debug, info, warn, error, dpanic or fatal.
+func WithLogLevel(level string) Option {
+	return func(c *ConfigContainer) {
+		c.LogLevel = level
+	}
+}
+
+// WithLogExportPath additionally writes the default logger's output to path.
+func WithLogExportPath(path string) Option {
+	return func(c *ConfigContainer) {
+		c.LogExportPath = path
+	}
+}
+
+// WithSensitiveData sets whether tokens and other sensitive values may be written to debug logs.
+func WithSensitiveData(show bool) Option {
+	return func(c *ConfigContainer) {
+		c.HideSensitiveData = !show
+	}
+}
+
+// WithHTTPClient sends every request, including token requests, with a copy of client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *ConfigContainer) {
+		c.HTTPClient = client
+	}
+}
+
+// WithTransport sends every request, including token requests, with transport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *ConfigContainer) {
+		c.Transport = transport
+	}
+}
+
+// WithIntegration uses a pre-built integration instead of one built from the instance domain and credentials.
+func WithIntegration(integration httpclient.APIIntegration) Option {
+	return func(c *ConfigContainer) {
+		c.Integration = integration
+	}
+}
+
+// WithTracerProvider records a span for every SDK operation and HTTP attempt with provider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *ConfigContainer) {
+		c.TracerProvider = provider
+	}
+}
+
+// WithMeterProvider records request, latency and throttling metrics with provider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *ConfigContainer) {
+		c.MeterProvider = provider
+	}
+}
+
+// WithDryRun records every mutating request in the client's Plan instead of sending it. Requests which
+// only read are still sent.
+func WithDryRun() Option {
+	return func(c *ConfigContainer) {
+		c.DryRun = true
+	}
+}
+
+// WithAuditLog appends an AuditEntry for every mutating request to the file at path, as JSON Lines.
+func WithAuditLog(path string) Option {
+	return func(c *ConfigContainer) {
+		c.AuditLogPath = path
+	}
+}
+
+// WithAuditSink sends an AuditEntry for every mutating request to sink.
+func WithAuditSink(sink AuditSink) Option {
+	return func(c *ConfigContainer) {
+		c.AuditSink = sink
+	}
+}
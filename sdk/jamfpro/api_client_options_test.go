@@ -0,0 +1,210 @@
+// api_client_options_test.go
+package jamfpro_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+	"go.uber.org/zap"
+)
+
+func TestNewValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []jamfpro.Option
+		wantProblems []string
+	}{
+		{
+			name:         "no options",
+			wantProblems: []string{"instance domain is required", "auth method is required"},
+		},
+		{
+			name: "every problem reported together",
+			opts: []jamfpro.Option{
+				jamfpro.WithInstanceDomain("yourserver.jamfcloud.com"),
+				jamfpro.WithOAuth("", ""),
+				jamfpro.WithLogLevel("verbose"),
+				jamfpro.WithConcurrency(0),
+				jamfpro.WithRetry(-1, time.Minute),
+			},
+			wantProblems: []string{
+				"instance domain",
+				"client id is required",
+				"client secret is required",
+				"invalid log level",
+				"max concurrent requests must be at least 1",
+				"max retry attempts cannot be negative",
+			},
+		},
+		{
+			name: "basic auth without password",
+			opts: []jamfpro.Option{
+				jamfpro.WithInstanceDomain("https://yourserver.jamfcloud.com"),
+				jamfpro.WithBasicAuth("admin", ""),
+			},
+			wantProblems: []string{"password is required"},
+		},
+		{
+			name: "credential source with two sources",
+			opts: []jamfpro.Option{
+				jamfpro.WithInstanceDomain("https://yourserver.jamfcloud.com"),
+				jamfpro.WithOAuth("id", ""),
+				func(c *jamfpro.ConfigContainer) {
+					c.CredentialSource = &jamfpro.CredentialSource{Env: "CLIENT_SECRET", File: "/run/secrets/client-secret"}
+				},
+			},
+			wantProblems: []string{"credential source must set exactly one of env, file and command"},
+		},
+		{
+			name: "unnamed cookie",
+			opts: []jamfpro.Option{
+				jamfpro.WithInstanceDomain("https://yourserver.jamfcloud.com"),
+				jamfpro.WithOAuth("id", "secret"),
+				jamfpro.WithCustomCookies(jamfpro.CustomCookie{Value: "value"}),
+			},
+			wantProblems: []string{"custom cookie 0 has no name"},
+		},
+		{
+			name: "durations which would be truncated",
+			opts: []jamfpro.Option{
+				jamfpro.WithInstanceDomain("https://yourserver.jamfcloud.com"),
+				jamfpro.WithOAuth("id", "secret"),
+				jamfpro.WithTimeout(500 * time.Millisecond),
+				jamfpro.WithRequestDelay(1500 * time.Microsecond),
+			},
+			wantProblems: []string{
+				"timeout must be a whole number of seconds, got 500ms",
+				"request delay must be a whole number of milliseconds, got 1.5ms",
+			},
+		},
+		{
+			name: "nil configuration",
+			opts: []jamfpro.Option{
+				jamfpro.WithConfig(nil),
+				jamfpro.WithInstanceDomain("https://yourserver.jamfcloud.com"),
+				jamfpro.WithOAuth("id", "secret"),
+			},
+			wantProblems: []string{"WithConfig was given a nil configuration"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := jamfpro.New(tt.opts...)
+
+			var validationErr *jamfpro.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("New() error = %v, want a *ValidationError", err)
+			}
+			if len(validationErr.Problems) != len(tt.wantProblems) {
+				t.Errorf("New() reported %d problems %q, want %d", len(validationErr.Problems), validationErr.Problems, len(tt.wantProblems))
+			}
+			for _, want := range tt.wantProblems {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("New() error = %q, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := jamfpro.New(
+		jamfpro.WithInstanceDomain(srv.URL),
+		jamfpro.WithOAuth(srv.ClientID, srv.ClientSecret),
+		jamfpro.WithRetry(2, 10*time.Second),
+		jamfpro.WithConcurrency(3),
+		jamfpro.WithLogger(zap.NewNop().Sugar()),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() returned error: %v", err)
+	}
+}
+
+// redirectTransport redirects every buildings request back to itself, and passes everything else on.
+type redirectTransport struct {
+	requests atomic.Int32
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasPrefix(req.URL.Path, "/api/v1/buildings") {
+		t.requests.Add(1)
+		return &http.Response{
+			StatusCode: http.StatusFound,
+			Header:     http.Header{"Location": []string{req.URL.String()}},
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewRedirects(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name         string
+		follow       bool
+		wantRequests int32
+	}{
+		{name: "followed up to the limit", follow: true, wantRequests: 3},
+		{name: "not followed", follow: false, wantRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &redirectTransport{}
+			client, err := jamfpro.New(
+				jamfpro.WithConfig(srv.Config()),
+				jamfpro.WithRetry(0, 0),
+				jamfpro.WithRedirects(tt.follow, 2),
+				jamfpro.WithTransport(transport),
+			)
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			if _, err := client.GetBuildings(""); err == nil {
+				t.Error("GetBuildings() returned no error for a redirect loop")
+			}
+			if got := transport.requests.Load(); got != tt.wantRequests {
+				t.Errorf("transport saw %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestBuildClientFollowsRedirectsByDefault(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	transport := &redirectTransport{}
+	config := srv.Config()
+	config.Transport = transport
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildings(""); err == nil {
+		t.Error("GetBuildings() returned no error for a redirect loop")
+	}
+	// net/http stops after 10 requests when no policy is configured.
+	if got := transport.requests.Load(); got != 10 {
+		t.Errorf("transport saw %d requests, want 10", got)
+	}
+}
@@ -0,0 +1,220 @@
+// api_client_profiles.go
+// Named configuration profiles and a registry which builds and caches one client per profile, for
+// managing several Jamf Pro tenants from a single process.
+package jamfpro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Environment variables used to locate the profiles file and select a profile.
+const (
+	EnvConfigFile = "JAMF_CONFIG_FILE"
+	EnvProfile    = "JAMF_PROFILE"
+)
+
+// DefaultProfileName is the profile used when neither JAMF_PROFILE nor default_profile is set.
+const DefaultProfileName = "default"
+
+// ErrProfileNotFound is returned when a profile is not defined in the profiles file.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ProfilesFile is the format of a configuration file holding several named profiles. Each profile is
+// a ConfigContainer in the same format as a single client configuration file, layered over Defaults,
+// so that settings shared by every tenant only have to be written once:
+//
+//	{
+//	  "default_profile": "prod",
+//	  "defaults": { "auth_method": "oauth2", "log_level": "warn", "max_concurrent_requests": 5 },
+//	  "profiles": {
+//	    "prod":    { "instance_domain": "https://prod.jamfcloud.com", "client_id": "...", "client_secret": "..." },
+//	    "staging": { "instance_domain": "https://staging.jamfcloud.com", "client_id": "...", "client_secret": "..." }
+//	  }
+//	}
+type ProfilesFile struct {
+	DefaultProfile string                     `json:"default_profile"`
+	Defaults       json.RawMessage            `json:"defaults"`
+	Profiles       map[string]json.RawMessage `json:"profiles"`
+}
+
+// Registry lazily builds and caches one client per profile. It is safe for concurrent use.
+type Registry struct {
+	defaultProfile string
+	configs        map[string]*ConfigContainer
+	opts           []Option
+
+	mu      sync.Mutex
+	entries map[string]*registryEntry
+}
+
+// registryEntry holds the client of a single profile once it has been built.
+type registryEntry struct {
+	mu     sync.Mutex
+	client *Client
+}
+
+// DefaultProfilesPath returns the path of the profiles file: the JAMF_CONFIG_FILE environment variable
+// when set, and ~/.jamfpro/config.json otherwise.
+func DefaultProfilesPath() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the profiles file: %w", err)
+	}
+	return filepath.Join(home, ".jamfpro", "config.json"), nil
+}
+
+// LoadProfiles reads the profiles file at path, or at DefaultProfilesPath when path is empty, and
+// returns a registry for its profiles. The options are applied to every profile after its
+// configuration, for example to share a logger or transport between tenants.
+func LoadProfiles(path string, opts ...Option) (*Registry, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultProfilesPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read profiles file: %w", err)
+	}
+
+	var file ProfilesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("could not unmarshal profiles file %s: %w", path, err)
+	}
+
+	configs := make(map[string]*ConfigContainer, len(file.Profiles))
+	for name, profile := range file.Profiles {
+		config := defaultConfig()
+		for _, layer := range []json.RawMessage{file.Defaults, profile} {
+			if len(layer) == 0 {
+				continue
+			}
+			if err := json.Unmarshal(layer, config); err != nil {
+				return nil, fmt.Errorf("could not unmarshal profile %q in %s: %w", name, path, err)
+			}
+		}
+		configs[name] = config
+	}
+
+	registry := NewRegistry(configs, opts...)
+	registry.defaultProfile = file.DefaultProfile
+	return registry, nil
+}
+
+// NewRegistry returns a registry for profiles defined in code. The configurations are copied, and the
+// options are applied to every profile after its configuration.
+func NewRegistry(profiles map[string]*ConfigContainer, opts ...Option) *Registry {
+	configs := make(map[string]*ConfigContainer, len(profiles))
+	for name, config := range profiles {
+		copied := *config
+		configs[name] = &copied
+	}
+
+	return &Registry{
+		configs: configs,
+		opts:    opts,
+		entries: map[string]*registryEntry{},
+	}
+}
+
+// Profiles returns the names of every profile in the registry, sorted.
+func (r *Registry) Profiles() []string {
+	names := make([]string, 0, len(r.configs))
+	for name := range r.configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ProfileName returns the name of the selected profile: the JAMF_PROFILE environment variable when
+// set, then the file's default_profile, then "default".
+func (r *Registry) ProfileName() string {
+	if name := os.Getenv(EnvProfile); name != "" {
+		return name
+	}
+	if r.defaultProfile != "" {
+		return r.defaultProfile
+	}
+	return DefaultProfileName
+}
+
+// Config returns a copy of the named profile's configuration, with the registry's options applied.
+func (r *Registry) Config(name string) (*ConfigContainer, error) {
+	config, ok := r.configs[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q, available profiles are %s", ErrProfileNotFound, name, strings.Join(r.Profiles(), ", "))
+	}
+
+	copied := *config
+	for _, opt := range r.opts {
+		opt(&copied)
+	}
+	return &copied, nil
+}
+
+// Client returns the client for the named profile, building it on first use. Clients which fail to
+// build are not cached, so a later call retries.
+func (r *Registry) Client(name string) (*Client, error) {
+	config, err := r.Config(name)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	entry, ok := r.entries[name]
+	if !ok {
+		entry = &registryEntry{}
+		r.entries[name] = entry
+	}
+	r.mu.Unlock()
+
+	// Each profile has its own lock so that a slow tenant does not hold up the others.
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.client != nil {
+		return entry.client, nil
+	}
+
+	client, err := BuildClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client for profile %q: %w", name, err)
+	}
+
+	entry.client = client
+	return client, nil
+}
+
+// Default returns the client for the profile selected by ProfileName.
+func (r *Registry) Default() (*Client, error) {
+	return r.Client(r.ProfileName())
+}
+
+// BuildClientWithProfile initializes a new Jamf Pro client from a single profile of the profiles file
+// at path, or at DefaultProfilesPath when path is empty. When profile is empty the profile is selected
+// as described by Registry.ProfileName.
+func BuildClientWithProfile(path, profile string) (*Client, error) {
+	registry, err := LoadProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == "" {
+		profile = registry.ProfileName()
+	}
+	return registry.Client(profile)
+}
@@ -0,0 +1,85 @@
+// api_client_profiles_test.go
+package jamfpro_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+	"go.uber.org/zap"
+)
+
+func TestRegistry(t *testing.T) {
+	prod := jamfprotest.NewServer()
+	defer prod.Close()
+	staging := jamfprotest.NewServer()
+	defer staging.Close()
+
+	if _, err := prod.AddResource("/api/v1/buildings", jamfpro.ResourceBuilding{Name: "Head Office"}); err != nil {
+		t.Fatalf("AddResource() returned error: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	profiles := fmt.Sprintf(`{
+		"default_profile": "prod",
+		"defaults": {"auth_method": "oauth2", "client_id": %q, "client_secret": %q, "max_concurrent_requests": 2},
+		"profiles": {
+			"prod": {"instance_domain": %q},
+			"staging": {"instance_domain": %q, "max_concurrent_requests": 4},
+			"broken": {"instance_domain": %q, "client_secret": "wrong"}
+		}
+	}`, prod.ClientID, prod.ClientSecret, prod.URL, staging.URL, staging.URL)
+	if err := os.WriteFile(path, []byte(profiles), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	registry, err := jamfpro.LoadProfiles(path, jamfpro.WithLogger(zap.NewNop().Sugar()))
+	if err != nil {
+		t.Fatalf("LoadProfiles() returned error: %v", err)
+	}
+
+	if got := fmt.Sprint(registry.Profiles()); got != "[broken prod staging]" {
+		t.Errorf("Profiles() = %s, want [broken prod staging]", got)
+	}
+
+	config, err := registry.Config("staging")
+	if err != nil {
+		t.Fatalf("Config() returned error: %v", err)
+	}
+	if config.MaxConcurrentRequests != 4 || config.ClientID != prod.ClientID || config.FollowRedirects == nil || !*config.FollowRedirects {
+		t.Errorf("Config() = %+v, want the profile layered over the defaults", config)
+	}
+
+	t.Setenv(jamfpro.EnvProfile, "")
+	client, err := registry.Default()
+	if err != nil {
+		t.Fatalf("Default() returned error: %v", err)
+	}
+	if cached, _ := registry.Client("prod"); cached != client {
+		t.Error("Client() did not return the cached client of the default profile")
+	}
+	if buildings, err := client.GetBuildings(""); err != nil || buildings.TotalCount != 1 {
+		t.Errorf("GetBuildings() on prod = %v, %v, want the prod building", buildings, err)
+	}
+
+	t.Setenv(jamfpro.EnvProfile, "staging")
+	client, err = registry.Default()
+	if err != nil {
+		t.Fatalf("Default() with %s=staging returned error: %v", jamfpro.EnvProfile, err)
+	}
+	if buildings, err := client.GetBuildings(""); err != nil || buildings.TotalCount != 0 {
+		t.Errorf("GetBuildings() on staging = %v, %v, want no buildings", buildings, err)
+	}
+
+	if _, err := registry.Client("broken"); err == nil {
+		t.Error("Client() with the wrong client secret returned no error")
+	}
+
+	if _, err := registry.Client("missing"); !errors.Is(err, jamfpro.ErrProfileNotFound) {
+		t.Errorf("Client() for an undefined profile error = %v, want ErrProfileNotFound", err)
+	}
+}
@@ -0,0 +1,155 @@
+// api_client_requests.go
+// Request helpers used by every resource file. All SDK traffic to Jamf Pro is funnelled through
+// doRequest and doMultiPartRequest so that per-call state, such as the caller's context, is applied
+// in a single place rather than in each of the resource methods.
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync/atomic"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+)
+
+// WithContext returns a shallow copy of the client whose requests are bound to ctx.
+// The copy shares the underlying HTTP client, integration and token state with the original,
+// so it is cheap to create one per inbound request or per worker job.
+//
+// Example usage:
+//
+//	inventory, err := client.WithContext(ctx).GetComputersInventory("")
+//
+// Once ctx is cancelled or its deadline passes, the request in flight is aborted, the SDK call
+// returns ctx.Err() and no further requests (for example the remaining pages of a paginated list)
+// are sent. A nil ctx is treated as context.Background().
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	client := *c
+	client.ctx = ctx
+	return &client
+}
+
+// Context returns the context the client's requests are bound to. It defaults to context.Background().
+func (c *Client) Context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
+// doRequest executes a request through the client's middleware and the underlying http client,
+// honouring the client's context. Error responses are returned as *APIError.
+func (c *Client) doRequest(method, endpoint string, body, out interface{}) (*http.Response, error) {
+	return c.handle(&Request{Method: method, Endpoint: endpoint, Body: body, Out: out}, c.send)
+}
+
+// doMultiPartRequest executes a multipart request through the client's middleware and the underlying http client, honouring the client's context.
+func (c *Client) doMultiPartRequest(method, endpoint string, files map[string][]string, formDataFields map[string]string, fileContentTypes map[string]string, formDataPartHeaders map[string]http.Header, out interface{}) (*http.Response, error) {
+	body := &MultipartBody{Files: files, Fields: formDataFields, ContentTypes: fileContentTypes, PartHeaders: formDataPartHeaders}
+	return c.handle(&Request{Method: method, Endpoint: endpoint, Body: body, Out: out}, c.sendMultipart)
+}
+
+// send is the last handler of every request with an encoded body.
+func (c *Client) send(req *Request) (*http.Response, error) {
+	integration := *c.HTTP.Integration
+	data, err := integration.PrepRequestBody(req.Body, req.Method, req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	url := integration.ConstructURL(req.Endpoint)
+	retry := c.config.RetryEligiableRequests && isIdempotent(req.Method)
+
+	return c.execute(req, url, retry, func() (io.Reader, string, error) {
+		return bytes.NewReader(data), "", nil
+	})
+}
+
+// sendMultipart is the last handler of every multipart request. Multipart requests are not retried.
+func (c *Client) sendMultipart(req *Request) (*http.Response, error) {
+	body, ok := req.Body.(*MultipartBody)
+	if !ok {
+		return nil, fmt.Errorf("multipart request body must be a *MultipartBody, got %T", req.Body)
+	}
+	if req.Method != http.MethodPost && req.Method != http.MethodPut {
+		return nil, fmt.Errorf("unsupported HTTP method for multipart request: %s", req.Method)
+	}
+	url := (*c.HTTP.Integration).GetFQDN() + req.Endpoint
+
+	return c.execute(req, url, false, func() (io.Reader, string, error) {
+		return newMultipartBody(body)
+	})
+}
+
+// requestStateKey carries the requestState of an HTTP request on its context.
+type requestStateKey struct{}
+
+// requestState is the state of an SDK request which requestTransport applies to its attempts.
+type requestState struct {
+	header   http.Header
+	op       *operation
+	template string
+	page     int
+	attempts atomic.Int32
+}
+
+// withRequestState returns ctx carrying the state requestTransport needs for req, or ctx itself when
+// the request has no headers and is not traced.
+func (c *Client) withRequestState(ctx context.Context, req *Request) context.Context {
+	op := operationFrom(ctx)
+	if op != nil && op.telemetry == nil {
+		op = nil
+	}
+	if len(req.Header) == 0 && op == nil {
+		return ctx
+	}
+
+	state := &requestState{header: req.Header, op: op, page: -1}
+	if op != nil {
+		state.template, _, state.page = endpointTemplate(req.Endpoint)
+	}
+	return context.WithValue(ctx, requestStateKey{}, state)
+}
+
+// newProdExecutor returns the executor used to send SDK requests, with the client's transport
+// wrapped so that the headers and telemetry of each SDK request are applied to its attempts.
+func newProdExecutor(client *http.Client) *httpclient.ProdExecutor {
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client.Transport = &requestTransport{base: base}
+	return &httpclient.ProdExecutor{Client: client}
+}
+
+// requestTransport applies the state of the SDK request an attempt belongs to, found on the context
+// of the HTTP request.
+type requestTransport struct {
+	base http.RoundTripper
+}
+
+func (t *requestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	state, ok := req.Context().Value(requestStateKey{}).(*requestState)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+
+	if len(state.header) > 0 {
+		req = req.Clone(req.Context())
+		for key, values := range state.header {
+			req.Header[key] = slices.Clone(values)
+		}
+	}
+
+	if state.op != nil {
+		return state.op.roundTrip(t.base, req, state)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,63 @@
+// api_client_requests_test.go
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithContextAbortsRequest(t *testing.T) {
+	started := make(chan struct{})
+	aborted := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server only notices a closed connection once the body has been read.
+		io.Copy(io.Discard, r.Body)
+		close(started)
+		select {
+		case <-r.Context().Done():
+			close(aborted)
+		case <-time.After(5 * time.Second):
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"1","name":"Head Office"}`))
+		}
+	})
+	client := newTestClient(t, handler, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := client.WithContext(ctx).UpdateBuildingByID("1", &ResourceBuilding{Name: "Head Office"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("UpdateBuildingByID() error = %v, want context.Canceled", err)
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the server did not see the request aborted")
+	}
+
+	if _, err := client.WithContext(ctx).GetBuildingByID("1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetBuildingByID() with a cancelled context error = %v, want context.Canceled", err)
+	}
+}
+
+func TestWithNilContext(t *testing.T) {
+	client := newTestClient(t, failingHandler(0, 0, nil, new(atomic.Int32)), 1)
+
+	bound := client.WithContext(nil)
+	if bound.Context() != context.Background() {
+		t.Errorf("Context() = %v, want context.Background()", bound.Context())
+	}
+	if _, err := bound.doRequest(http.MethodGet, "/api/v1/buildings", nil, &map[string]interface{}{}); err != nil {
+		t.Errorf("doRequest() with a nil context returned error: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+// api_client_rotation.go
+// Rotation of the client credentials of the API integration a client authenticates as. The new
+// credentials are persisted and the live client switches to them before they are verified, since
+// Jamf Pro has already invalidated the previous secret by then.
+package jamfpro
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RotationOptions configures RotateClientCredentials.
+type RotationOptions struct {
+	// IntegrationID is the id of the API integration whose credentials are rotated. When empty, the
+	// integration is found by the client id the client authenticates with.
+	IntegrationID string
+	// Admin generates the new credentials and needs the Update API Integrations privilege. Defaults to
+	// the client being rotated.
+	Admin *Client
+	// Sink receives the new credentials as soon as they are generated, before the client switches to
+	// them, for example to write them to a secret store.
+	Sink func(credentials ResourceClientCredentials) error
+}
+
+// RotationResult describes a completed rotation.
+type RotationResult struct {
+	IntegrationID string
+	Credentials   ResourceClientCredentials
+	// JamfProVersion is the version reported by Jamf Pro when the new credentials were verified. It is
+	// empty when verification failed.
+	JamfProVersion string
+	// RotatedAt is when the client switched to the new credentials.
+	RotatedAt time.Time
+}
+
+// RotateClientCredentials generates new client credentials for an API integration and rolls them out:
+//
+//  1. new credentials are generated with RefreshClientCredentialsByApiRoleID,
+//  2. they are handed to opts.Sink,
+//  3. the client switches to them. Requests already in flight keep their current token, and the next
+//     request obtains a token with the new credentials. Clients derived with WithContext switch too.
+//  4. they are verified by obtaining a token and calling GetJamfProVersion with them.
+//
+// Jamf Pro replaces the previous secret as soon as new credentials are generated, so there is no
+// window in which both secrets can obtain a token: only tokens issued before the rotation stay valid
+// until they expire. Once new credentials have been generated the result is therefore always returned,
+// and the client always switches. A failure of the sink or of verification is returned alongside the
+// result, joined with errors.Join when both fail.
+//
+// The client must have been built from a configuration using OAuth2, rather than a custom Integration.
+func (c *Client) RotateClientCredentials(opts RotationOptions) (*RotationResult, error) {
+	c, done := c.startOperation("RotateClientCredentials")
+	defer done()
+
+	if c.credentials == nil || c.config.AuthMethod != AuthMethodOAuth2 {
+		return nil, errors.New("client credentials can only be rotated for clients built with oauth2 authentication")
+	}
+
+	admin := opts.Admin
+	if admin == nil {
+		admin = c
+	}
+
+	integrationID := opts.IntegrationID
+	if integrationID == "" {
+		var err error
+		if integrationID, err = admin.apiIntegrationIDByClientID(c.credentials.clientID()); err != nil {
+			return nil, err
+		}
+	}
+
+	generated, err := admin.RefreshClientCredentialsByApiRoleID(integrationID)
+	if err != nil {
+		return nil, err
+	}
+	result := &RotationResult{IntegrationID: integrationID, Credentials: *generated}
+
+	var sinkErr error
+	if opts.Sink != nil {
+		if sinkErr = opts.Sink(*generated); sinkErr != nil {
+			sinkErr = fmt.Errorf("failed to store new client credentials for api integration %s: %w", integrationID, sinkErr)
+		}
+	}
+
+	c.credentials.switchCredentials(Credentials{ID: generated.ClientID, Secret: generated.ClientSecret})
+	result.RotatedAt = time.Now()
+
+	version, verifyErr := c.verifyClientCredentials(*generated)
+	if verifyErr != nil {
+		verifyErr = fmt.Errorf("the client switched to new client credentials for api integration %s, but they failed verification: %w", integrationID, verifyErr)
+	}
+	result.JamfProVersion = version
+
+	return result, errors.Join(sinkErr, verifyErr)
+}
+
+// apiIntegrationIDByClientID returns the id of the API integration with clientID. It returns a
+// *NotFoundError when no integration has exactly that client id, and an *AmbiguousError when several do.
+func (c *Client) apiIntegrationIDByClientID(clientID string) (string, error) {
+	integration, err := getOneByName(c, uriApiIntegrations, "api integration", "clientId", clientID,
+		func(value ResourceApiIntegration) string { return value.ClientID })
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(integration.ID), nil
+}
+
+// verifyClientCredentials builds a separate client with credentials, which obtains a token, and
+// returns the Jamf Pro version reported to it. The verifier is neither audited, traced nor a dry run,
+// so that the credentials are always checked against Jamf Pro.
+func (c *Client) verifyClientCredentials(credentials ResourceClientCredentials) (string, error) {
+	config := c.config
+	config.ClientID, config.ClientSecret = credentials.ClientID, credentials.ClientSecret
+	config.Credentials = nil
+	config.CredentialSource = nil
+	config.Integration = nil
+	config.DryRun = false
+	config.AuditLogPath, config.AuditSink = "", nil
+	config.TracerProvider, config.MeterProvider = nil, nil
+
+	verifier, err := BuildClient(&config)
+	if err != nil {
+		return "", err
+	}
+
+	version, err := verifier.WithContext(c.Context()).GetJamfProVersion()
+	if err != nil {
+		return "", err
+	}
+	if version.Version == nil {
+		return "", nil
+	}
+	return *version.Version, nil
+}
@@ -0,0 +1,238 @@
+// api_client_rotation_test.go
+package jamfpro_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+// bootstrapClientConfig provisions an API integration on srv and returns its OAuth2 configuration.
+func bootstrapClientConfig(t *testing.T, srv *jamfprotest.Server) *jamfpro.ConfigContainer {
+	t.Helper()
+
+	admin := srv.Config()
+	admin.AuthMethod = jamfpro.AuthMethodBasic
+	admin.Username, admin.Password = srv.Username, srv.Password
+
+	result, err := jamfpro.BootstrapOAuthClient(admin, jamfpro.BootstrapOptions{
+		RoleName:        "Rotation",
+		Privileges:      []string{"Read Buildings", "Update API Integrations"},
+		IntegrationName: "Rotation",
+	})
+	if err != nil {
+		t.Fatalf("BootstrapOAuthClient() returned error: %v", err)
+	}
+	return result.Config
+}
+
+func TestRotateClientCredentials(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	config := bootstrapClientConfig(t, srv)
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+
+	// Keep requests flowing while the credentials are rotated.
+	stop := make(chan struct{})
+	errs := make(chan error, 100)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, err := client.GetBuildings(""); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	var stored jamfpro.ResourceClientCredentials
+	before := time.Now()
+	result, err := client.RotateClientCredentials(jamfpro.RotationOptions{
+		Sink: func(credentials jamfpro.ResourceClientCredentials) error {
+			stored = credentials
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("RotateClientCredentials() returned error: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, err := client.GetBuildings(""); err != nil {
+			t.Errorf("GetBuildings() after rotation returned error: %v", err)
+		}
+	}
+	close(stop)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("GetBuildings() during rotation returned error: %v", err)
+	}
+
+	if stored != result.Credentials || stored.ClientSecret == config.ClientSecret || stored.ClientID != config.ClientID {
+		t.Errorf("sink received %+v, want the new secret for client id %s", stored, config.ClientID)
+	}
+	if result.RotatedAt.Before(before) || result.JamfProVersion != srv.Version {
+		t.Errorf("RotateClientCredentials() = %+v, want the rotation time and Jamf Pro version", result)
+	}
+	if _, err := jamfpro.BuildClient(config); err == nil {
+		t.Error("BuildClient() with the replaced client secret returned no error")
+	}
+}
+
+func TestRotateClientCredentialsWithStaleProvider(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	config := bootstrapClientConfig(t, srv)
+	path := filepath.Join(t.TempDir(), "client-secret")
+	if err := os.WriteFile(path, []byte(config.ClientSecret), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config.ClientSecret = ""
+	config.CredentialSource = &jamfpro.CredentialSource{File: path}
+
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+
+	sinkErr := errors.New("secret store unavailable")
+	result, err := client.RotateClientCredentials(jamfpro.RotationOptions{
+		Sink: func(jamfpro.ResourceClientCredentials) error { return sinkErr },
+	})
+	if !errors.Is(err, sinkErr) || result == nil || result.Credentials.ClientSecret == "" {
+		t.Fatalf("RotateClientCredentials() = %+v, %v, want the new credentials and the sink error", result, err)
+	}
+
+	// The file still holds the replaced secret, which must not be switched back to.
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() after rotation returned error: %v", err)
+	}
+}
+
+// failingVersionTransport fails every request for the Jamf Pro version once fail is set.
+type failingVersionTransport struct {
+	base http.RoundTripper
+	fail atomic.Bool
+}
+
+func (t *failingVersionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.fail.Load() && req.URL.Path == "/api/v1/jamf-pro-version" {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Content-Type": {"text/plain"}},
+			Body:       io.NopCloser(strings.NewReader("unavailable")),
+			Request:    req,
+		}, nil
+	}
+	return t.base.RoundTrip(req)
+}
+
+func TestRotateClientCredentialsWithFailedVerification(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	config := bootstrapClientConfig(t, srv)
+	transport := &failingVersionTransport{base: config.Transport}
+	config.Transport = transport
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+
+	var stored jamfpro.ResourceClientCredentials
+	transport.fail.Store(true)
+	result, err := client.RotateClientCredentials(jamfpro.RotationOptions{
+		Sink: func(credentials jamfpro.ResourceClientCredentials) error {
+			stored = credentials
+			return nil
+		},
+	})
+	if !errors.Is(err, jamfpro.ErrServer) || result == nil {
+		t.Fatalf("RotateClientCredentials() = %+v, %v, want the result and the verification error", result, err)
+	}
+	if stored != result.Credentials || stored.ClientSecret == config.ClientSecret {
+		t.Errorf("sink received %+v, want the new credentials %+v", stored, result.Credentials)
+	}
+	if result.RotatedAt.IsZero() || result.JamfProVersion != "" {
+		t.Errorf("RotateClientCredentials() = %+v, want a rotation time and no Jamf Pro version", result)
+	}
+
+	// The client keeps working with the credentials it switched to.
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() after rotation returned error: %v", err)
+	}
+}
+
+func TestRotateClientCredentialsMatchesClientIDExactly(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	config := bootstrapClientConfig(t, srv)
+	spans := tracetest.NewSpanRecorder()
+	config.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() returned error: %v", err)
+	}
+
+	// Jamf Pro compares client ids case insensitively, so the filter also returns this integration.
+	if _, err := srv.AddResource("/api/v1/api-integrations", jamfpro.ResourceApiIntegration{
+		DisplayName: "Other", ClientID: strings.ToUpper(config.ClientID),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.RotateClientCredentials(jamfpro.RotationOptions{})
+	if err != nil {
+		t.Fatalf("RotateClientCredentials() returned error: %v", err)
+	}
+	if result.Credentials.ClientID != config.ClientID {
+		t.Errorf("rotated client id = %s, want %s", result.Credentials.ClientID, config.ClientID)
+	}
+
+	// The verification call is made by a separate client which is not traced.
+	for _, span := range spans.Ended() {
+		if span.Name() == "jamfpro.GetJamfProVersion" {
+			t.Error("verification of the new credentials was traced")
+		}
+	}
+
+	// Several integrations with the client id cannot be told apart.
+	if _, err := srv.AddResource("/api/v1/api-integrations", jamfpro.ResourceApiIntegration{
+		DisplayName: "Copy", ClientID: config.ClientID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var ambiguous *jamfpro.AmbiguousError
+	if _, err := client.RotateClientCredentials(jamfpro.RotationOptions{}); !errors.As(err, &ambiguous) {
+		t.Errorf("RotateClientCredentials() with a duplicated client id error = %v, want *AmbiguousError", err)
+	}
+}
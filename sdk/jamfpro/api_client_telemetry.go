@@ -0,0 +1,241 @@
+// api_client_telemetry.go
+// Optional OpenTelemetry instrumentation. Every SDK call is recorded as an operation span named after
+// the client method, with a child span per HTTP attempt of each of its requests, including every page
+// of a list, and request counts, latencies and throttling events are recorded as metrics. Attempts
+// are tied to their operation by the context of their request, see requestTransport.
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// instrumentationName identifies the SDK to tracer and meter providers.
+const instrumentationName = "github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+
+// Attribute keys recorded on spans and metrics, in addition to the OpenTelemetry HTTP conventions.
+const (
+	AttributeOperation  = attribute.Key("jamfpro.operation")
+	AttributeResourceID = attribute.Key("jamfpro.resource_id")
+	AttributePage       = attribute.Key("jamfpro.page")
+	AttributeRetries    = attribute.Key("jamfpro.retries")
+)
+
+const (
+	attributeMethod     = attribute.Key("http.request.method")
+	attributeStatusCode = attribute.Key("http.response.status_code")
+	attributeResend     = attribute.Key("http.request.resend_count")
+	attributeTemplate   = attribute.Key("url.template")
+	attributeServer     = attribute.Key("server.address")
+)
+
+// telemetry holds the instruments of a client built with a TracerProvider or MeterProvider.
+type telemetry struct {
+	tracer    trace.Tracer
+	requests  metric.Int64Counter
+	latency   metric.Float64Histogram
+	duration  metric.Float64Histogram
+	throttled metric.Int64Counter
+}
+
+// newTelemetry returns the instruments for config, or nil when neither provider is configured.
+func newTelemetry(config *ConfigContainer) (*telemetry, error) {
+	if config.TracerProvider == nil && config.MeterProvider == nil {
+		return nil, nil
+	}
+
+	var tracerProvider trace.TracerProvider = tracenoop.NewTracerProvider()
+	if config.TracerProvider != nil {
+		tracerProvider = config.TracerProvider
+	}
+	var meterProvider metric.MeterProvider = metricnoop.NewMeterProvider()
+	if config.MeterProvider != nil {
+		meterProvider = config.MeterProvider
+	}
+
+	meter := meterProvider.Meter(instrumentationName)
+	t := &telemetry{tracer: tracerProvider.Tracer(instrumentationName)}
+
+	var err error
+	if t.requests, err = meter.Int64Counter("jamfpro.client.requests",
+		metric.WithDescription("HTTP requests sent to Jamf Pro, including retries."),
+		metric.WithUnit("{request}")); err != nil {
+		return nil, err
+	}
+	if t.latency, err = meter.Float64Histogram("jamfpro.client.request.duration",
+		metric.WithDescription("Duration of each HTTP request sent to Jamf Pro."),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if t.duration, err = meter.Float64Histogram("jamfpro.client.operation.duration",
+		metric.WithDescription("Duration of each SDK operation, including retries and backoff."),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if t.throttled, err = meter.Int64Counter("jamfpro.client.throttled",
+		metric.WithDescription("Responses from Jamf Pro with status 429 Too Many Requests."),
+		metric.WithUnit("{response}")); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// recordRequest attributes a finished request of the operation to it. The operation span is named
+// after the endpoint of its first request and reports the status and error of its last.
+func (op *operation) recordRequest(method, endpoint string, err error) {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	op.requests++
+	if op.method == "" {
+		op.method = method
+		op.template, op.resourceID, _ = endpointTemplate(endpoint)
+	}
+	op.err = err
+}
+
+// end ends the operation span and records the duration of the operation.
+func (op *operation) end() {
+	t := op.telemetry
+	if t == nil {
+		return
+	}
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	attrs := []attribute.KeyValue{AttributeOperation.String(op.name)}
+	if op.method != "" {
+		attrs = append(attrs, attributeMethod.String(op.method), attributeTemplate.String(op.template))
+	}
+	op.span.SetAttributes(attrs...)
+	if op.resourceID != "" {
+		op.span.SetAttributes(AttributeResourceID.String(op.resourceID))
+	}
+	if op.status != 0 {
+		op.span.SetAttributes(attributeStatusCode.Int(op.status))
+	}
+	if retries := op.attempts - op.requests; retries > 0 {
+		op.span.SetAttributes(AttributeRetries.Int(retries))
+	}
+	if op.err != nil {
+		op.span.RecordError(op.err)
+		op.span.SetStatus(codes.Error, op.err.Error())
+	}
+	op.span.End()
+
+	ctx := trace.ContextWithSpan(context.Background(), op.span)
+	t.duration.Record(ctx, time.Since(op.start).Seconds(), metric.WithAttributes(attrs...))
+}
+
+// roundTrip sends a single HTTP attempt of the request with base, recording a span, which is a child
+// of the operation span, and metrics.
+func (op *operation) roundTrip(base http.RoundTripper, req *http.Request, state *requestState) (*http.Response, error) {
+	t := op.telemetry
+	resend := state.attempts.Add(1) - 1
+	op.mu.Lock()
+	op.attempts++
+	op.mu.Unlock()
+
+	ctx := req.Context()
+	attrs := []attribute.KeyValue{AttributeOperation.String(op.name), attributeMethod.String(req.Method), attributeTemplate.String(state.template)}
+	spanAttrs := append(slices.Clip(attrs), attributeServer.String(req.URL.Hostname()), attributeResend.Int(int(resend)))
+	if state.page >= 0 {
+		spanAttrs = append(spanAttrs, AttributePage.Int(state.page))
+	}
+	_, span := t.tracer.Start(ctx, req.Method+" "+state.template, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(spanAttrs...))
+	defer span.End()
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	latency := time.Since(start).Seconds()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+		t.latency.Record(ctx, latency, metric.WithAttributes(attrs...))
+		return resp, err
+	}
+
+	op.mu.Lock()
+	op.status = resp.StatusCode
+	op.mu.Unlock()
+	span.SetAttributes(attributeStatusCode.Int(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		span.AddEvent("throttled", trace.WithAttributes(attribute.String("retry_after", resp.Header.Get("Retry-After"))))
+		t.throttled.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	attrs = append(attrs, attributeStatusCode.Int(resp.StatusCode))
+	t.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+	t.latency.Record(ctx, latency, metric.WithAttributes(attrs...))
+	return resp, nil
+}
+
+// uuidSegment matches path segments holding a UUID.
+var uuidSegment = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// classicLookupKeys are the Classic API path segments which are followed by a lookup value, as in
+// /JSSResource/computers/serialnumber/C02XXXXXXXX.
+var classicLookupKeys = map[string]bool{
+	"id": true, "name": true, "udid": true, "serialnumber": true, "macaddress": true,
+	"username": true, "email": true, "userid": true, "computerid": true, "deviceid": true,
+}
+
+// endpointTemplate replaces the identifiers in endpoint with placeholders, so that the template can be
+// used as a low-cardinality attribute, and returns the resource id and the requested page, or -1 when
+// the endpoint is not paginated.
+func endpointTemplate(endpoint string) (template, resourceID string, page int) {
+	path, rawQuery, _ := strings.Cut(endpoint, "?")
+
+	page = -1
+	if rawQuery != "" {
+		if query, err := url.ParseQuery(rawQuery); err == nil {
+			if value, err := strconv.Atoi(query.Get("page")); err == nil {
+				page = value
+			}
+		}
+	}
+
+	segments := strings.Split(path, "/")
+	classic := strings.HasPrefix(path, "/JSSResource/")
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		if classic && i > 0 && classicLookupKeys[segments[i-1]] {
+			if segments[i-1] == "id" && resourceID == "" {
+				resourceID = segment
+			}
+			segments[i] = "{" + segments[i-1] + "}"
+			continue
+		}
+
+		if _, err := strconv.ParseUint(segment, 10, 64); err == nil || uuidSegment.MatchString(segment) {
+			if resourceID == "" {
+				resourceID = segment
+			}
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/"), resourceID, page
+}
@@ -0,0 +1,263 @@
+// api_client_telemetry_test.go
+package jamfpro_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+// throttleTransport answers the first request for path with 429 Too Many Requests.
+type throttleTransport struct {
+	path      string
+	throttled atomic.Bool
+}
+
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == t.path && t.throttled.CompareAndSwap(false, true) {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{"1"}, "Content-Type": []string{"application/json"}},
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestTelemetry(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	id, err := srv.AddResource("/api/v1/buildings", jamfpro.ResourceBuilding{Name: "Head Office"})
+	if err != nil {
+		t.Fatalf("AddResource() returned error: %v", err)
+	}
+	if _, err := srv.AddResource("/JSSResource/computergroups", jamfpro.ResourceComputerGroup{Name: "All Macs"}); err != nil {
+		t.Fatalf("AddResource() returned error: %v", err)
+	}
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client, err := jamfpro.New(
+		jamfpro.WithConfig(srv.Config()),
+		jamfpro.WithRetry(3, time.Minute),
+		jamfpro.WithTransport(&throttleTransport{path: "/api/v1/buildings/" + id}),
+		jamfpro.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		jamfpro.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildingByID(id); err != nil {
+		t.Fatalf("GetBuildingByID() returned error: %v", err)
+	}
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Fatalf("GetBuildings() returned error: %v", err)
+	}
+	if _, err := client.GetComputerGroupByName("All Macs"); err != nil {
+		t.Fatalf("GetComputerGroupByName() returned error: %v", err)
+	}
+
+	operations := map[string]sdktrace.ReadOnlySpan{}
+	attempts := map[string][]sdktrace.ReadOnlySpan{}
+	for _, span := range spans.Ended() {
+		if span.Parent().IsValid() {
+			attempts[span.Parent().SpanID().String()] = append(attempts[span.Parent().SpanID().String()], span)
+		} else {
+			operations[span.Name()] = span
+		}
+	}
+
+	tests := []struct {
+		operation    string
+		template     string
+		attempts     int
+		attrs        map[attribute.Key]attribute.Value
+		attemptAttrs map[attribute.Key]attribute.Value
+	}{
+		{
+			operation: "jamfpro.GetBuildingByID",
+			template:  "/api/v1/buildings/{id}",
+			attempts:  2,
+			attrs: map[attribute.Key]attribute.Value{
+				jamfpro.AttributeResourceID: attribute.StringValue(id),
+				jamfpro.AttributeRetries:    attribute.IntValue(1),
+				"http.response.status_code": attribute.IntValue(http.StatusOK),
+			},
+		},
+		{
+			operation:    "jamfpro.GetBuildings",
+			template:     "/api/v1/buildings",
+			attempts:     1,
+			attemptAttrs: map[attribute.Key]attribute.Value{jamfpro.AttributePage: attribute.IntValue(0)},
+		},
+		{
+			operation: "jamfpro.GetComputerGroupByName",
+			template:  "/JSSResource/computergroups/name/{name}",
+			attempts:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			span, ok := operations[tt.operation]
+			if !ok {
+				t.Fatalf("no span named %q, got %v", tt.operation, operations)
+			}
+
+			if template, _ := spanAttribute(span, "url.template"); template.AsString() != tt.template {
+				t.Errorf("url.template = %q, want %q", template.AsString(), tt.template)
+			}
+			for key, want := range tt.attrs {
+				if got, _ := spanAttribute(span, key); got != want {
+					t.Errorf("%s = %v, want %v", key, got.Emit(), want.Emit())
+				}
+			}
+
+			children := attempts[span.SpanContext().SpanID().String()]
+			if len(children) != tt.attempts {
+				t.Fatalf("got %d attempt spans, want %d", len(children), tt.attempts)
+			}
+			for _, child := range children {
+				if !strings.HasPrefix(child.Name(), "GET "+tt.template) {
+					t.Errorf("attempt span name = %q", child.Name())
+				}
+				for key, want := range tt.attemptAttrs {
+					if got, _ := spanAttribute(child, key); got != want {
+						t.Errorf("attempt %s = %v, want %v", key, got.Emit(), want.Emit())
+					}
+				}
+			}
+		})
+	}
+
+	var data metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &data); err != nil {
+		t.Fatalf("Collect() returned error: %v", err)
+	}
+
+	sums := map[string]int64{}
+	histograms := map[string]uint64{}
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch d := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, point := range d.DataPoints {
+					sums[m.Name] += point.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, point := range d.DataPoints {
+					histograms[m.Name] += point.Count
+				}
+			}
+		}
+	}
+
+	if sums["jamfpro.client.requests"] != 4 {
+		t.Errorf("jamfpro.client.requests = %d, want 4", sums["jamfpro.client.requests"])
+	}
+	if sums["jamfpro.client.throttled"] != 1 {
+		t.Errorf("jamfpro.client.throttled = %d, want 1", sums["jamfpro.client.throttled"])
+	}
+	if histograms["jamfpro.client.request.duration"] != 4 {
+		t.Errorf("jamfpro.client.request.duration count = %d, want 4", histograms["jamfpro.client.request.duration"])
+	}
+	if histograms["jamfpro.client.operation.duration"] != 3 {
+		t.Errorf("jamfpro.client.operation.duration count = %d, want 3", histograms["jamfpro.client.operation.duration"])
+	}
+}
+
+func TestTelemetryParallelPagination(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	for i := 0; i < 450; i++ {
+		if _, err := srv.AddResource("/api/v1/buildings", jamfpro.ResourceBuilding{Name: "Building"}); err != nil {
+			t.Fatalf("AddResource() returned error: %v", err)
+		}
+	}
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	client, err := jamfpro.New(
+		jamfpro.WithConfig(srv.Config()),
+		jamfpro.WithParallelPagination(),
+		jamfpro.WithConcurrency(4),
+		jamfpro.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		jamfpro.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Fatalf("GetBuildings() returned error: %v", err)
+	}
+
+	// The three pages are fetched within a single operation.
+	var roots []sdktrace.ReadOnlySpan
+	pages := map[int64]bool{}
+	for _, span := range spans.Ended() {
+		if !span.Parent().IsValid() {
+			roots = append(roots, span)
+			continue
+		}
+		page, _ := spanAttribute(span, jamfpro.AttributePage)
+		pages[page.AsInt64()] = true
+	}
+
+	if len(roots) != 1 || roots[0].Name() != "jamfpro.GetBuildings" {
+		t.Fatalf("got %d root spans, want one named jamfpro.GetBuildings", len(roots))
+	}
+	for _, span := range spans.Ended() {
+		if span.Parent().IsValid() && span.Parent().SpanID() != roots[0].SpanContext().SpanID() {
+			t.Errorf("span %q is not a child of the operation span", span.Name())
+		}
+	}
+	if len(pages) != 3 {
+		t.Errorf("got attempt spans for pages %v, want 0, 1 and 2", pages)
+	}
+
+	var data metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &data); err != nil {
+		t.Fatalf("Collect() returned error: %v", err)
+	}
+	var operations uint64
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != "jamfpro.client.operation.duration" {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Histogram[float64]).DataPoints {
+				operations += point.Count
+			}
+		}
+	}
+	if operations != 1 {
+		t.Errorf("jamfpro.client.operation.duration count = %d, want 1", operations)
+	}
+}
@@ -0,0 +1,246 @@
+// api_client_transport.go
+// Sends SDK requests to Jamf Pro. The http client's DoRequest builds every request on
+// context.Background(), so a cancelled context could only stop the SDK waiting for a request, never
+// abort it. Requests are therefore built with the client's integration, which encodes bodies, builds
+// URLs and adds authentication, and sent with the client's executor on the context of the SDK
+// request, which also carries the state requestTransport applies to each attempt. Retries, backoff,
+// concurrency permits and the mandatory request delay follow the http client: idempotent requests
+// which fail with a retryable status are retried with backoff, honouring Retry-After when throttled.
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/ratehandler"
+	"github.com/deploymenttheory/go-api-http-client/response"
+)
+
+// bodyFunc returns a fresh request body and its content type, or "" to leave the content type to the
+// integration. It is called once per attempt.
+type bodyFunc func() (io.Reader, string, error)
+
+// execute sends req to url on its context, retrying when retry is set, and decodes a successful
+// response into req.Out. Error responses are returned as *APIError alongside the response.
+func (c *Client) execute(req *Request, url string, retry bool, newBody bodyFunc) (*http.Response, error) {
+	ctx := req.Context
+	if ctx == nil {
+		ctx = c.Context()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	ctx = c.withRequestState(ctx, req)
+
+	resp, err := c.executeWithRetries(ctx, req.Method, url, retry, newBody, req.Out)
+	if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+		// An aborted request fails with the error of the context rather than of the transport.
+		err = ctxErr
+	}
+	if op := operationFrom(ctx); op != nil && op.telemetry != nil {
+		op.recordRequest(req.Method, req.Endpoint, err)
+	}
+	return resp, err
+}
+
+func (c *Client) executeWithRetries(ctx context.Context, method, url string, retry bool, newBody bodyFunc, out interface{}) (*http.Response, error) {
+	sugar := c.HTTP.Sugar
+	deadline := time.Now().Add(time.Duration(c.config.TotalRetryDuration) * time.Second)
+
+	retries := 0
+	for {
+		resp, err := c.attempt(ctx, method, url, newBody, out)
+		if err != nil || resp.StatusCode < http.StatusBadRequest {
+			return resp, err
+		}
+		if !retry || response.IsNonRetryableStatusCode(resp.StatusCode) {
+			return resp, newAPIError(resp, sugar)
+		}
+
+		var wait time.Duration
+		if resp.StatusCode == http.StatusTooManyRequests {
+			wait = ratehandler.ParseRateLimitHeaders(resp, sugar)
+		}
+		if wait == 0 && response.IsRetryableStatusCode(resp.StatusCode) {
+			retries++
+			if retries <= c.config.MaxRetryAttempts {
+				wait = ratehandler.CalculateBackoff(retries)
+			}
+		}
+		if wait == 0 || time.Now().Add(wait).After(deadline) {
+			return resp, newAPIError(resp, sugar)
+		}
+
+		c.Logger().Debug("Retrying request", "method", method, "url", url, "status_code", resp.StatusCode, "wait", wait)
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends a single HTTP request. A successful response is decoded into out, and the body of an
+// error response is read into memory, so that either outlives the timeout of the attempt.
+func (c *Client) attempt(ctx context.Context, method, url string, newBody bodyFunc, out interface{}) (*http.Response, error) {
+	if timeout := time.Duration(c.config.CustomTimeout) * time.Second; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if concurrency := c.HTTP.Concurrency; concurrency != nil {
+		_, permit, err := concurrency.AcquireConcurrencyPermit(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire concurrency permit: %w", err)
+		}
+		defer concurrency.ReleaseConcurrencyPermit(permit)
+
+		concurrency.Metrics.Lock()
+		concurrency.Metrics.TotalRequests++
+		concurrency.Metrics.Unlock()
+	}
+
+	body, contentType, err := newBody()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err == nil {
+		err = (*c.HTTP.Integration).PrepRequestParamsAndAuth(req)
+	}
+	if err != nil {
+		// Closing the body stops the writer of a multipart body.
+		if closer, ok := body.(io.Closer); ok {
+			closer.Close()
+		}
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	start := time.Now()
+	resp, err := c.executor.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.HTTP.Concurrency != nil {
+		c.HTTP.Concurrency.EvaluateAndAdjustConcurrency(resp, time.Since(start))
+	}
+	c.HTTP.CheckDeprecationHeader(resp)
+
+	if resp.StatusCode < http.StatusBadRequest {
+		err = response.HandleAPISuccessResponse(resp, out, c.HTTP.Sugar)
+	} else {
+		var data []byte
+		data, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	if err == nil {
+		err = sleep(ctx, time.Duration(c.config.MandatoryRequestDelay)*time.Millisecond)
+	}
+	return resp, err
+}
+
+// sleep waits for d, returning early with the error of ctx once it is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isIdempotent reports whether requests with method may be retried.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// newMultipartBody streams body as multipart form data. File parts are base64 encoded, as Jamf Pro
+// expects from the http client.
+func newMultipartBody(body *MultipartBody) (io.Reader, string, error) {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		err := writeMultipartBody(writer, body)
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return pr, writer.FormDataContentType(), nil
+}
+
+// writeMultipartBody writes the files and then the fields of body.
+func writeMultipartBody(writer *multipart.Writer, body *MultipartBody) error {
+	for field, paths := range body.Files {
+		for _, path := range paths {
+			if err := writeMultipartFile(writer, field, path, body); err != nil {
+				return err
+			}
+		}
+	}
+	for field, value := range body.Fields {
+		if err := writer.WriteField(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMultipartFile writes the file at path as a base64 encoded part of field.
+func writeMultipartFile(writer *multipart.Writer, field, path string, body *MultipartBody) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	contentType := "application/octet-stream"
+	if value, ok := body.ContentTypes[field]; ok {
+		contentType = value
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, field, filepath.Base(path)))
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "base64")
+	for key, values := range body.PartHeaders[field] {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	encoder := base64.NewEncoder(base64.StdEncoding, part)
+	if _, err := io.Copy(encoder, file); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
@@ -0,0 +1,169 @@
+// api_client_transport_test.go
+package jamfpro
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failingHandler fails the first failures requests with status, setting header on each failure, and
+// answers the rest with an empty JSON object.
+func failingHandler(failures int32, status int, header http.Header, requests *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"httpStatus":` + strconv.Itoa(status) + `,"errors":[]}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+}
+
+func TestExecuteRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		status       int
+		header       http.Header
+		retryEnabled bool
+		wantRequests int32
+		wantErr      bool
+	}{
+		{name: "idempotent request is retried", method: http.MethodGet, status: http.StatusServiceUnavailable, retryEnabled: true, wantRequests: 3},
+		{name: "retries disabled", method: http.MethodGet, status: http.StatusServiceUnavailable, wantRequests: 1, wantErr: true},
+		{name: "non-idempotent request is not retried", method: http.MethodPost, status: http.StatusServiceUnavailable, retryEnabled: true, wantRequests: 1, wantErr: true},
+		{name: "non-retryable status", method: http.MethodGet, status: http.StatusNotFound, retryEnabled: true, wantRequests: 1, wantErr: true},
+		{name: "throttled request waits for Retry-After", method: http.MethodGet, status: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"1"}}, retryEnabled: true, wantRequests: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			client := newTestClient(t, failingHandler(2, tt.status, tt.header, &requests), 1)
+			client.config.RetryEligiableRequests = tt.retryEnabled
+			client.config.MaxRetryAttempts = 3
+			client.config.TotalRetryDuration = 60
+
+			_, err := client.doRequest(tt.method, "/api/v1/buildings", nil, &map[string]interface{}{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("doRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.As(err, new(*APIError)) {
+				t.Errorf("doRequest() error = %T, want *APIError", err)
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("server saw %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestExecuteGivesUpAfterTotalRetryDuration(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, failingHandler(2, http.StatusTooManyRequests, http.Header{"Retry-After": {"30"}}, &requests), 1)
+	client.config.RetryEligiableRequests = true
+	client.config.MaxRetryAttempts = 3
+	client.config.TotalRetryDuration = 5
+
+	start := time.Now()
+	if _, err := client.doRequest(http.MethodGet, "/api/v1/buildings", nil, nil); err == nil {
+		t.Fatal("doRequest() error = nil, want the throttled response")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("doRequest() waited %v although Retry-After exceeds the total retry duration", elapsed)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server saw %d requests, want 1", got)
+	}
+}
+
+func TestExecuteMandatoryDelayHonoursContext(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, failingHandler(0, 0, nil, &requests), 1)
+	client.config.MandatoryRequestDelay = int((10 * time.Second) / time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.WithContext(ctx).doRequest(http.MethodGet, "/api/v1/buildings", nil, &map[string]interface{}{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("doRequest() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("doRequest() waited %v for the mandatory delay after its context was done", elapsed)
+	}
+}
+
+func TestSendMultipart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "icon.png")
+	content := []byte("not really a png")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	type part struct {
+		name, filename, contentType, encoding, body string
+	}
+	var parts []part
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		if err != nil {
+			t.Errorf("request is not multipart: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for {
+			p, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("failed to read part: %v", err)
+				break
+			}
+			body, _ := io.ReadAll(p)
+			parts = append(parts, part{p.FormName(), p.FileName(), p.Header.Get("Content-Type"), p.Header.Get("Content-Transfer-Encoding"), string(body)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+	client := newTestClient(t, handler, 1)
+
+	files := map[string][]string{"file": {path}}
+	fields := map[string]string{"name": "icon"}
+	contentTypes := map[string]string{"file": "image/png"}
+	if _, err := client.doMultiPartRequest(http.MethodPost, "/api/v1/icon", files, fields, contentTypes, nil, &map[string]interface{}{}); err != nil {
+		t.Fatalf("doMultiPartRequest() error = %v", err)
+	}
+
+	want := []part{
+		{name: "file", filename: "icon.png", contentType: "image/png", encoding: "base64", body: base64.StdEncoding.EncodeToString(content)},
+		{name: "name", body: "icon"},
+	}
+	if len(parts) != len(want) {
+		t.Fatalf("server saw parts %+v, want %+v", parts, want)
+	}
+	for i := range want {
+		if parts[i] != want[i] {
+			t.Errorf("part %d = %+v, want %+v", i, parts[i], want[i])
+		}
+	}
+
+	if _, err := client.doMultiPartRequest(http.MethodGet, "/api/v1/icon", files, nil, nil, nil, nil); err == nil {
+		t.Error("doMultiPartRequest() with GET error = nil, want an unsupported method error")
+	}
+}
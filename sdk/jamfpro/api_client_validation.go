@@ -0,0 +1,121 @@
+// api_client_validation.go
+// Validation of client configuration, run before a client is built.
+package jamfpro
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Supported values of ConfigContainer.AuthMethod.
+const (
+	AuthMethodOAuth2 = "oauth2"
+	AuthMethodBasic  = "basic"
+)
+
+// ValidationError lists every problem found in a client configuration.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid client configuration: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks the configuration as a whole and returns a *ValidationError listing every problem
+// found, or nil when the configuration can be used to build a client.
+func (config *ConfigContainer) Validate() error {
+	problems := slices.Clone(config.optionProblems)
+	addProblem := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	// Instance and credentials are only needed when the integration is built from the configuration.
+	if config.Integration == nil {
+		if config.InstanceDomain == "" {
+			addProblem("instance domain is required")
+		} else if u, err := url.Parse(config.InstanceDomain); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			addProblem("instance domain %q must be an absolute URL such as https://yourserver.jamfcloud.com", config.InstanceDomain)
+		}
+
+		// A credential provider supplies the secret, and may also supply the id.
+		hasProvider := config.Credentials != nil || config.CredentialSource != nil
+
+		switch config.AuthMethod {
+		case AuthMethodOAuth2:
+			if config.ClientID == "" && !hasProvider {
+				addProblem("client id is required for oauth2 authentication")
+			}
+			if config.ClientSecret == "" && !hasProvider {
+				addProblem("client secret is required for oauth2 authentication")
+			}
+		case AuthMethodBasic:
+			if config.Username == "" && !hasProvider {
+				addProblem("username is required for basic authentication")
+			}
+			if config.Password == "" && !hasProvider {
+				addProblem("password is required for basic authentication")
+			}
+		case "":
+			addProblem("auth method is required, supported methods are %q and %q", AuthMethodOAuth2, AuthMethodBasic)
+		default:
+			addProblem("invalid auth method %q, supported methods are %q and %q", config.AuthMethod, AuthMethodOAuth2, AuthMethodBasic)
+		}
+	}
+
+	if source := config.CredentialSource; source != nil && config.Credentials == nil {
+		set := 0
+		for _, value := range []string{source.Env, source.File, source.Command} {
+			if value != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			addProblem("credential source must set exactly one of env, file and command")
+		}
+	}
+
+	if config.Logger == nil && config.SlogLogger == nil {
+		if _, err := LogLevelStringtoZap(config.LogLevel); err != nil {
+			addProblem("invalid log level %q, supported levels are debug, info, warn, error, dpanic and fatal", config.LogLevel)
+		}
+	}
+
+	if config.EnableConcurrencyManagement && config.MaxConcurrentRequests < 1 {
+		addProblem("max concurrent requests must be at least 1 when concurrency management is enabled, got %d", config.MaxConcurrentRequests)
+	}
+
+	for _, field := range []struct {
+		name  string
+		value int
+	}{
+		{"max retry attempts", config.MaxRetryAttempts},
+		{"max concurrent requests", config.MaxConcurrentRequests},
+		{"custom timeout", config.CustomTimeout},
+		{"token refresh buffer period", config.TokenRefreshBufferPeriod},
+		{"total retry duration", config.TotalRetryDuration},
+		{"max redirects", config.MaxRedirects},
+		{"mandatory request delay", config.MandatoryRequestDelay},
+	} {
+		if field.value < 0 {
+			addProblem("%s cannot be negative, got %d", field.name, field.value)
+		}
+	}
+
+	if config.AuditSink != nil && config.AuditLogPath != "" {
+		addProblem("set at most one of audit sink and audit log path")
+	}
+
+	for i, cookie := range config.CustomCookies {
+		if cookie.Name == "" {
+			addProblem("custom cookie %d has no name", i)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
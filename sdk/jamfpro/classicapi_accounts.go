@@ -77,10 +77,13 @@ type AccountSubsetPrivileges struct {
 
 // GetAccounts retrieves a list of all accounts (both users and groups).
 func (c *Client) GetAccounts() (*ResponseAccountsList, error) {
+	c, done := c.startOperation("GetAccounts")
+	defer done()
+
 	endpoint := uriAPIAccounts
 
 	var accountsList ResponseAccountsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &accountsList)
+	resp, err := c.doRequest("GET", endpoint, nil, &accountsList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "accounts", err)
 	}
@@ -94,10 +97,13 @@ func (c *Client) GetAccounts() (*ResponseAccountsList, error) {
 
 // GetAccountByID retrieves the Account by its ID
 func (c *Client) GetAccountByID(id string) (*ResourceAccount, error) {
+	c, done := c.startOperation("GetAccountByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/userid/%s", uriAPIAccounts, id)
 
 	var account ResourceAccount
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &account)
+	resp, err := c.doRequest("GET", endpoint, nil, &account)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "account", id, err)
 	}
@@ -111,10 +117,13 @@ func (c *Client) GetAccountByID(id string) (*ResourceAccount, error) {
 
 // GetAccountByName retrieves the Account by its name
 func (c *Client) GetAccountByName(name string) (*ResourceAccount, error) {
+	c, done := c.startOperation("GetAccountByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/username/%s", uriAPIAccounts, name)
 
 	var account ResourceAccount
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &account)
+	resp, err := c.doRequest("GET", endpoint, nil, &account)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "account", name, err)
 	}
@@ -128,6 +137,9 @@ func (c *Client) GetAccountByName(name string) (*ResourceAccount, error) {
 
 // CreateAccountByID creates an Account using its ID
 func (c *Client) CreateAccount(account *ResourceAccount) (*ResponseAccountCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateAccount")
+	defer done()
+
 	endpoint := uriAPIAccounts
 
 	requestBody := struct {
@@ -138,7 +150,7 @@ func (c *Client) CreateAccount(account *ResourceAccount) (*ResponseAccountCreate
 	}
 
 	var returnedAccount ResponseAccountCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, &returnedAccount)
+	resp, err := c.doRequest("POST", endpoint, requestBody, &returnedAccount)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "account", err)
 	}
@@ -152,6 +164,9 @@ func (c *Client) CreateAccount(account *ResourceAccount) (*ResponseAccountCreate
 
 // UpdateAccountByID updates an Account using its ID
 func (c *Client) UpdateAccountByID(id string, account *ResourceAccount) (*ResponseAccountCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAccountByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/userid/%s", uriAPIAccounts, id)
 
 	// if account.Site.ID == 0 && account.Site.Name == "" {
@@ -169,7 +184,7 @@ func (c *Client) UpdateAccountByID(id string, account *ResourceAccount) (*Respon
 	}
 
 	var updatedAccount ResponseAccountCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedAccount)
+	resp, err := c.doRequest("PUT", endpoint, requestBody, &updatedAccount)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "account", id, err)
 	}
@@ -183,6 +198,9 @@ func (c *Client) UpdateAccountByID(id string, account *ResourceAccount) (*Respon
 
 // UpdateAccountByName updates an Account using its name.
 func (c *Client) UpdateAccountByName(name string, account *ResourceAccount) (*ResponseAccountCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAccountByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/username/%s", uriAPIAccounts, name)
 
 	// if account.Site.ID == 0 && account.Site.Name == "" {
@@ -200,7 +218,7 @@ func (c *Client) UpdateAccountByName(name string, account *ResourceAccount) (*Re
 	}
 
 	var updatedAccount ResponseAccountCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedAccount)
+	resp, err := c.doRequest("PUT", endpoint, requestBody, &updatedAccount)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "account", name, err)
 	}
@@ -214,9 +232,12 @@ func (c *Client) UpdateAccountByName(name string, account *ResourceAccount) (*Re
 
 // DeleteAccountByID deletes an Account using its ID
 func (c *Client) DeleteAccountByID(id string) error {
+	c, done := c.startOperation("DeleteAccountByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/userid/%s", uriAPIAccounts, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "account", id, err)
 	}
@@ -230,9 +251,12 @@ func (c *Client) DeleteAccountByID(id string) error {
 
 // DeleteAccountByName deletes an Account using its name.
 func (c *Client) DeleteAccountByName(name string) error {
+	c, done := c.startOperation("DeleteAccountByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/username/%s", uriAPIAccounts, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "account", name, err)
 	}
@@ -48,10 +48,13 @@ type MemberUser struct {
 
 // GetAccountGroupByID gets an account group using its ID and returns a response.
 func (c *Client) GetAccountGroupByID(id string) (*ResourceAccountGroup, error) {
+	c, done := c.startOperation("GetAccountGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/groupid/%s", uriAPIAccounts, id)
 
 	var group ResourceAccountGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
+	resp, err := c.doRequest("GET", endpoint, nil, &group)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "account group", id, err)
 	}
@@ -65,10 +68,13 @@ func (c *Client) GetAccountGroupByID(id string) (*ResourceAccountGroup, error) {
 
 // GetAccountByName retrieves the Account by its name
 func (c *Client) GetAccountGroupByName(name string) (*ResourceAccountGroup, error) {
+	c, done := c.startOperation("GetAccountGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/groupname/%s", uriAPIAccounts, name)
 
 	var account ResourceAccountGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &account)
+	resp, err := c.doRequest("GET", endpoint, nil, &account)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "account group", name, err)
 	}
@@ -82,6 +88,9 @@ func (c *Client) GetAccountGroupByName(name string) (*ResourceAccountGroup, erro
 
 // CreateAccountGroupByID creates an Account Group using its ID
 func (c *Client) CreateAccountGroup(accountGroup *ResourceAccountGroup) (*ResponseAccountGroupCreated, error) {
+	c, done := c.startOperation("CreateAccountGroup")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/groupid/0", uriAPIAccounts)
 
 	requestBody := &struct {
@@ -92,7 +101,7 @@ func (c *Client) CreateAccountGroup(accountGroup *ResourceAccountGroup) (*Respon
 	}
 
 	var returnedAccountGroup ResponseAccountGroupCreated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, &returnedAccountGroup)
+	resp, err := c.doRequest("POST", endpoint, requestBody, &returnedAccountGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "account group", err)
 	}
@@ -106,6 +115,9 @@ func (c *Client) CreateAccountGroup(accountGroup *ResourceAccountGroup) (*Respon
 
 // UpdateAccountGroupByID updates an Account Group using its ID
 func (c *Client) UpdateAccountGroupByID(id string, accountGroup *ResourceAccountGroup) (*ResourceAccountGroup, error) {
+	c, done := c.startOperation("UpdateAccountGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/groupid/%s", uriAPIAccounts, id)
 
 	// if accountGroup.Site.ID == 0 && accountGroup.Site.Name == "" {
@@ -123,7 +135,7 @@ func (c *Client) UpdateAccountGroupByID(id string, accountGroup *ResourceAccount
 	}
 
 	var updatedGroup ResourceAccountGroup
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedGroup)
+	resp, err := c.doRequest("PUT", endpoint, requestBody, &updatedGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "account group", id, err)
 	}
@@ -137,6 +149,9 @@ func (c *Client) UpdateAccountGroupByID(id string, accountGroup *ResourceAccount
 
 // UpdateAccountGroupByName updates an Account Group using its name.
 func (c *Client) UpdateAccountGroupByName(name string, accountGroup *ResourceAccountGroup) (*ResourceAccountGroup, error) {
+	c, done := c.startOperation("UpdateAccountGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/groupname/%s", uriAPIAccounts, name)
 
 	// if accountGroup.Site.ID == 0 && accountGroup.Site.Name == "" {
@@ -154,7 +169,7 @@ func (c *Client) UpdateAccountGroupByName(name string, accountGroup *ResourceAcc
 	}
 
 	var updatedGroup ResourceAccountGroup
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedGroup)
+	resp, err := c.doRequest("PUT", endpoint, requestBody, &updatedGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "account group", name, err)
 	}
@@ -168,9 +183,12 @@ func (c *Client) UpdateAccountGroupByName(name string, accountGroup *ResourceAcc
 
 // DeleteAccountGroupByID deletes an Account Group using its ID.
 func (c *Client) DeleteAccountGroupByID(id string) error {
+	c, done := c.startOperation("DeleteAccountGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/groupid/%s", uriAPIAccounts, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "account group", id, err)
 	}
@@ -184,9 +202,12 @@ func (c *Client) DeleteAccountGroupByID(id string) error {
 
 // DeleteAccountGroupByName deletes an Account Group using its name.
 func (c *Client) DeleteAccountGroupByName(name string) error {
+	c, done := c.startOperation("DeleteAccountGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/groupname/%s", uriAPIAccounts, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "account group", name, err)
 	}
@@ -24,10 +24,13 @@ type ResourceActivationCode struct {
 
 // GetActivationCode retrieves the activation code.
 func (c *Client) GetActivationCode() (*ResourceActivationCode, error) {
+	c, done := c.startOperation("GetActivationCode")
+	defer done()
+
 	endpoint := uriAPIActivationCode
 
 	var activationCode ResourceActivationCode
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &activationCode)
+	resp, err := c.doRequest("GET", endpoint, nil, &activationCode)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "activation code", err)
 	}
@@ -41,6 +44,9 @@ func (c *Client) GetActivationCode() (*ResourceActivationCode, error) {
 
 // UpdateActivationCode updates the activation code.
 func (c *Client) UpdateActivationCode(activationCode *ResourceActivationCode) error {
+	c, done := c.startOperation("UpdateActivationCode")
+	defer done()
+
 	endpoint := uriAPIActivationCode
 
 	requestBody := struct {
@@ -52,7 +58,7 @@ func (c *Client) UpdateActivationCode(activationCode *ResourceActivationCode) er
 
 	var handleResponse struct{}
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdate, "activation code", err)
 	}
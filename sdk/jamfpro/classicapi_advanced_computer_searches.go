@@ -87,13 +87,15 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(searches)
 */
 func (c *Client) GetAdvancedComputerSearches() (*ResponseAdvancedComputerSearchesList, error) {
+	c, done := c.startOperation("GetAdvancedComputerSearches")
+	defer done()
+
 	endpoint := uriAPIAdvancedComputerSearches
 
 	var searchesList ResponseAdvancedComputerSearchesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &searchesList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "advance computer searches", err)
 	}
@@ -120,15 +122,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(search)
 
 Errors: Returns an error if the request fails or if the ID is not found.
 */
 func (c *Client) GetAdvancedComputerSearchByID(id string) (*ResourceAdvancedComputerSearch, error) {
+	c, done := c.startOperation("GetAdvancedComputerSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedComputerSearches, id)
 
 	var search ResourceAdvancedComputerSearch
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &search)
+	resp, err := c.doRequest("GET", endpoint, nil, &search)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "advance computer search", id, err)
 	}
@@ -155,15 +159,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(search)
 
 Errors: Returns an error if the request fails or if the name is not found.
 */
 func (c *Client) GetAdvancedComputerSearchByName(name string) (*ResourceAdvancedComputerSearch, error) {
+	c, done := c.startOperation("GetAdvancedComputerSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedComputerSearches, name)
 
 	var search ResourceAdvancedComputerSearch
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &search)
+	resp, err := c.doRequest("GET", endpoint, nil, &search)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "advance computer search", name, err)
 	}
@@ -194,11 +200,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(created)
 
 Errors: Returns an error if the request fails or if the resource cannot be created.
 */
 func (c *Client) CreateAdvancedComputerSearch(search *ResourceAdvancedComputerSearch) (*ResponseAdvancedComputerSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateAdvancedComputerSearch")
+	defer done()
+
 	endpoint := uriAPIAdvancedComputerSearches
 
 	requestBody := struct {
@@ -209,7 +217,7 @@ func (c *Client) CreateAdvancedComputerSearch(search *ResourceAdvancedComputerSe
 	}
 
 	var createdSearch ResponseAdvancedComputerSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdSearch)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "advance computer search", err)
 	}
@@ -241,11 +249,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 
 Errors: Returns an error if the request fails or if the resource cannot be updated.
 */
 func (c *Client) UpdateAdvancedComputerSearchByID(id string, search *ResourceAdvancedComputerSearch) (*ResponseAdvancedComputerSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAdvancedComputerSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedComputerSearches, id)
 
 	requestBody := struct {
@@ -256,7 +266,7 @@ func (c *Client) UpdateAdvancedComputerSearchByID(id string, search *ResourceAdv
 	}
 
 	var updatedSearch ResponseAdvancedComputerSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "advance computer search", id, err)
 	}
@@ -288,11 +298,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 
 Errors: Returns an error if the request fails or if the resource cannot be updated.
 */
 func (c *Client) UpdateAdvancedComputerSearchByName(name string, search *ResourceAdvancedComputerSearch) (*ResponseAdvancedComputerSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAdvancedComputerSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedComputerSearches, name)
 
 	requestBody := struct {
@@ -303,7 +315,7 @@ func (c *Client) UpdateAdvancedComputerSearchByName(name string, search *Resourc
 	}
 
 	var updatedSearch ResponseAdvancedComputerSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "advance computer search", name, err)
 	}
@@ -334,9 +346,12 @@ Example:
 Errors: Returns an error if the request fails or if the resource cannot be deleted.
 */
 func (c *Client) DeleteAdvancedComputerSearchByID(id string) error {
+	c, done := c.startOperation("DeleteAdvancedComputerSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedComputerSearches, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "advance computer search", id, err)
 	}
@@ -367,9 +382,12 @@ Example:
 Errors: Returns an error if the request fails or if the resource cannot be deleted.
 */
 func (c *Client) DeleteAdvancedComputerSearchByName(name string) error {
+	c, done := c.startOperation("DeleteAdvancedComputerSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedComputerSearches, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "advance computer search", name, err)
 	}
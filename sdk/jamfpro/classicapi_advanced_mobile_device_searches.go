@@ -85,15 +85,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(searches)
 
 Errors: Returns an error if the request fails.
 */
 func (c *Client) GetAdvancedMobileDeviceSearches() (*ResponseAdvancedMobileDeviceSearchesList, error) {
+	c, done := c.startOperation("GetAdvancedMobileDeviceSearches")
+	defer done()
+
 	endpoint := uriAPIAdvancedMobileDeviceSearches
 
 	var searchesList ResponseAdvancedMobileDeviceSearchesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &searchesList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "advanced mobile device searches", err)
 	}
@@ -120,15 +122,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(search)
 
 Errors: Returns an error if the request fails or if the ID is not found.
 */
 func (c *Client) GetAdvancedMobileDeviceSearchByID(id string) (*ResourceAdvancedMobileDeviceSearch, error) {
+	c, done := c.startOperation("GetAdvancedMobileDeviceSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedMobileDeviceSearches, id)
 
 	var searchDetail ResourceAdvancedMobileDeviceSearch
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "advanced mobile device search", id, err)
 	}
@@ -155,15 +159,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(search)
 
 Errors: Returns an error if the request fails or if the name is not found.
 */
 func (c *Client) GetAdvancedMobileDeviceSearchByName(name string) (*ResourceAdvancedMobileDeviceSearch, error) {
+	c, done := c.startOperation("GetAdvancedMobileDeviceSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedMobileDeviceSearches, name)
 
 	var searchDetail ResourceAdvancedMobileDeviceSearch
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "advanced mobile device search", name, err)
 	}
@@ -194,11 +200,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(created)
 
 Errors: Returns an error if the request fails or if the resource cannot be created.
 */
 func (c *Client) CreateAdvancedMobileDeviceSearch(search *ResourceAdvancedMobileDeviceSearch) (*ResponseAdvancedMobileDeviceSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateAdvancedMobileDeviceSearch")
+	defer done()
+
 	endpoint := uriAPIAdvancedMobileDeviceSearches
 
 	requestBody := struct {
@@ -209,7 +217,7 @@ func (c *Client) CreateAdvancedMobileDeviceSearch(search *ResourceAdvancedMobile
 	}
 
 	var createdSearch ResponseAdvancedMobileDeviceSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdSearch)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "advanced mobile device search", err)
 	}
@@ -241,11 +249,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 
 Errors: Returns an error if the request fails or if the resource cannot be updated.
 */
 func (c *Client) UpdateAdvancedMobileDeviceSearchByID(id string, search *ResourceAdvancedMobileDeviceSearch) (*ResponseAdvancedMobileDeviceSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAdvancedMobileDeviceSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedMobileDeviceSearches, id)
 
 	requestBody := struct {
@@ -256,7 +266,7 @@ func (c *Client) UpdateAdvancedMobileDeviceSearchByID(id string, search *Resourc
 	}
 
 	var updatedSearch ResponseAdvancedMobileDeviceSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "advanced mobile device search", id, err)
 	}
@@ -288,11 +298,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 
 Errors: Returns an error if the request fails or if the resource cannot be updated.
 */
 func (c *Client) UpdateAdvancedMobileDeviceSearchByName(name string, search *ResourceAdvancedMobileDeviceSearch) (*ResponseAdvancedMobileDeviceSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAdvancedMobileDeviceSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedMobileDeviceSearches, name)
 
 	requestBody := struct {
@@ -303,7 +315,7 @@ func (c *Client) UpdateAdvancedMobileDeviceSearchByName(name string, search *Res
 	}
 
 	var updatedSearch ResponseAdvancedMobileDeviceSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "advanced mobile device search", name, err)
 	}
@@ -334,9 +346,12 @@ Example:
 Errors: Returns an error if the request fails or if the resource cannot be deleted.
 */
 func (c *Client) DeleteAdvancedMobileDeviceSearchByID(id string) error {
+	c, done := c.startOperation("DeleteAdvancedMobileDeviceSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedMobileDeviceSearches, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "advanced mobile device search", id, err)
 	}
@@ -367,9 +382,12 @@ Example:
 Errors: Returns an error if the request fails or if the resource cannot be deleted.
 */
 func (c *Client) DeleteAdvancedMobileDeviceSearchByName(name string) error {
+	c, done := c.startOperation("DeleteAdvancedMobileDeviceSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedMobileDeviceSearches, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "advanced mobile device search", name, err)
 	}
@@ -80,15 +80,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(searches)
 
 Errors: Returns an error if the request fails.
 */
 func (c *Client) GetAdvancedUserSearches() (*ResponseAdvancedUserSearchesList, error) {
+	c, done := c.startOperation("GetAdvancedUserSearches")
+	defer done()
+
 	endpoint := uriAPIAdvancedUserSearches
 
 	var advancedUserSearchesList ResponseAdvancedUserSearchesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &advancedUserSearchesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &advancedUserSearchesList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "advanced user searches", err)
 	}
@@ -115,15 +117,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(search)
 
 Errors: Returns an error if the request fails or if the ID is not found.
 */
 func (c *Client) GetAdvancedUserSearchByID(id string) (*ResourceAdvancedUserSearch, error) {
+	c, done := c.startOperation("GetAdvancedUserSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedUserSearches, id)
 
 	var searchDetail ResourceAdvancedUserSearch
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "advanced user search", id, err)
 	}
@@ -150,15 +154,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(search)
 
 Errors: Returns an error if the request fails or if the name is not found.
 */
 func (c *Client) GetAdvancedUserSearchByName(name string) (*ResourceAdvancedUserSearch, error) {
+	c, done := c.startOperation("GetAdvancedUserSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedUserSearches, name)
 
 	var searchDetail ResourceAdvancedUserSearch
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "advanced user search", name, err)
 	}
@@ -189,11 +195,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(created)
 
 Errors: Returns an error if the request fails or if the resource cannot be created.
 */
 func (c *Client) CreateAdvancedUserSearch(search *ResourceAdvancedUserSearch) (*ResponseAdvancedUserSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateAdvancedUserSearch")
+	defer done()
+
 	endpoint := uriAPIAdvancedUserSearches
 
 	requestBody := struct {
@@ -204,7 +212,7 @@ func (c *Client) CreateAdvancedUserSearch(search *ResourceAdvancedUserSearch) (*
 	}
 
 	var createdSearch ResponseAdvancedUserSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdSearch)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "advanced user search", err)
 	}
@@ -236,11 +244,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 
 Errors: Returns an error if the request fails or if the resource cannot be updated.
 */
 func (c *Client) UpdateAdvancedUserSearchByID(id string, search *ResourceAdvancedUserSearch) (*ResponseAdvancedUserSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAdvancedUserSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedUserSearches, id)
 
 	requestBody := struct {
@@ -251,7 +261,7 @@ func (c *Client) UpdateAdvancedUserSearchByID(id string, search *ResourceAdvance
 	}
 
 	var updatedSearch ResponseAdvancedUserSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "advanced user search", id, err)
 	}
@@ -283,11 +293,13 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 
 Errors: Returns an error if the request fails or if the resource cannot be updated.
 */
 func (c *Client) UpdateAdvancedUserSearchByName(name string, search *ResourceAdvancedUserSearch) (*ResponseAdvancedUserSearchCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateAdvancedUserSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedUserSearches, name)
 
 	requestBody := struct {
@@ -298,7 +310,7 @@ func (c *Client) UpdateAdvancedUserSearchByName(name string, search *ResourceAdv
 	}
 
 	var updatedSearch ResponseAdvancedUserSearchCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "advanced user search", name, err)
 	}
@@ -329,8 +341,11 @@ Example:
 Errors: Returns an error if the request fails or if the resource cannot be deleted.
 */
 func (c *Client) DeleteAdvancedUserSearchByID(id string) error {
+	c, done := c.startOperation("DeleteAdvancedUserSearchByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAdvancedUserSearches, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "advanced user search", id, err)
 	}
@@ -359,8 +374,11 @@ Example:
 Errors: Returns an error if the request fails or if the resource cannot be deleted.
 */
 func (c *Client) DeleteAdvancedUserSearchByName(name string) error {
+	c, done := c.startOperation("DeleteAdvancedUserSearchByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedUserSearches, name)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "advanced user search", name, err)
 	}
@@ -31,10 +31,13 @@ type ResourceAllowedFileExtension struct {
 
 // GetAllowedFileExtensions retrieves all allowed file extensions
 func (c *Client) GetAllowedFileExtensions() (*ResponseAllowedFileExtensionsList, error) {
+	c, done := c.startOperation("GetAllowedFileExtensions")
+	defer done()
+
 	endpoint := uriAPIAllowedFileExtensions
 
 	var allowedExtensionsList ResponseAllowedFileExtensionsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &allowedExtensionsList)
+	resp, err := c.doRequest("GET", endpoint, nil, &allowedExtensionsList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "allowed file extension", err)
 	}
@@ -48,10 +51,13 @@ func (c *Client) GetAllowedFileExtensions() (*ResponseAllowedFileExtensionsList,
 
 // GetAllowedFileExtensionByID retrieves the allowed file extension by its ID
 func (c *Client) GetAllowedFileExtensionByID(id string) (*ResourceAllowedFileExtension, error) {
+	c, done := c.startOperation("GetAllowedFileExtensionByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAllowedFileExtensions, id)
 
 	var extension ResourceAllowedFileExtension
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extension)
+	resp, err := c.doRequest("GET", endpoint, nil, &extension)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "allowed file extension", id, err)
 	}
@@ -65,10 +71,13 @@ func (c *Client) GetAllowedFileExtensionByID(id string) (*ResourceAllowedFileExt
 
 // GetAllowedFileExtensionByName retrieves the allowed file extension by its name
 func (c *Client) GetAllowedFileExtensionByName(name string) (*ResourceAllowedFileExtension, error) {
+	c, done := c.startOperation("GetAllowedFileExtensionByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/extension/%s", uriAPIAllowedFileExtensions, name)
 
 	var extension ResourceAllowedFileExtension
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extension)
+	resp, err := c.doRequest("GET", endpoint, nil, &extension)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "allowed file extension", name, err)
 	}
@@ -82,6 +91,9 @@ func (c *Client) GetAllowedFileExtensionByName(name string) (*ResourceAllowedFil
 
 // CreateAllowedFileExtension creates a new allowed file extension on the Jamf Pro server.
 func (c *Client) CreateAllowedFileExtension(extension *ResourceAllowedFileExtension) (*ResourceAllowedFileExtension, error) {
+	c, done := c.startOperation("CreateAllowedFileExtension")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriAPIAllowedFileExtensions)
 
 	requestBody := struct {
@@ -92,7 +104,7 @@ func (c *Client) CreateAllowedFileExtension(extension *ResourceAllowedFileExtens
 	}
 
 	var responseExtension ResourceAllowedFileExtension
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseExtension)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseExtension)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "allowed file extension", err)
 	}
@@ -106,9 +118,12 @@ func (c *Client) CreateAllowedFileExtension(extension *ResourceAllowedFileExtens
 
 // DeleteAllowedFileExtensionByID deletes an existing allowed file extension by ID
 func (c *Client) DeleteAllowedFileExtensionByID(id string) error {
+	c, done := c.startOperation("DeleteAllowedFileExtensionByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriAPIAllowedFileExtensions, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "allowed file extension", id, err)
 	}
@@ -55,12 +55,15 @@ type ResponceBYOProfileCreatedAndUpdated struct {
 
 // GetBYOProfiles gets a list of all BYO profiles.
 func (c *Client) GetBYOProfiles() (*ResponseBYOProfilesList, error) {
+	c, done := c.startOperation("GetBYOProfiles")
+	defer done()
+
 	endpoint := uriBYOProfiles
 
 	var byoProfiles ResponseBYOProfilesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &byoProfiles)
+	resp, err := c.doRequest("GET", endpoint, nil, &byoProfiles)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch all BYO Profiles: %v", err)
+		return nil, fmt.Errorf("failed to fetch all BYO Profiles: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -72,10 +75,13 @@ func (c *Client) GetBYOProfiles() (*ResponseBYOProfilesList, error) {
 
 // GetBYOProfileByID retrieves a BYO profile by its ID.
 func (c *Client) GetBYOProfileByID(id string) (*ResourceBYOProfile, error) {
+	c, done := c.startOperation("GetBYOProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriBYOProfiles, id)
 
 	var profile ResourceBYOProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "byo profile", id, err)
 	}
@@ -89,12 +95,15 @@ func (c *Client) GetBYOProfileByID(id string) (*ResourceBYOProfile, error) {
 
 // GetBYOProfileByName retrieves a BYO profile by its name.
 func (c *Client) GetBYOProfileByName(name string) (*ResourceBYOProfile, error) {
+	c, done := c.startOperation("GetBYOProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriBYOProfiles, name)
 
 	var profile ResourceBYOProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch BYO Profile by name: %v", err)
+		return nil, fmt.Errorf("failed to fetch BYO Profile by name: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -106,6 +115,9 @@ func (c *Client) GetBYOProfileByName(name string) (*ResourceBYOProfile, error) {
 
 // CreateBYOProfile creates a new BYO profile.
 func (c *Client) CreateBYOProfile(profile *ResourceBYOProfile) (*ResponceBYOProfileCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateBYOProfile")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriBYOProfiles)
 
 	requestBody := struct {
@@ -116,7 +128,7 @@ func (c *Client) CreateBYOProfile(profile *ResourceBYOProfile) (*ResponceBYOProf
 	}
 
 	var createdProfile ResponceBYOProfileCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdProfile)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "byo profile", err)
 	}
@@ -130,6 +142,9 @@ func (c *Client) CreateBYOProfile(profile *ResourceBYOProfile) (*ResponceBYOProf
 
 // UpdateBYOProfileByID updates an existing BYO profile by its ID.
 func (c *Client) UpdateBYOProfileByID(id string, profile *ResourceBYOProfile) (*ResponceBYOProfileCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateBYOProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriBYOProfiles, id)
 
 	requestBody := struct {
@@ -140,7 +155,7 @@ func (c *Client) UpdateBYOProfileByID(id string, profile *ResourceBYOProfile) (*
 	}
 
 	var updatedProfile ResponceBYOProfileCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "byo profile", id, err)
 	}
@@ -154,6 +169,9 @@ func (c *Client) UpdateBYOProfileByID(id string, profile *ResourceBYOProfile) (*
 
 // UpdateBYOProfileByName updates a BYO profile by its name.
 func (c *Client) UpdateBYOProfileByName(name string, profile *ResourceBYOProfile) (*ResponceBYOProfileCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateBYOProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriBYOProfiles, name)
 
 	requestBody := struct {
@@ -164,7 +182,7 @@ func (c *Client) UpdateBYOProfileByName(name string, profile *ResourceBYOProfile
 	}
 
 	var updatedProfile ResponceBYOProfileCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "byo profile", name, err)
 	}
@@ -178,9 +196,12 @@ func (c *Client) UpdateBYOProfileByName(name string, profile *ResourceBYOProfile
 
 // DeleteBYOProfileByID deletes a BYO profile by its ID.
 func (c *Client) DeleteBYOProfileByID(id string) error {
+	c, done := c.startOperation("DeleteBYOProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriBYOProfiles, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "byo profile", id, err)
 	}
@@ -194,9 +215,12 @@ func (c *Client) DeleteBYOProfileByID(id string) error {
 
 // DeleteBYOProfileByName deletes a BYO profile by its name.
 func (c *Client) DeleteBYOProfileByName(name string) error {
+	c, done := c.startOperation("DeleteBYOProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriBYOProfiles, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "byo profile", name, err)
 	}
@@ -132,10 +132,13 @@ type ClassSubsetAppleTVs struct {
 
 // GetClasses gets a list of all classes.
 func (c *Client) GetClasses() (*ResponseClassesList, error) {
+	c, done := c.startOperation("GetClasses")
+	defer done()
+
 	endpoint := uriClasses
 
 	var classes ResponseClassesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &classes)
+	resp, err := c.doRequest("GET", endpoint, nil, &classes)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "classes", err)
 	}
@@ -149,10 +152,13 @@ func (c *Client) GetClasses() (*ResponseClassesList, error) {
 
 // GetClassesByID retrieves a class by its ID.
 func (c *Client) GetClassByID(id string) (*ResourceClass, error) {
+	c, done := c.startOperation("GetClassByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriClasses, id)
 
 	var class ResourceClass
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &class)
+	resp, err := c.doRequest("GET", endpoint, nil, &class)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "class", id, err)
 	}
@@ -166,10 +172,13 @@ func (c *Client) GetClassByID(id string) (*ResourceClass, error) {
 
 // GetClassesByName retrieves a class by its name.
 func (c *Client) GetClassByName(name string) (*ResourceClass, error) {
+	c, done := c.startOperation("GetClassByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriClasses, name)
 
 	var class ResourceClass
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &class)
+	resp, err := c.doRequest("GET", endpoint, nil, &class)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "class", name, err)
 	}
@@ -183,6 +192,9 @@ func (c *Client) GetClassByName(name string) (*ResourceClass, error) {
 
 // CreateClassesByID creates a new class with the given details.
 func (c *Client) CreateClass(class *ResourceClass) (*ResourceClass, error) {
+	c, done := c.startOperation("CreateClass")
+	defer done()
+
 	endpoint := uriClasses
 
 	requestBody := struct {
@@ -193,7 +205,7 @@ func (c *Client) CreateClass(class *ResourceClass) (*ResourceClass, error) {
 	}
 
 	var createdClass ResourceClass
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdClass)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdClass)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "class", err)
 	}
@@ -207,6 +219,9 @@ func (c *Client) CreateClass(class *ResourceClass) (*ResourceClass, error) {
 
 // UpdateClassByID updates an existing class with the given ID.
 func (c *Client) UpdateClassByID(id string, class *ResourceClass) error {
+	c, done := c.startOperation("UpdateClassByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriClasses, id)
 
 	requestBody := struct {
@@ -216,7 +231,7 @@ func (c *Client) UpdateClassByID(id string, class *ResourceClass) error {
 		ResourceClass: class,
 	}
 
-	_, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, nil)
+	_, err := c.doRequest("PUT", endpoint, &requestBody, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdateByID, "class", id, err)
 	}
@@ -226,6 +241,9 @@ func (c *Client) UpdateClassByID(id string, class *ResourceClass) error {
 
 // UpdateClassByName updates an existing class with the given name.
 func (c *Client) UpdateClassByName(name string, class *ResourceClass) error {
+	c, done := c.startOperation("UpdateClassByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriClasses, name)
 
 	requestBody := struct {
@@ -235,7 +253,7 @@ func (c *Client) UpdateClassByName(name string, class *ResourceClass) error {
 		ResourceClass: class,
 	}
 
-	_, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, nil)
+	_, err := c.doRequest("PUT", endpoint, &requestBody, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdateByName, "class", name, err)
 	}
@@ -245,9 +263,12 @@ func (c *Client) UpdateClassByName(name string, class *ResourceClass) error {
 
 // DeleteClassByID deletes an existing class with the given ID.
 func (c *Client) DeleteClassByID(id string) error {
+	c, done := c.startOperation("DeleteClassByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriClasses, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "class", id, err)
 	}
@@ -261,9 +282,12 @@ func (c *Client) DeleteClassByID(id string) error {
 
 // DeleteClassByName deletes a class by its name.
 func (c *Client) DeleteClassByName(name string) error {
+	c, done := c.startOperation("DeleteClassByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriClasses, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "class", name, err)
 	}
@@ -36,10 +36,13 @@ type ResourceComputerCheckin struct {
 
 // GetComputerCheckinInformation gets the jamf pro computer check-in settings
 func (c *Client) GetComputerCheckinInformation() (*ResourceComputerCheckin, error) {
+	c, done := c.startOperation("GetComputerCheckinInformation")
+	defer done()
+
 	endpoint := uriComputerCheckin
 
 	var checkinSettings ResourceComputerCheckin
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &checkinSettings)
+	resp, err := c.doRequest("GET", endpoint, nil, &checkinSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "computer checkin information", err)
 	}
@@ -53,6 +56,9 @@ func (c *Client) GetComputerCheckinInformation() (*ResourceComputerCheckin, erro
 
 // UpdateComputerCheckinInformation updates the jamf pro computer check-in settings
 func (c *Client) UpdateComputerCheckinInformation(settings *ResourceComputerCheckin) error {
+	c, done := c.startOperation("UpdateComputerCheckinInformation")
+	defer done()
+
 	endpoint := uriComputerCheckin
 
 	requestBody := struct {
@@ -64,7 +70,7 @@ func (c *Client) UpdateComputerCheckinInformation(settings *ResourceComputerChec
 
 	var handleResponse struct{}
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdate, "computer checkin information", err)
 	}
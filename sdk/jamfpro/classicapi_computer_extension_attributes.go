@@ -53,10 +53,13 @@ type ComputerExtensionAttributeSubsetInputType struct {
 
 // GetComputerExtensionAttributes gets a list of all computer extension attributes
 func (c *Client) GetComputerExtensionAttributes() (*ResponseComputerExtensionAttributesList, error) {
+	c, done := c.startOperation("GetComputerExtensionAttributes")
+	defer done()
+
 	endpoint := uriComputerExtensionAttributes
 
 	var attributes ResponseComputerExtensionAttributesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &attributes)
+	resp, err := c.doRequest("GET", endpoint, nil, &attributes)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "computer extension attributes", err)
 	}
@@ -70,10 +73,13 @@ func (c *Client) GetComputerExtensionAttributes() (*ResponseComputerExtensionAtt
 
 // GetComputerExtensionAttributeByID retrieves a computer extension attribute by its ID.
 func (c *Client) GetComputerExtensionAttributeByID(id string) (*ResourceComputerExtensionAttribute, error) {
+	c, done := c.startOperation("GetComputerExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerExtensionAttributes, id)
 
 	var attribute ResourceComputerExtensionAttribute
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &attribute)
+	resp, err := c.doRequest("GET", endpoint, nil, &attribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer extension attribute", id, err)
 	}
@@ -87,10 +93,13 @@ func (c *Client) GetComputerExtensionAttributeByID(id string) (*ResourceComputer
 
 // GetComputerExtensionAttributeByName retrieves a computer extension attribute by its name.
 func (c *Client) GetComputerExtensionAttributeByName(name string) (*ResourceComputerExtensionAttribute, error) {
+	c, done := c.startOperation("GetComputerExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputerExtensionAttributes, name)
 
 	var attribute ResourceComputerExtensionAttribute
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &attribute)
+	resp, err := c.doRequest("GET", endpoint, nil, &attribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "computer extension attribute", name, err)
 	}
@@ -104,6 +113,9 @@ func (c *Client) GetComputerExtensionAttributeByName(name string) (*ResourceComp
 
 // CreateComputerExtensionAttribute creates a new computer extension attribute.
 func (c *Client) CreateComputerExtensionAttribute(attribute *ResourceComputerExtensionAttribute) (*ResourceComputerExtensionAttribute, error) {
+	c, done := c.startOperation("CreateComputerExtensionAttribute")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriComputerExtensionAttributes)
 
 	requestBody := struct {
@@ -114,7 +126,7 @@ func (c *Client) CreateComputerExtensionAttribute(attribute *ResourceComputerExt
 	}
 
 	var createdAttribute ResourceComputerExtensionAttribute
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdAttribute)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "computer extension attribute", err)
 	}
@@ -128,6 +140,9 @@ func (c *Client) CreateComputerExtensionAttribute(attribute *ResourceComputerExt
 
 // UpdateComputerExtensionAttributeByID updates an existing computer extension attribute by its ID.
 func (c *Client) UpdateComputerExtensionAttributeByID(id string, attribute *ResourceComputerExtensionAttribute) (*ResourceComputerExtensionAttribute, error) {
+	c, done := c.startOperation("UpdateComputerExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerExtensionAttributes, id)
 
 	requestBody := struct {
@@ -138,7 +153,7 @@ func (c *Client) UpdateComputerExtensionAttributeByID(id string, attribute *Reso
 	}
 
 	var updatedAttribute ResourceComputerExtensionAttribute
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "computer extension attribute", id, err)
 	}
@@ -152,6 +167,9 @@ func (c *Client) UpdateComputerExtensionAttributeByID(id string, attribute *Reso
 
 // UpdateComputerExtensionAttributeByName updates a computer extension attribute by its name.
 func (c *Client) UpdateComputerExtensionAttributeByName(name string, attribute *ResourceComputerExtensionAttribute) (*ResourceComputerExtensionAttribute, error) {
+	c, done := c.startOperation("UpdateComputerExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputerExtensionAttributes, name)
 
 	requestBody := struct {
@@ -162,7 +180,7 @@ func (c *Client) UpdateComputerExtensionAttributeByName(name string, attribute *
 	}
 
 	var updatedAttribute ResourceComputerExtensionAttribute
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "computer extension attribute", name, err)
 	}
@@ -176,9 +194,12 @@ func (c *Client) UpdateComputerExtensionAttributeByName(name string, attribute *
 
 // DeleteComputerExtensionAttributeByID deletes a computer extension attribute by its ID.
 func (c *Client) DeleteComputerExtensionAttributeByID(id string) error {
+	c, done := c.startOperation("DeleteComputerExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerExtensionAttributes, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "computer extension attribute", id, err)
 	}
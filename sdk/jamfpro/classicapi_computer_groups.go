@@ -70,10 +70,13 @@ type ComputerGroupSubsetComputer struct {
 
 // GetComputerGroups gets a list of all computer groups
 func (c *Client) GetComputerGroups() (*ResponseComputerGroupsList, error) {
+	c, done := c.startOperation("GetComputerGroups")
+	defer done()
+
 	endpoint := uriComputerGroups
 
 	var computerGroups ResponseComputerGroupsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerGroups)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerGroups)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "computer groups", err)
 	}
@@ -87,10 +90,13 @@ func (c *Client) GetComputerGroups() (*ResponseComputerGroupsList, error) {
 
 // GetComputerGroupByID retrieves a computer group by its ID.
 func (c *Client) GetComputerGroupByID(id string) (*ResourceComputerGroup, error) {
+	c, done := c.startOperation("GetComputerGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerGroups, id)
 
 	var group ResourceComputerGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
+	resp, err := c.doRequest("GET", endpoint, nil, &group)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer group", id, err)
 	}
@@ -104,10 +110,13 @@ func (c *Client) GetComputerGroupByID(id string) (*ResourceComputerGroup, error)
 
 // GetComputerGroupByName retrieves a computer group by its name.
 func (c *Client) GetComputerGroupByName(name string) (*ResourceComputerGroup, error) {
+	c, done := c.startOperation("GetComputerGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputerGroups, name)
 
 	var group ResourceComputerGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
+	resp, err := c.doRequest("GET", endpoint, nil, &group)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "computer group", name, err)
 	}
@@ -121,6 +130,9 @@ func (c *Client) GetComputerGroupByName(name string) (*ResourceComputerGroup, er
 
 // CreateComputerGroup creates a new computer group.
 func (c *Client) CreateComputerGroup(group *ResourceComputerGroup) (*ResponseComputerGroupreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateComputerGroup")
+	defer done()
+
 	endpoint := uriComputerGroups
 
 	requestBody := struct {
@@ -131,7 +143,7 @@ func (c *Client) CreateComputerGroup(group *ResourceComputerGroup) (*ResponseCom
 	}
 
 	var createdGroup ResponseComputerGroupreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdGroup)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "computer group", err)
 	}
@@ -145,6 +157,9 @@ func (c *Client) CreateComputerGroup(group *ResourceComputerGroup) (*ResponseCom
 
 // UpdateComputerGroupByID updates an existing computer group by its ID.
 func (c *Client) UpdateComputerGroupByID(id string, group *ResourceComputerGroup) (*ResponseComputerGroupreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateComputerGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerGroups, id)
 
 	requestBody := struct {
@@ -155,7 +170,7 @@ func (c *Client) UpdateComputerGroupByID(id string, group *ResourceComputerGroup
 	}
 
 	var updatedGroup ResponseComputerGroupreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "computer group", id, err)
 	}
@@ -169,6 +184,9 @@ func (c *Client) UpdateComputerGroupByID(id string, group *ResourceComputerGroup
 
 // UpdateComputerGroupByName updates a computer group by its name.
 func (c *Client) UpdateComputerGroupByName(name string, group *ResourceComputerGroup) (*ResponseComputerGroupreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateComputerGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputerGroups, name)
 
 	requestBody := struct {
@@ -179,7 +197,7 @@ func (c *Client) UpdateComputerGroupByName(name string, group *ResourceComputerG
 	}
 
 	var updatedGroup ResponseComputerGroupreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "computer group", name, err)
 	}
@@ -193,9 +211,12 @@ func (c *Client) UpdateComputerGroupByName(name string, group *ResourceComputerG
 
 // DeleteComputerGroupByID deletes a computer group by its ID.
 func (c *Client) DeleteComputerGroupByID(id string) error {
+	c, done := c.startOperation("DeleteComputerGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerGroups, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "computer group", id, err)
 	}
@@ -209,9 +230,12 @@ func (c *Client) DeleteComputerGroupByID(id string) error {
 
 // DeleteComputerGroupByName deletes a computer group by its name.
 func (c *Client) DeleteComputerGroupByName(name string) error {
+	c, done := c.startOperation("DeleteComputerGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputerGroups, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "computer group", name, err)
 	}
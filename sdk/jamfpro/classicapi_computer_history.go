@@ -167,10 +167,13 @@ type ComputerHistorySubsetApp struct {
 
 // GetComputerHistoryByComputerID retrieves the historical information of a computer given its ID.
 func (c *Client) GetComputerHistoryByComputerID(id string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerHistory, id)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer histroy", id, err)
 	}
@@ -184,10 +187,13 @@ func (c *Client) GetComputerHistoryByComputerID(id string) (*ResourceComputerHis
 
 // GetComputerHistoryByComputerIDAndDataSubset retrieves a subset of the historical information of a computer given its ID and subset name.
 func (c *Client) GetComputerHistoryByComputerIDAndDataSubset(id string, subset string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerIDAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/subset/%s", uriComputerHistory, id, subset)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer history with data subset", id, err)
 	}
@@ -201,12 +207,15 @@ func (c *Client) GetComputerHistoryByComputerIDAndDataSubset(id string, subset s
 
 // GetComputerHistoryByComputerName retrieves the historical information of a computer given its name.
 func (c *Client) GetComputerHistoryByComputerName(name string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputerHistory, name)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve computer history by computer name '%s': %v", name, err)
+		return nil, fmt.Errorf("failed to retrieve computer history by computer name '%s': %w", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -218,10 +227,13 @@ func (c *Client) GetComputerHistoryByComputerName(name string) (*ResourceCompute
 
 // GetComputerHistoryByComputerNameAndDataSubset retrieves a subset of the historical information of a computer given its name and subset name.
 func (c *Client) GetComputerHistoryByComputerNameAndDataSubset(name string, subset string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerNameAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/subset/%s", uriComputerHistory, name, subset)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer history with data subset", name, err)
 	}
@@ -235,12 +247,15 @@ func (c *Client) GetComputerHistoryByComputerNameAndDataSubset(name string, subs
 
 // GetComputerHistoryByComputerUDID retrieves the historical information of a computer by it's UDID.
 func (c *Client) GetComputerHistoryByComputerUDID(udid string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerUDID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/udid/%s", uriComputerHistory, udid)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve computer history by computer udid '%s': %v", udid, err)
+		return nil, fmt.Errorf("failed to retrieve computer history by computer udid '%s': %w", udid, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -252,12 +267,15 @@ func (c *Client) GetComputerHistoryByComputerUDID(udid string) (*ResourceCompute
 
 // GetComputerHistoryByComputerUDIDAndDataSubset retrieves a subset of the historical information of a computer given its udid and subset name.
 func (c *Client) GetComputerHistoryByComputerUDIDAndDataSubset(udid string, subset string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerUDIDAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/udid/%s/subset/%s", uriComputerHistory, udid, subset)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve computer history by computer udid '%s': %v", udid, err)
+		return nil, fmt.Errorf("failed to retrieve computer history by computer udid '%s': %w", udid, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -269,10 +287,13 @@ func (c *Client) GetComputerHistoryByComputerUDIDAndDataSubset(udid string, subs
 
 // GetComputerHistoryByComputerSerialNumber retrieves the historical information of a computer by it's serial number
 func (c *Client) GetComputerHistoryByComputerSerialNumber(serial string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerSerialNumber")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/serialnumber/%s", uriComputerHistory, serial)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer history with computer serial number", serial, err)
 	}
@@ -286,12 +307,15 @@ func (c *Client) GetComputerHistoryByComputerSerialNumber(serial string) (*Resou
 
 // GetComputerHistoryByComputerSerialNumberAndDataSubset retrieves a subset of the historical information of a computer by it's serial number and data subset name.
 func (c *Client) GetComputerHistoryByComputerSerialNumberAndDataSubset(udid string, subset string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerSerialNumberAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/serialnumber/%s/subset/%s", uriComputerHistory, udid, subset)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve computer history by computer serial number '%s': %v", udid, err)
+		return nil, fmt.Errorf("failed to retrieve computer history by computer serial number '%s': %w", udid, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -303,10 +327,13 @@ func (c *Client) GetComputerHistoryByComputerSerialNumberAndDataSubset(udid stri
 
 // GetComputerHistoryByComputerMACAddress retrieves the historical information of a computer by it's MAC Address
 func (c *Client) GetComputerHistoryByComputerMACAddress(MACAddress string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerMACAddress")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/macaddress/%s", uriComputerHistory, MACAddress)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer history with computer MAC Address", MACAddress, err)
 	}
@@ -320,12 +347,15 @@ func (c *Client) GetComputerHistoryByComputerMACAddress(MACAddress string) (*Res
 
 // GetComputerHistoryByComputerMACAddressAndDataSubset retrieves a subset of the historical information of a computer by it's serial number and data subset name.
 func (c *Client) GetComputerHistoryByComputerMACAddressAndDataSubset(MACAddress string, subset string) (*ResourceComputerHistory, error) {
+	c, done := c.startOperation("GetComputerHistoryByComputerMACAddressAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/macaddress/%s/subset/%s", uriComputerHistory, MACAddress, subset)
 
 	var computerHistory ResourceComputerHistory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerHistory)
+	resp, err := c.doRequest("GET", endpoint, nil, &computerHistory)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve computer history by computer MAC Address '%s': %v", MACAddress, err)
+		return nil, fmt.Errorf("failed to retrieve computer history by computer MAC Address '%s': %w", MACAddress, err)
 	}
 
 	if resp != nil && resp.Body != nil {
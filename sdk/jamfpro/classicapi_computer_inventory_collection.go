@@ -65,10 +65,13 @@ type Plugin struct {
 
 // GetComputerInventoryCollection gets the jamf pro inventory collection settings
 func (c *Client) GetComputerInventoryCollectionInformation() (*ResourceComputerInventoryCollection, error) {
+	c, done := c.startOperation("GetComputerInventoryCollectionInformation")
+	defer done()
+
 	endpoint := uriComputerInventoryCollection
 
 	var inventoryCollection ResourceComputerInventoryCollection
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &inventoryCollection)
+	resp, err := c.doRequest("GET", endpoint, nil, &inventoryCollection)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "computer inventory collection settings", err)
 	}
@@ -82,6 +85,9 @@ func (c *Client) GetComputerInventoryCollectionInformation() (*ResourceComputerI
 
 // UpdateComputerInventoryCollectionInformation updates the jamf pro computer check-in settings
 func (c *Client) UpdateComputerInventoryCollectionInformation(settings *ResourceComputerInventoryCollection) error {
+	c, done := c.startOperation("UpdateComputerInventoryCollectionInformation")
+	defer done()
+
 	endpoint := uriComputerInventoryCollection
 
 	requestBody := struct {
@@ -93,7 +99,7 @@ func (c *Client) UpdateComputerInventoryCollectionInformation(settings *Resource
 
 	var handleResponse struct{}
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdate, "computer inventory collection settings", err)
 	}
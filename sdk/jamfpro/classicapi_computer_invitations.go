@@ -67,10 +67,13 @@ type ComputerInvitationSubsetEnrollIntoState struct {
 
 // GetComputerInvitations retrieves a list of all computer invitations.
 func (c *Client) GetComputerInvitations() (*ResponseComputerInvitationsList, error) {
+	c, done := c.startOperation("GetComputerInvitations")
+	defer done()
+
 	endpoint := uriComputerInvitations
 
 	var invitations ResponseComputerInvitationsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &invitations)
+	resp, err := c.doRequest("GET", endpoint, nil, &invitations)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "computer invitations", err)
 	}
@@ -85,10 +88,13 @@ func (c *Client) GetComputerInvitations() (*ResponseComputerInvitationsList, err
 // Duplicate function ???
 // GetComputerInvitationByID retrieves a computer invitation by its ID.
 func (c *Client) GetComputerInvitationByID(id string) (*ResourceComputerInvitation, error) {
+	c, done := c.startOperation("GetComputerInvitationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerInvitations, id)
 
 	var invitation ResourceComputerInvitation
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &invitation)
+	resp, err := c.doRequest("GET", endpoint, nil, &invitation)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer invitation", id, err)
 	}
@@ -102,10 +108,13 @@ func (c *Client) GetComputerInvitationByID(id string) (*ResourceComputerInvitati
 
 // GetComputerInvitationsByName retrieves a computer invitation by its invitation Name.
 func (c *Client) GetComputerInvitationByInvitationID(id string) (*ResourceComputerInvitation, error) {
+	c, done := c.startOperation("GetComputerInvitationByInvitationID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/invitation/%s", uriComputerInvitations, id)
 
 	var invitation ResourceComputerInvitation
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &invitation)
+	resp, err := c.doRequest("GET", endpoint, nil, &invitation)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer invitation", id, err)
 	}
@@ -119,6 +128,9 @@ func (c *Client) GetComputerInvitationByInvitationID(id string) (*ResourceComput
 
 // CreateComputerInvitation creates a new computer invitation.
 func (c *Client) CreateComputerInvitation(invitation *ResourceComputerInvitation) (*ResourceComputerInvitation, error) {
+	c, done := c.startOperation("CreateComputerInvitation")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriComputerInvitations)
 
 	requestBody := struct {
@@ -129,7 +141,7 @@ func (c *Client) CreateComputerInvitation(invitation *ResourceComputerInvitation
 	}
 
 	var createdInvitation ResourceComputerInvitation
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdInvitation)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdInvitation)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "computer invitation", err)
 	}
@@ -143,9 +155,12 @@ func (c *Client) CreateComputerInvitation(invitation *ResourceComputerInvitation
 
 // DeleteComputerInvitationByID deletes a computer invitation by its ID.
 func (c *Client) DeleteComputerInvitationByID(id string) error {
+	c, done := c.startOperation("DeleteComputerInvitationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputerInvitations, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "computer invitation", id, err)
 	}
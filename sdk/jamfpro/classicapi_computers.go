@@ -387,10 +387,13 @@ type ComputerSubsetConfigurationProfiles struct {
 
 // GetComputers retrieves all computers
 func (c *Client) GetComputers() (*ResponseComputersList, error) {
+	c, done := c.startOperation("GetComputers")
+	defer done()
+
 	endpoint := uriComputers
 
 	var computersList ResponseComputersList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computersList)
+	resp, err := c.doRequest("GET", endpoint, nil, &computersList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "computers", err)
 	}
@@ -404,10 +407,13 @@ func (c *Client) GetComputers() (*ResponseComputersList, error) {
 
 // GetComputerByID retrieves the computer details by its ID.
 func (c *Client) GetComputerByID(id string) (*ResponseComputer, error) {
+	c, done := c.startOperation("GetComputerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputers, id)
 
 	var computer ResponseComputer
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computer)
+	resp, err := c.doRequest("GET", endpoint, nil, &computer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer", id, err)
 	}
@@ -421,10 +427,13 @@ func (c *Client) GetComputerByID(id string) (*ResponseComputer, error) {
 
 // GetComputerByName retrieves the computer by its name
 func (c *Client) GetComputerByName(name string) (*ResponseComputer, error) {
+	c, done := c.startOperation("GetComputerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputers, name)
 
 	var computer ResponseComputer
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computer)
+	resp, err := c.doRequest("GET", endpoint, nil, &computer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "computer", name, err)
 	}
@@ -438,6 +447,9 @@ func (c *Client) GetComputerByName(name string) (*ResponseComputer, error) {
 
 // CreateComputer creates a new computer.
 func (c *Client) CreateComputer(computer ResponseComputer) (*ResponseComputer, error) {
+	c, done := c.startOperation("CreateComputer")
+	defer done()
+
 	endpoint := uriComputers
 
 	requestBody := struct {
@@ -448,7 +460,7 @@ func (c *Client) CreateComputer(computer ResponseComputer) (*ResponseComputer, e
 	}
 
 	var response ResponseComputer
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "computer", err)
 	}
@@ -462,6 +474,9 @@ func (c *Client) CreateComputer(computer ResponseComputer) (*ResponseComputer, e
 
 // UpdateComputerByID updates the details of a computer by its ID.
 func (c *Client) UpdateComputerByID(id string, computer ResponseComputer) (*ResponseComputer, error) {
+	c, done := c.startOperation("UpdateComputerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputers, id)
 
 	if computer.General.Site.ID == 0 && computer.General.Site.Name == "" {
@@ -477,7 +492,7 @@ func (c *Client) UpdateComputerByID(id string, computer ResponseComputer) (*Resp
 	}
 
 	var response ResponseComputer
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "computer", id, err)
 	}
@@ -491,6 +506,9 @@ func (c *Client) UpdateComputerByID(id string, computer ResponseComputer) (*Resp
 
 // UpdateComputerByName updates the details of a computer by its name.
 func (c *Client) UpdateComputerByName(name string, computer ResponseComputer) (*ResponseComputer, error) {
+	c, done := c.startOperation("UpdateComputerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputers, name)
 
 	if computer.General.Site.ID == 0 && computer.General.Site.Name == "" {
@@ -506,7 +524,7 @@ func (c *Client) UpdateComputerByName(name string, computer ResponseComputer) (*
 	}
 
 	var response ResponseComputer
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "computer", name, err)
 	}
@@ -520,9 +538,12 @@ func (c *Client) UpdateComputerByName(name string, computer ResponseComputer) (*
 
 // DeleteComputerByID deletes an existing Computer by its ID
 func (c *Client) DeleteComputerByID(id string) error {
+	c, done := c.startOperation("DeleteComputerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriComputers, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "computer", id, err)
 	}
@@ -536,9 +557,12 @@ func (c *Client) DeleteComputerByID(id string) error {
 
 // DeleteComputerByName deletes an existing computer by its name
 func (c *Client) DeleteComputerByName(name string) error {
+	c, done := c.startOperation("DeleteComputerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriComputers, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "computer", name, err)
 	}
@@ -44,10 +44,13 @@ type ResponseDirectoryBinding struct {
 
 // GetDirectoryBindings retrieves a serialized list of directory bindings.
 func (c *Client) GetDirectoryBindings() (*ResponseDirectoryBindingsList, error) {
+	c, done := c.startOperation("GetDirectoryBindings")
+	defer done()
+
 	endpoint := uriDirectoryBindings
 
 	var bindings ResponseDirectoryBindingsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &bindings)
+	resp, err := c.doRequest("GET", endpoint, nil, &bindings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "directory bindings", err)
 	}
@@ -61,10 +64,13 @@ func (c *Client) GetDirectoryBindings() (*ResponseDirectoryBindingsList, error)
 
 // GetDirectoryBindingByID retrieves a single directory binding by its ID.
 func (c *Client) GetDirectoryBindingByID(id string) (*ResponseDirectoryBinding, error) {
+	c, done := c.startOperation("GetDirectoryBindingByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDirectoryBindings, id)
 
 	var binding ResponseDirectoryBinding
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &binding)
+	resp, err := c.doRequest("GET", endpoint, nil, &binding)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "directory binding", id, err)
 	}
@@ -78,10 +84,13 @@ func (c *Client) GetDirectoryBindingByID(id string) (*ResponseDirectoryBinding,
 
 // GetDirectoryBindingByName retrieves a single directory binding by its name.
 func (c *Client) GetDirectoryBindingByName(name string) (*ResponseDirectoryBinding, error) {
+	c, done := c.startOperation("GetDirectoryBindingByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDirectoryBindings, name)
 
 	var binding ResponseDirectoryBinding
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &binding)
+	resp, err := c.doRequest("GET", endpoint, nil, &binding)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "directory binding", name, err)
 	}
@@ -95,6 +104,9 @@ func (c *Client) GetDirectoryBindingByName(name string) (*ResponseDirectoryBindi
 
 // CreateDirectoryBinding creates a new directory binding.
 func (c *Client) CreateDirectoryBinding(binding *ResponseDirectoryBinding) (*ResponseDirectoryBinding, error) {
+	c, done := c.startOperation("CreateDirectoryBinding")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriDirectoryBindings)
 
 	requestBody := struct {
@@ -105,7 +117,7 @@ func (c *Client) CreateDirectoryBinding(binding *ResponseDirectoryBinding) (*Res
 	}
 
 	var createdBinding ResponseDirectoryBinding
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdBinding)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdBinding)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "directory binding", err)
 	}
@@ -119,6 +131,9 @@ func (c *Client) CreateDirectoryBinding(binding *ResponseDirectoryBinding) (*Res
 
 // UpdateDirectoryBindingByID updates a directory binding by its ID.
 func (c *Client) UpdateDirectoryBindingByID(id string, binding *ResponseDirectoryBinding) (*ResponseDirectoryBinding, error) {
+	c, done := c.startOperation("UpdateDirectoryBindingByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDirectoryBindings, id)
 
 	requestBody := struct {
@@ -129,7 +144,7 @@ func (c *Client) UpdateDirectoryBindingByID(id string, binding *ResponseDirector
 	}
 
 	var updatedBinding ResponseDirectoryBinding
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedBinding)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedBinding)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "directory binding", id, err)
 	}
@@ -143,6 +158,9 @@ func (c *Client) UpdateDirectoryBindingByID(id string, binding *ResponseDirector
 
 // UpdateDirectoryBindingByName updates a directory binding by its name.
 func (c *Client) UpdateDirectoryBindingByName(name string, binding *ResponseDirectoryBinding) (*ResponseDirectoryBinding, error) {
+	c, done := c.startOperation("UpdateDirectoryBindingByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDirectoryBindings, name)
 
 	requestBody := struct {
@@ -153,7 +171,7 @@ func (c *Client) UpdateDirectoryBindingByName(name string, binding *ResponseDire
 	}
 
 	var updatedBinding ResponseDirectoryBinding
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedBinding)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedBinding)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "directory binding", name, err)
 	}
@@ -167,9 +185,12 @@ func (c *Client) UpdateDirectoryBindingByName(name string, binding *ResponseDire
 
 // DeleteDirectoryBindingByID deletes a directory binding by its ID.
 func (c *Client) DeleteDirectoryBindingByID(id string) error {
+	c, done := c.startOperation("DeleteDirectoryBindingByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDirectoryBindings, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "directory binding", id, err)
 	}
@@ -183,9 +204,12 @@ func (c *Client) DeleteDirectoryBindingByID(id string) error {
 
 // DeleteDirectoryBindingByName deletes a directory binding by its name.
 func (c *Client) DeleteDirectoryBindingByName(name string) error {
+	c, done := c.startOperation("DeleteDirectoryBindingByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDirectoryBindings, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "directory binding", name, err)
 	}
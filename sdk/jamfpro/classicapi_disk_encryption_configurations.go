@@ -54,10 +54,13 @@ type DiskEncryptionConfigurationInstitutionalRecoveryKey struct {
 
 // GetDiskEncryptionConfigurations retrieves a serialized list of disk encryption configurations.
 func (c *Client) GetDiskEncryptionConfigurations() (*ResponseDiskEncryptionConfigurationsList, error) {
+	c, done := c.startOperation("GetDiskEncryptionConfigurations")
+	defer done()
+
 	endpoint := uriDiskEncryptionConfigurations
 
 	var configurations ResponseDiskEncryptionConfigurationsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configurations)
+	resp, err := c.doRequest("GET", endpoint, nil, &configurations)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "disk encryption configurations", err)
 	}
@@ -71,10 +74,13 @@ func (c *Client) GetDiskEncryptionConfigurations() (*ResponseDiskEncryptionConfi
 
 // GetDiskEncryptionConfigurationByID retrieves a single disk encryption configuration by its ID.
 func (c *Client) GetDiskEncryptionConfigurationByID(id string) (*ResourceDiskEncryptionConfiguration, error) {
+	c, done := c.startOperation("GetDiskEncryptionConfigurationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDiskEncryptionConfigurations, id)
 
 	var configuration ResourceDiskEncryptionConfiguration
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configuration)
+	resp, err := c.doRequest("GET", endpoint, nil, &configuration)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "disk encryption configuration", id, err)
 	}
@@ -88,10 +94,13 @@ func (c *Client) GetDiskEncryptionConfigurationByID(id string) (*ResourceDiskEnc
 
 // GetDiskEncryptionConfigurationByName retrieves a disk encryption configuration by its name.
 func (c *Client) GetDiskEncryptionConfigurationByName(name string) (*ResourceDiskEncryptionConfiguration, error) {
+	c, done := c.startOperation("GetDiskEncryptionConfigurationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDiskEncryptionConfigurations, name)
 
 	var configuration ResourceDiskEncryptionConfiguration
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configuration)
+	resp, err := c.doRequest("GET", endpoint, nil, &configuration)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "disk encryption configuration", name, err)
 	}
@@ -105,6 +114,9 @@ func (c *Client) GetDiskEncryptionConfigurationByName(name string) (*ResourceDis
 
 // CreateDiskEncryptionConfiguration creates a new disk encryption configuration.
 func (c *Client) CreateDiskEncryptionConfiguration(config *ResourceDiskEncryptionConfiguration) (*ResponseDiskEncryptionConfigurationCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateDiskEncryptionConfiguration")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriDiskEncryptionConfigurations)
 
 	requestBody := struct {
@@ -115,7 +127,7 @@ func (c *Client) CreateDiskEncryptionConfiguration(config *ResourceDiskEncryptio
 	}
 
 	var createdConfig ResponseDiskEncryptionConfigurationCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdConfig)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdConfig)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "disk encryption configuration", err)
 	}
@@ -129,6 +141,9 @@ func (c *Client) CreateDiskEncryptionConfiguration(config *ResourceDiskEncryptio
 
 // UpdateDiskEncryptionConfigurationByID updates a disk encryption configuration by its ID.
 func (c *Client) UpdateDiskEncryptionConfigurationByID(id string, config *ResourceDiskEncryptionConfiguration) (*ResponseDiskEncryptionConfigurationCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateDiskEncryptionConfigurationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDiskEncryptionConfigurations, id)
 
 	requestBody := struct {
@@ -139,7 +154,7 @@ func (c *Client) UpdateDiskEncryptionConfigurationByID(id string, config *Resour
 	}
 
 	var updatedConfig ResponseDiskEncryptionConfigurationCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedConfig)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedConfig)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "disk encryption configuration", id, err)
 	}
@@ -153,6 +168,9 @@ func (c *Client) UpdateDiskEncryptionConfigurationByID(id string, config *Resour
 
 // UpdateDiskEncryptionConfigurationByName updates a disk encryption configuration by its name.
 func (c *Client) UpdateDiskEncryptionConfigurationByName(name string, config *ResourceDiskEncryptionConfiguration) (*ResourceDiskEncryptionConfiguration, error) {
+	c, done := c.startOperation("UpdateDiskEncryptionConfigurationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDiskEncryptionConfigurations, name)
 
 	requestBody := struct {
@@ -163,7 +181,7 @@ func (c *Client) UpdateDiskEncryptionConfigurationByName(name string, config *Re
 	}
 
 	var updatedConfig ResourceDiskEncryptionConfiguration
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedConfig)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedConfig)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "disk encryption configuration", name, err)
 	}
@@ -177,9 +195,12 @@ func (c *Client) UpdateDiskEncryptionConfigurationByName(name string, config *Re
 
 // DeleteDiskEncryptionConfigurationByID deletes a disk encryption configuration by its ID.
 func (c *Client) DeleteDiskEncryptionConfigurationByID(id string) error {
+	c, done := c.startOperation("DeleteDiskEncryptionConfigurationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDiskEncryptionConfigurations, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "disk encryption configuration", id, err)
 	}
@@ -193,9 +214,12 @@ func (c *Client) DeleteDiskEncryptionConfigurationByID(id string) error {
 
 // DeleteDiskEncryptionConfigurationByName deletes a disk encryption configuration by its name.
 func (c *Client) DeleteDiskEncryptionConfigurationByName(name string) error {
+	c, done := c.startOperation("DeleteDiskEncryptionConfigurationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDiskEncryptionConfigurations, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "disk encryption configuration", name, err)
 	}
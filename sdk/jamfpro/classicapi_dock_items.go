@@ -41,10 +41,13 @@ type ResourceDockItem struct {
 
 // GetDockItems retrieves a serialized list of dock items.
 func (c *Client) GetDockItems() (*ResponseDockItemsList, error) {
+	c, done := c.startOperation("GetDockItems")
+	defer done()
+
 	endpoint := uriDockItems
 
 	var dockItems ResponseDockItemsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &dockItems)
+	resp, err := c.doRequest("GET", endpoint, nil, &dockItems)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "dock items", err)
 	}
@@ -58,10 +61,13 @@ func (c *Client) GetDockItems() (*ResponseDockItemsList, error) {
 
 // GetDockItemsByID retrieves a single dock item by its ID.
 func (c *Client) GetDockItemByID(id string) (*ResourceDockItem, error) {
+	c, done := c.startOperation("GetDockItemByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDockItems, id)
 
 	var dockItem ResourceDockItem
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &dockItem)
+	resp, err := c.doRequest("GET", endpoint, nil, &dockItem)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "dock item", id, err)
 	}
@@ -75,10 +81,13 @@ func (c *Client) GetDockItemByID(id string) (*ResourceDockItem, error) {
 
 // GetDockItemsByName retrieves a single dock item by its name.
 func (c *Client) GetDockItemByName(name string) (*ResourceDockItem, error) {
+	c, done := c.startOperation("GetDockItemByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDockItems, name)
 
 	var dockItem ResourceDockItem
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &dockItem)
+	resp, err := c.doRequest("GET", endpoint, nil, &dockItem)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "dock item", name, err)
 	}
@@ -92,6 +101,9 @@ func (c *Client) GetDockItemByName(name string) (*ResourceDockItem, error) {
 
 // CreateDockItems creates a new dock item.
 func (c *Client) CreateDockItem(dockItem *ResourceDockItem) (*ResourceDockItem, error) {
+	c, done := c.startOperation("CreateDockItem")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriDockItems)
 
 	requestBody := struct {
@@ -102,7 +114,7 @@ func (c *Client) CreateDockItem(dockItem *ResourceDockItem) (*ResourceDockItem,
 	}
 
 	var createdDockItem ResourceDockItem
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdDockItem)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdDockItem)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "dock item", err)
 	}
@@ -116,6 +128,9 @@ func (c *Client) CreateDockItem(dockItem *ResourceDockItem) (*ResourceDockItem,
 
 // UpdateDockItemByID updates a dock item by its ID.
 func (c *Client) UpdateDockItemByID(id string, dockItem *ResourceDockItem) (*ResourceDockItem, error) {
+	c, done := c.startOperation("UpdateDockItemByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDockItems, id)
 
 	requestBody := struct {
@@ -126,7 +141,7 @@ func (c *Client) UpdateDockItemByID(id string, dockItem *ResourceDockItem) (*Res
 	}
 
 	var updatedDockItem ResourceDockItem
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDockItem)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedDockItem)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "dock item", id, err)
 	}
@@ -140,6 +155,9 @@ func (c *Client) UpdateDockItemByID(id string, dockItem *ResourceDockItem) (*Res
 
 // UpdateDockItemByName updates a dock item by its name.
 func (c *Client) UpdateDockItemByName(name string, dockItem *ResourceDockItem) (*ResourceDockItem, error) {
+	c, done := c.startOperation("UpdateDockItemByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDockItems, name)
 
 	requestBody := struct {
@@ -150,7 +168,7 @@ func (c *Client) UpdateDockItemByName(name string, dockItem *ResourceDockItem) (
 	}
 
 	var updatedDockItem ResourceDockItem
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDockItem)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedDockItem)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "dock item", name, err)
 	}
@@ -164,9 +182,12 @@ func (c *Client) UpdateDockItemByName(name string, dockItem *ResourceDockItem) (
 
 // DeleteDockItemsByID deletes a dock item by its ID.
 func (c *Client) DeleteDockItemByID(id string) error {
+	c, done := c.startOperation("DeleteDockItemByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDockItems, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "dock item", id, err)
 	}
@@ -180,9 +201,12 @@ func (c *Client) DeleteDockItemByID(id string) error {
 
 // DeleteDockItemsByName deletes a dock item by its name.
 func (c *Client) DeleteDockItemByName(name string) error {
+	c, done := c.startOperation("DeleteDockItemByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDockItems, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "dock item", name, err)
 	}
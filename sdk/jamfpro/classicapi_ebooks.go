@@ -189,10 +189,13 @@ type EbookSubsetScopeUserGroup struct {
 
 // GetEbooks retrieves a serialized list of ebooks.
 func (c *Client) GetEbooks() (*ResponseEbooksList, error) {
+	c, done := c.startOperation("GetEbooks")
+	defer done()
+
 	endpoint := uriEbooks
 
 	var ebooks ResponseEbooksList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebooks)
+	resp, err := c.doRequest("GET", endpoint, nil, &ebooks)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "ebooks", err)
 	}
@@ -206,10 +209,13 @@ func (c *Client) GetEbooks() (*ResponseEbooksList, error) {
 
 // GetEbooksByID retrieves a single ebook by its ID.
 func (c *Client) GetEbookByID(id string) (*ResourceEbooks, error) {
+	c, done := c.startOperation("GetEbookByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriEbooks, id)
 
 	var ebook ResourceEbooks
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebook)
+	resp, err := c.doRequest("GET", endpoint, nil, &ebook)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "ebook", id, err)
 	}
@@ -223,10 +229,13 @@ func (c *Client) GetEbookByID(id string) (*ResourceEbooks, error) {
 
 // GetEbooksByName retrieves a single ebook by its name.
 func (c *Client) GetEbookByName(name string) (*ResourceEbooks, error) {
+	c, done := c.startOperation("GetEbookByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriEbooks, name)
 
 	var ebook ResourceEbooks
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebook)
+	resp, err := c.doRequest("GET", endpoint, nil, &ebook)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "ebook", name, err)
 	}
@@ -240,10 +249,13 @@ func (c *Client) GetEbookByName(name string) (*ResourceEbooks, error) {
 
 // GetEbooksByNameAndDataSubset retrieves a specific subset of an ebook by its name.
 func (c *Client) GetEbookByNameAndDataSubset(name, subset string) (*ResourceEbooks, error) {
+	c, done := c.startOperation("GetEbookByNameAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/subset/%s", uriEbooks, name, subset)
 
 	var ebook ResourceEbooks
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebook)
+	resp, err := c.doRequest("GET", endpoint, nil, &ebook)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "ebook", name, err)
 	}
@@ -257,6 +269,9 @@ func (c *Client) GetEbookByNameAndDataSubset(name, subset string) (*ResourceEboo
 
 // CreateEbook creates a new ebook.
 func (c *Client) CreateEbook(ebook ResourceEbooks) (*ResourceEbooks, error) {
+	c, done := c.startOperation("CreateEbook")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriEbooks)
 
 	requestBody := struct {
@@ -267,7 +282,7 @@ func (c *Client) CreateEbook(ebook ResourceEbooks) (*ResourceEbooks, error) {
 	}
 
 	var response ResourceEbooks
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "ebook", err)
 	}
@@ -281,6 +296,9 @@ func (c *Client) CreateEbook(ebook ResourceEbooks) (*ResourceEbooks, error) {
 
 // UpdateEbookByID updates an existing ebook by its ID.
 func (c *Client) UpdateEbookByID(id string, ebook ResourceEbooks) (*ResourceEbooks, error) {
+	c, done := c.startOperation("UpdateEbookByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriEbooks, id)
 
 	requestBody := struct {
@@ -291,7 +309,7 @@ func (c *Client) UpdateEbookByID(id string, ebook ResourceEbooks) (*ResourceEboo
 	}
 
 	var updatedEbook ResourceEbooks
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedEbook)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedEbook)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ebook", id, err)
 	}
@@ -305,6 +323,9 @@ func (c *Client) UpdateEbookByID(id string, ebook ResourceEbooks) (*ResourceEboo
 
 // UpdateEbookByName updates an existing ebook by its name.
 func (c *Client) UpdateEbookByName(name string, ebook ResourceEbooks) (*ResourceEbooks, error) {
+	c, done := c.startOperation("UpdateEbookByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriEbooks, name)
 
 	requestBody := struct {
@@ -315,7 +336,7 @@ func (c *Client) UpdateEbookByName(name string, ebook ResourceEbooks) (*Resource
 	}
 
 	var updatedEbook ResourceEbooks
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedEbook)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedEbook)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "ebook", name, err)
 	}
@@ -329,9 +350,12 @@ func (c *Client) UpdateEbookByName(name string, ebook ResourceEbooks) (*Resource
 
 // DeleteEbookByID deletes a ebook by its ID.
 func (c *Client) DeleteEbookByID(id string) error {
+	c, done := c.startOperation("DeleteEbookByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriEbooks, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "ebook", id, err)
 	}
@@ -345,9 +369,12 @@ func (c *Client) DeleteEbookByID(id string) error {
 
 // DeleteEbookByName deletes a ebook by its name.
 func (c *Client) DeleteEbookByName(name string) error {
+	c, done := c.startOperation("DeleteEbookByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriEbooks, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "ebook", name, err)
 	}
@@ -70,10 +70,13 @@ type ResponseFileShareDistributionPointCreatedAndUpdated struct {
 
 // GetDistributionPoints retrieves a serialized list of distribution points.
 func (c *Client) GetDistributionPoints() (*ResponseDistributionPointsList, error) {
+	c, done := c.startOperation("GetDistributionPoints")
+	defer done()
+
 	endpoint := uriDistributionPoints
 
 	var distributionPoints ResponseDistributionPointsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &distributionPoints)
+	resp, err := c.doRequest("GET", endpoint, nil, &distributionPoints)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "distribution points", err)
 	}
@@ -87,10 +90,13 @@ func (c *Client) GetDistributionPoints() (*ResponseDistributionPointsList, error
 
 // GetDistributionPointByID retrieves a single distribution point by its ID.
 func (c *Client) GetDistributionPointByID(id string) (*ResourceFileShareDistributionPoint, error) {
+	c, done := c.startOperation("GetDistributionPointByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDistributionPoints, id)
 
 	var distributionPoint ResourceFileShareDistributionPoint
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &distributionPoint)
+	resp, err := c.doRequest("GET", endpoint, nil, &distributionPoint)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "distribution point", id, err)
 	}
@@ -104,10 +110,13 @@ func (c *Client) GetDistributionPointByID(id string) (*ResourceFileShareDistribu
 
 // GetDistributionPointByName retrieves a single distribution point by its name.
 func (c *Client) GetDistributionPointByName(name string) (*ResourceFileShareDistributionPoint, error) {
+	c, done := c.startOperation("GetDistributionPointByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDistributionPoints, name)
 
 	var distributionPoint ResourceFileShareDistributionPoint
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &distributionPoint)
+	resp, err := c.doRequest("GET", endpoint, nil, &distributionPoint)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "distribution point", name, err)
 	}
@@ -121,6 +130,9 @@ func (c *Client) GetDistributionPointByName(name string) (*ResourceFileShareDist
 
 // CreateDistributionPoint creates a new distribution point.
 func (c *Client) CreateDistributionPoint(dp *ResourceFileShareDistributionPoint) (*ResponseFileShareDistributionPointCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateDistributionPoint")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriDistributionPoints)
 
 	requestBody := struct {
@@ -131,7 +143,7 @@ func (c *Client) CreateDistributionPoint(dp *ResourceFileShareDistributionPoint)
 	}
 
 	var createdDistributionPoint ResponseFileShareDistributionPointCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdDistributionPoint)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdDistributionPoint)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "distribution point", err)
 	}
@@ -145,6 +157,9 @@ func (c *Client) CreateDistributionPoint(dp *ResourceFileShareDistributionPoint)
 
 // UpdateDistributionPointByID updates a distribution point by its ID.
 func (c *Client) UpdateDistributionPointByID(id string, dp *ResourceFileShareDistributionPoint) (*ResponseFileShareDistributionPointCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateDistributionPointByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDistributionPoints, id)
 
 	requestBody := struct {
@@ -155,7 +170,7 @@ func (c *Client) UpdateDistributionPointByID(id string, dp *ResourceFileShareDis
 	}
 
 	var updatedDistributionPoint ResponseFileShareDistributionPointCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDistributionPoint)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedDistributionPoint)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "distribution point", id, err)
 	}
@@ -169,6 +184,9 @@ func (c *Client) UpdateDistributionPointByID(id string, dp *ResourceFileShareDis
 
 // UpdateDistributionPointByName updates a distribution point by its name.
 func (c *Client) UpdateDistributionPointByName(name string, dp *ResourceFileShareDistributionPoint) (*ResponseFileShareDistributionPointCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateDistributionPointByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDistributionPoints, name)
 
 	requestBody := struct {
@@ -179,7 +197,7 @@ func (c *Client) UpdateDistributionPointByName(name string, dp *ResourceFileShar
 	}
 
 	var updatedDistributionPoint ResponseFileShareDistributionPointCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDistributionPoint)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedDistributionPoint)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "distribution point", name, err)
 	}
@@ -193,9 +211,12 @@ func (c *Client) UpdateDistributionPointByName(name string, dp *ResourceFileShar
 
 // DeleteDistributionPointByID deletes a distribution point by its ID.
 func (c *Client) DeleteDistributionPointByID(id string) error {
+	c, done := c.startOperation("DeleteDistributionPointByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriDistributionPoints, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "distribution point", id, err)
 	}
@@ -209,9 +230,12 @@ func (c *Client) DeleteDistributionPointByID(id string) error {
 
 // DeleteDistributionPointByName deletes a distribution point by its name.
 func (c *Client) DeleteDistributionPointByName(name string) error {
+	c, done := c.startOperation("DeleteDistributionPointByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriDistributionPoints, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "distribution point", name, err)
 	}
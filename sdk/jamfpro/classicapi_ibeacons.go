@@ -35,10 +35,13 @@ type ResourceIBeacons struct {
 
 // GetIBeacons retrieves a list of all iBeacons registered in Jamf Pro.
 func (c *Client) GetIBeacons() (*ResponseIBeaconsList, error) {
+	c, done := c.startOperation("GetIBeacons")
+	defer done()
+
 	endpoint := uriIbeacons
 
 	var iBeacons ResponseIBeaconsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &iBeacons)
+	resp, err := c.doRequest("GET", endpoint, nil, &iBeacons)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "ibeacons", err)
 	}
@@ -53,9 +56,12 @@ func (c *Client) GetIBeacons() (*ResponseIBeaconsList, error) {
 // GetIBeaconByID fetches the details of a specific iBeacon by its ID.
 // It returns the iBeacon's ID, name, UUID, major, and minor values.
 func (c *Client) GetIBeaconByID(id string) (*ResourceIBeacons, error) {
+	c, done := c.startOperation("GetIBeaconByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriIbeacons, id)
 	var beacon ResourceIBeacons
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &beacon)
+	resp, err := c.doRequest("GET", endpoint, nil, &beacon)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "ibeacon", id, err)
 	}
@@ -70,9 +76,12 @@ func (c *Client) GetIBeaconByID(id string) (*ResourceIBeacons, error) {
 // GetIBeaconByName fetches the details of a specific iBeacon by its name.
 // It returns the iBeacon's ID, name, UUID, major, and minor values.
 func (c *Client) GetIBeaconByName(name string) (*ResourceIBeacons, error) {
+	c, done := c.startOperation("GetIBeaconByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriIbeacons, name)
 	var beacon ResourceIBeacons
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &beacon)
+	resp, err := c.doRequest("GET", endpoint, nil, &beacon)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "ibeacon", name, err)
 	}
@@ -86,6 +95,9 @@ func (c *Client) GetIBeaconByName(name string) (*ResourceIBeacons, error) {
 
 // CreateIBeacon creates a new iBeacon in Jamf Pro.
 func (c *Client) CreateIBeacon(beacon *ResourceIBeacons) (*ResourceIBeacons, error) {
+	c, done := c.startOperation("CreateIBeacon")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriIbeacons)
 
 	requestBody := struct {
@@ -96,7 +108,7 @@ func (c *Client) CreateIBeacon(beacon *ResourceIBeacons) (*ResourceIBeacons, err
 	}
 
 	var response ResourceIBeacons
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "ibeacon", err)
 	}
@@ -110,6 +122,9 @@ func (c *Client) CreateIBeacon(beacon *ResourceIBeacons) (*ResourceIBeacons, err
 
 // UpdateIBeaconByID updates an existing iBeacon by its ID in Jamf Pro.
 func (c *Client) UpdateIBeaconByID(id string, beacon *ResourceIBeacons) (*ResourceIBeacons, error) {
+	c, done := c.startOperation("UpdateIBeaconByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriIbeacons, id)
 
 	requestBody := struct {
@@ -120,7 +135,7 @@ func (c *Client) UpdateIBeaconByID(id string, beacon *ResourceIBeacons) (*Resour
 	}
 
 	var response ResourceIBeacons
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ibeacon", id, err)
 	}
@@ -134,6 +149,9 @@ func (c *Client) UpdateIBeaconByID(id string, beacon *ResourceIBeacons) (*Resour
 
 // UpdateIBeaconByName updates an existing iBeacon by its name in Jamf Pro.
 func (c *Client) UpdateIBeaconByName(name string, beacon *ResourceIBeacons) (*ResourceIBeacons, error) {
+	c, done := c.startOperation("UpdateIBeaconByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriIbeacons, name)
 
 	requestBody := struct {
@@ -144,7 +162,7 @@ func (c *Client) UpdateIBeaconByName(name string, beacon *ResourceIBeacons) (*Re
 	}
 
 	var response ResourceIBeacons
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "ibeacon", name, err)
 	}
@@ -158,9 +176,12 @@ func (c *Client) UpdateIBeaconByName(name string, beacon *ResourceIBeacons) (*Re
 
 // DeleteIBeaconByID deletes an iBeacon by its ID in Jamf Pro.
 func (c *Client) DeleteIBeaconByID(id string) error {
+	c, done := c.startOperation("DeleteIBeaconByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriIbeacons, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "ibeacon", id, err)
 	}
@@ -174,9 +195,12 @@ func (c *Client) DeleteIBeaconByID(id string) error {
 
 // DeleteIBeaconByName deletes an iBeacon by its name in Jamf Pro.
 func (c *Client) DeleteIBeaconByName(name string) error {
+	c, done := c.startOperation("DeleteIBeaconByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriIbeacons, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "ibeacon", name, err)
 	}
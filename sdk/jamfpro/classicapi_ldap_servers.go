@@ -115,10 +115,13 @@ type LDAPServerSubsetMappingUserGroupMemberships struct {
 
 // GetLDAPServers retrieves a serialized list of LDAP servers.
 func (c *Client) GetLDAPServers() (*ResponseLDAPServersList, error) {
+	c, done := c.startOperation("GetLDAPServers")
+	defer done()
+
 	endpoint := uriLDAPServers
 
 	var ldapServers ResponseLDAPServersList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServers)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServers)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "ldap servers", err)
 	}
@@ -132,10 +135,13 @@ func (c *Client) GetLDAPServers() (*ResponseLDAPServersList, error) {
 
 // GetLDAPServerByID retrieves the details of a specific LDAP server by its ID.
 func (c *Client) GetLDAPServerByID(id string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriLDAPServers, id)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server", id, err)
 	}
@@ -149,10 +155,13 @@ func (c *Client) GetLDAPServerByID(id string) (*ResourceLDAPServers, error) {
 
 // GetLDAPServerByName retrieves the details of a specific LDAP server by its name.
 func (c *Client) GetLDAPServerByName(name string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriLDAPServers, name)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server", name, err)
 	}
@@ -166,10 +175,13 @@ func (c *Client) GetLDAPServerByName(name string) (*ResourceLDAPServers, error)
 
 // GetLDAPServerByIDAndUserDataSubset retrieves information about matching users for a specific LDAP server by its ID.
 func (c *Client) GetLDAPServerByIDAndUserDataSubset(id string, user string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByIDAndUserDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/user/%s", uriLDAPServers, id, user)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server and user data", id, err)
 	}
@@ -183,10 +195,13 @@ func (c *Client) GetLDAPServerByIDAndUserDataSubset(id string, user string) (*Re
 
 // GetLDAPServerByIDAndGroupDataSubset retrieves information about matching groups for a specific LDAP server by its ID.
 func (c *Client) GetLDAPServerByIDAndGroupDataSubset(id string, group string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByIDAndGroupDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/group/%s", uriLDAPServers, id, group)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server and group data", id, err)
 	}
@@ -200,10 +215,13 @@ func (c *Client) GetLDAPServerByIDAndGroupDataSubset(id string, group string) (*
 
 // GetLDAPServerByIDAndUserMembershipInGroupDataSubset retrieves information about user membership in a group for an LDAP server specified by its ID.
 func (c *Client) GetLDAPServerByIDAndUserMembershipInGroupDataSubset(id string, group, user string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByIDAndUserMembershipInGroupDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/group/%s/user/%s", uriLDAPServers, id, group, user)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server and user membership", id, err)
 	}
@@ -217,10 +235,13 @@ func (c *Client) GetLDAPServerByIDAndUserMembershipInGroupDataSubset(id string,
 
 // GetLDAPServerByNameAndUserDataSubset retrieves information about matching users for a specific LDAP server specified by its name.
 func (c *Client) GetLDAPServerByNameAndUserDataSubset(name, user string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByNameAndUserDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/user/%s", uriLDAPServers, name, user)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server and user data", name, err)
 	}
@@ -234,10 +255,13 @@ func (c *Client) GetLDAPServerByNameAndUserDataSubset(name, user string) (*Resou
 
 // GetLDAPServerByNameAndGroupDataSubset retrieves information about groups for a specific LDAP server specified by its name.
 func (c *Client) GetLDAPServerByNameAndGroupDataSubset(name, group string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByNameAndGroupDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/group/%s", uriLDAPServers, name, group)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server and group data", name, err)
 	}
@@ -251,10 +275,13 @@ func (c *Client) GetLDAPServerByNameAndGroupDataSubset(name, group string) (*Res
 
 // GetLDAPServerByNameAndUserMembershipInGroupDataSubset retrieves information about user membership in a group for a specific LDAP server by its name.
 func (c *Client) GetLDAPServerByNameAndUserMembershipInGroupDataSubset(name, group, user string) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("GetLDAPServerByNameAndUserMembershipInGroupDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/group/%s/user/%s", uriLDAPServers, name, group, user)
 
 	var ldapServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server and user membership data", name, err)
 	}
@@ -268,6 +295,9 @@ func (c *Client) GetLDAPServerByNameAndUserMembershipInGroupDataSubset(name, gro
 
 // CreateLDAPServer creates a new LDAP server in Jamf Pro.
 func (c *Client) CreateLDAPServer(ldapServer *ResourceLDAPServers) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("CreateLDAPServer")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriLDAPServers)
 
 	requestBody := struct {
@@ -278,7 +308,7 @@ func (c *Client) CreateLDAPServer(ldapServer *ResourceLDAPServers) (*ResourceLDA
 	}
 
 	var responseLDAPServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseLDAPServer)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseLDAPServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "ldap server", err)
 	}
@@ -292,6 +322,9 @@ func (c *Client) CreateLDAPServer(ldapServer *ResourceLDAPServers) (*ResourceLDA
 
 // UpdateLDAPServerByID updates an existing LDAP server identified by its ID.
 func (c *Client) UpdateLDAPServerByID(id string, ldapServer *ResourceLDAPServers) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("UpdateLDAPServerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriLDAPServers, id)
 
 	requestBody := struct {
@@ -302,7 +335,7 @@ func (c *Client) UpdateLDAPServerByID(id string, ldapServer *ResourceLDAPServers
 	}
 
 	var responseLDAPServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseLDAPServer)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseLDAPServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ldap server", id, err)
 	}
@@ -316,6 +349,9 @@ func (c *Client) UpdateLDAPServerByID(id string, ldapServer *ResourceLDAPServers
 
 // UpdateLDAPServerByName updates an existing LDAP server identified by its name.
 func (c *Client) UpdateLDAPServerByName(name string, ldapServer *ResourceLDAPServers) (*ResourceLDAPServers, error) {
+	c, done := c.startOperation("UpdateLDAPServerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriLDAPServers, name)
 
 	requestBody := struct {
@@ -326,7 +362,7 @@ func (c *Client) UpdateLDAPServerByName(name string, ldapServer *ResourceLDAPSer
 	}
 
 	var responseLDAPServer ResourceLDAPServers
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseLDAPServer)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseLDAPServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "ldap server", name, err)
 	}
@@ -340,9 +376,12 @@ func (c *Client) UpdateLDAPServerByName(name string, ldapServer *ResourceLDAPSer
 
 // DeleteLDAPServerByID deletes an LDAP server identified by its ID.
 func (c *Client) DeleteLDAPServerByID(id string) error {
+	c, done := c.startOperation("DeleteLDAPServerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriLDAPServers, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "ldap server", id, err)
 	}
@@ -356,9 +395,12 @@ func (c *Client) DeleteLDAPServerByID(id string) error {
 
 // DeleteLDAPServerByName deletes an LDAP server identified by its name.
 func (c *Client) DeleteLDAPServerByName(name string) error {
+	c, done := c.startOperation("DeleteLDAPServerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriLDAPServers, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "ldap server", name, err)
 	}
@@ -114,10 +114,13 @@ type LicensedSoftwareSubsetLicenseAttachments struct {
 
 // GetLicensedSoftware retrieves a serialized list of licensed software.
 func (c *Client) GetLicensedSoftware() (*ResponseLicensedSoftwareList, error) {
+	c, done := c.startOperation("GetLicensedSoftware")
+	defer done()
+
 	endpoint := uriLicensedSoftware
 
 	var licensedSoftware ResponseLicensedSoftwareList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &licensedSoftware)
+	resp, err := c.doRequest("GET", endpoint, nil, &licensedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "licensed software", err)
 	}
@@ -131,10 +134,13 @@ func (c *Client) GetLicensedSoftware() (*ResponseLicensedSoftwareList, error) {
 
 // GetLicensedSoftwareByID retrieves details of a specific licensed software by its ID.
 func (c *Client) GetLicensedSoftwareByID(id string) (*ResourceLicensedSoftware, error) {
+	c, done := c.startOperation("GetLicensedSoftwareByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriLicensedSoftware, id)
 
 	var licensedSoftware ResourceLicensedSoftware
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &licensedSoftware)
+	resp, err := c.doRequest("GET", endpoint, nil, &licensedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "licensed software", id, err)
 	}
@@ -148,10 +154,13 @@ func (c *Client) GetLicensedSoftwareByID(id string) (*ResourceLicensedSoftware,
 
 // GetLicensedSoftwareByName retrieves details of a specific licensed software by its name.
 func (c *Client) GetLicensedSoftwareByName(name string) (*ResourceLicensedSoftware, error) {
+	c, done := c.startOperation("GetLicensedSoftwareByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriLicensedSoftware, name)
 
 	var licensedSoftware ResourceLicensedSoftware
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &licensedSoftware)
+	resp, err := c.doRequest("GET", endpoint, nil, &licensedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "licensed software", name, err)
 	}
@@ -165,6 +174,9 @@ func (c *Client) GetLicensedSoftwareByName(name string) (*ResourceLicensedSoftwa
 
 // CreateLicensedSoftware creates a new licensed software item in Jamf Pro.
 func (c *Client) CreateLicensedSoftware(licensedSoftware *ResourceLicensedSoftware) (*ResourceLicensedSoftware, error) {
+	c, done := c.startOperation("CreateLicensedSoftware")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriLicensedSoftware)
 
 	requestBody := struct {
@@ -175,7 +187,7 @@ func (c *Client) CreateLicensedSoftware(licensedSoftware *ResourceLicensedSoftwa
 	}
 
 	var ResourceLicensedSoftware ResourceLicensedSoftware
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &ResourceLicensedSoftware)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &ResourceLicensedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "licensed software", err)
 	}
@@ -189,6 +201,9 @@ func (c *Client) CreateLicensedSoftware(licensedSoftware *ResourceLicensedSoftwa
 
 // UpdateLicensedSoftwareByID updates an existing licensed software item by its ID.
 func (c *Client) UpdateLicensedSoftwareByID(id string, licensedSoftware *ResourceLicensedSoftware) (*ResourceLicensedSoftware, error) {
+	c, done := c.startOperation("UpdateLicensedSoftwareByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriLicensedSoftware, id)
 
 	requestBody := struct {
@@ -199,7 +214,7 @@ func (c *Client) UpdateLicensedSoftwareByID(id string, licensedSoftware *Resourc
 	}
 
 	var ResourceLicensedSoftware ResourceLicensedSoftware
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &ResourceLicensedSoftware)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &ResourceLicensedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "licensed software", id, err)
 	}
@@ -213,6 +228,9 @@ func (c *Client) UpdateLicensedSoftwareByID(id string, licensedSoftware *Resourc
 
 // UpdateLicensedSoftwareByName updates an existing licensed software item by its name.
 func (c *Client) UpdateLicensedSoftwareByName(name string, licensedSoftware *ResourceLicensedSoftware) (*ResourceLicensedSoftware, error) {
+	c, done := c.startOperation("UpdateLicensedSoftwareByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriLicensedSoftware, name)
 
 	requestBody := struct {
@@ -223,7 +241,7 @@ func (c *Client) UpdateLicensedSoftwareByName(name string, licensedSoftware *Res
 	}
 
 	var ResourceLicensedSoftware ResourceLicensedSoftware
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &ResourceLicensedSoftware)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &ResourceLicensedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "licensed software", name, err)
 	}
@@ -237,9 +255,12 @@ func (c *Client) UpdateLicensedSoftwareByName(name string, licensedSoftware *Res
 
 // DeleteLicensedSoftwareByID deletes a licensed software item by its ID.
 func (c *Client) DeleteLicensedSoftwareByID(id string) error {
+	c, done := c.startOperation("DeleteLicensedSoftwareByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriLicensedSoftware, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "licensed software", id, err)
 	}
@@ -253,9 +274,12 @@ func (c *Client) DeleteLicensedSoftwareByID(id string) error {
 
 // DeleteLicensedSoftwareByName deletes a licensed software item by its name.
 func (c *Client) DeleteLicensedSoftwareByName(name string) error {
+	c, done := c.startOperation("DeleteLicensedSoftwareByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriLicensedSoftware, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "licensed software", name, err)
 	}
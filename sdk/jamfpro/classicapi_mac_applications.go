@@ -153,10 +153,13 @@ type MacAppSubsetScopeNetworkSegment struct {
 
 // GetDockItems retrieves a serialized list of vpp mac applications.
 func (c *Client) GetMacApplications() (*ResponseMacApplicationsList, error) {
+	c, done := c.startOperation("GetMacApplications")
+	defer done()
+
 	endpoint := uriVPPMacApplications
 
 	var macApps ResponseMacApplicationsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApps)
+	resp, err := c.doRequest("GET", endpoint, nil, &macApps)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mac applications", err)
 	}
@@ -172,10 +175,13 @@ func (c *Client) GetMacApplications() (*ResponseMacApplicationsList, error) {
 
 // GetMacApplicationByID retrieves a single Mac application by its ID.
 func (c *Client) GetMacApplicationByID(id string) (*ResourceMacApplications, error) {
+	c, done := c.startOperation("GetMacApplicationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPMacApplications, id)
 
 	var macApp ResourceMacApplications
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
+	resp, err := c.doRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mac application", id, err)
 	}
@@ -189,10 +195,13 @@ func (c *Client) GetMacApplicationByID(id string) (*ResourceMacApplications, err
 
 // GetMacApplicationByName retrieves a single Mac application by its name.
 func (c *Client) GetMacApplicationByName(name string) (*ResourceMacApplications, error) {
+	c, done := c.startOperation("GetMacApplicationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriVPPMacApplications, name)
 
 	var macApp ResourceMacApplications
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
+	resp, err := c.doRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mac application", name, err)
 	}
@@ -207,10 +216,13 @@ func (c *Client) GetMacApplicationByName(name string) (*ResourceMacApplications,
 // GetMacApplicationByNameAndDataSubset retrieves a specific Mac Application by its ID and filters by a specific data subset.
 // Subset values can be General, Scope, SelfService, VPPCodes and VPP.
 func (c *Client) GetMacApplicationByIDAndDataSubset(id string, subset string) (*ResourceMacApplications, error) {
+	c, done := c.startOperation("GetMacApplicationByIDAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/subset/%s", uriVPPMacApplications, id, subset)
 
 	var macApp ResourceMacApplications
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
+	resp, err := c.doRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mac application and data subset", id, err)
 	}
@@ -225,10 +237,13 @@ func (c *Client) GetMacApplicationByIDAndDataSubset(id string, subset string) (*
 // GetMacApplicationByNameAndDataSubset retrieves a specific Mac Application by its name and filters by a specific data subset.
 // Subset values can be General, Scope, SelfService, VPPCodes and VPP.
 func (c *Client) GetMacApplicationByNameAndDataSubset(name, subset string) (*ResourceMacApplications, error) {
+	c, done := c.startOperation("GetMacApplicationByNameAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/subset/%s", uriVPPMacApplications, name, subset)
 
 	var macApp ResourceMacApplications
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
+	resp, err := c.doRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mac application and data subset", name, err)
 	}
@@ -242,6 +257,9 @@ func (c *Client) GetMacApplicationByNameAndDataSubset(name, subset string) (*Res
 
 // CreateMacApplication creates a new Mac Application.
 func (c *Client) CreateMacApplication(macApp ResourceMacApplications) (*ResourceMacApplications, error) {
+	c, done := c.startOperation("CreateMacApplication")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriVPPMacApplications)
 
 	requestBody := struct {
@@ -252,7 +270,7 @@ func (c *Client) CreateMacApplication(macApp ResourceMacApplications) (*Resource
 	}
 
 	var response ResourceMacApplications
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mac application", err)
 	}
@@ -266,6 +284,9 @@ func (c *Client) CreateMacApplication(macApp ResourceMacApplications) (*Resource
 
 // UpdateMacApplicationByID updates an existing Mac Application by its ID.
 func (c *Client) UpdateMacApplicationByID(id string, macApp ResourceMacApplications) (*ResourceMacApplications, error) {
+	c, done := c.startOperation("UpdateMacApplicationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPMacApplications, id)
 
 	requestBody := struct {
@@ -276,7 +297,7 @@ func (c *Client) UpdateMacApplicationByID(id string, macApp ResourceMacApplicati
 	}
 
 	var response ResourceMacApplications
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mac application", id, err)
 	}
@@ -290,6 +311,9 @@ func (c *Client) UpdateMacApplicationByID(id string, macApp ResourceMacApplicati
 
 // UpdateMacApplicationByName updates an existing Mac Application by its name.
 func (c *Client) UpdateMacApplicationByName(name string, macApp ResourceMacApplications) (*ResourceMacApplications, error) {
+	c, done := c.startOperation("UpdateMacApplicationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriVPPMacApplications, name)
 
 	requestBody := struct {
@@ -300,7 +324,7 @@ func (c *Client) UpdateMacApplicationByName(name string, macApp ResourceMacAppli
 	}
 
 	var response ResourceMacApplications
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mac application", name, err)
 	}
@@ -314,9 +338,12 @@ func (c *Client) UpdateMacApplicationByName(name string, macApp ResourceMacAppli
 
 // DeleteMacApplicationByID deletes a MacApplication by its ID.
 func (c *Client) DeleteMacApplicationByID(id string) error {
+	c, done := c.startOperation("DeleteMacApplicationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPMacApplications, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mac application", id, err)
 	}
@@ -330,9 +357,12 @@ func (c *Client) DeleteMacApplicationByID(id string) error {
 
 // DeleteMacApplicationByName deletes a MacApplication by its name.
 func (c *Client) DeleteMacApplicationByName(name string) error {
+	c, done := c.startOperation("DeleteMacApplicationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriVPPMacApplications, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mac application", name, err)
 	}
@@ -144,10 +144,13 @@ type MacOSConfigurationProfileSubsetScopeEntity struct {
 
 // GetMacOSConfigurationProfiles fetches a list of all macOS Configuration Profiles from the Jamf Pro server.
 func (c *Client) GetMacOSConfigurationProfiles() (*ResponseMacOSConfigurationProfileList, error) {
+	c, done := c.startOperation("GetMacOSConfigurationProfiles")
+	defer done()
+
 	endpoint := uriMacOSConfigurationProfiles
 
 	var profilesList ResponseMacOSConfigurationProfileList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profilesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &profilesList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "macOS configuration profiles", err)
 	}
@@ -161,10 +164,13 @@ func (c *Client) GetMacOSConfigurationProfiles() (*ResponseMacOSConfigurationPro
 
 // GetMacOSConfigurationProfileByID fetches a specific macOS Configuration Profile by its ID from the Jamf Pro server.
 func (c *Client) GetMacOSConfigurationProfileByID(id string) (*ResourceMacOSConfigurationProfile, error) {
+	c, done := c.startOperation("GetMacOSConfigurationProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMacOSConfigurationProfiles, id)
 
 	var profile ResourceMacOSConfigurationProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "macOS configuration profile", id, err)
 	}
@@ -178,10 +184,13 @@ func (c *Client) GetMacOSConfigurationProfileByID(id string) (*ResourceMacOSConf
 
 // GetMacOSConfigurationProfileByName fetches a specific macOS Configuration Profile by its name from the Jamf Pro server.
 func (c *Client) GetMacOSConfigurationProfileByName(name string) (*ResourceMacOSConfigurationProfile, error) {
+	c, done := c.startOperation("GetMacOSConfigurationProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMacOSConfigurationProfiles, name)
 
 	var profile ResourceMacOSConfigurationProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "macOS configuration profile", name, err)
 	}
@@ -197,6 +206,8 @@ func (c *Client) GetMacOSConfigurationProfileByName(name string) (*ResourceMacOS
 
 // GetMacOSConfigurationProfileByNameByID retrieves the details of a macOS Configuration Profile by its name.
 func (c *Client) GetMacOSConfigurationProfileByNameByID(name string) (*ResourceMacOSConfigurationProfile, error) {
+	c, done := c.startOperation("GetMacOSConfigurationProfileByNameByID")
+	defer done()
 
 	profilesList, err := c.GetMacOSConfigurationProfiles()
 	if err != nil {
@@ -227,6 +238,9 @@ func (c *Client) GetMacOSConfigurationProfileByNameByID(name string) (*ResourceM
 // It sends a POST request to the Jamf Pro server with the profile details and expects a response with the ID of the newly created profile.
 // CreateMacOSConfigurationProfile creates a new macOS Configuration Profile on the Jamf Pro server and returns the ID of the newly created profile.
 func (c *Client) CreateMacOSConfigurationProfile(profile *ResourceMacOSConfigurationProfile) (*ResponseMacOSConfigurationProfileCreationUpdate, error) {
+	c, done := c.startOperation("CreateMacOSConfigurationProfile")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriMacOSConfigurationProfiles)
 
 	requestBody := struct {
@@ -238,7 +252,7 @@ func (c *Client) CreateMacOSConfigurationProfile(profile *ResourceMacOSConfigura
 
 	var response ResponseMacOSConfigurationProfileCreationUpdate
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "macOS configuration profile", err)
 	}
@@ -253,6 +267,9 @@ func (c *Client) CreateMacOSConfigurationProfile(profile *ResourceMacOSConfigura
 // UpdateMacOSConfigurationProfileByID updates an existing macOS Configuration Profile by its ID on the Jamf Pro server
 // and returns the ID of the updated profile.
 func (c *Client) UpdateMacOSConfigurationProfileByID(id string, profile *ResourceMacOSConfigurationProfile) (int, error) {
+	c, done := c.startOperation("UpdateMacOSConfigurationProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMacOSConfigurationProfiles, id)
 
 	requestBody := struct {
@@ -264,7 +281,7 @@ func (c *Client) UpdateMacOSConfigurationProfileByID(id string, profile *Resourc
 
 	var response ResponseMacOSConfigurationProfileCreationUpdate
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return 0, fmt.Errorf(errMsgFailedUpdateByID, "macOS configuration profile", id, err)
 	}
@@ -279,6 +296,9 @@ func (c *Client) UpdateMacOSConfigurationProfileByID(id string, profile *Resourc
 // UpdateMacOSConfigurationProfileByName updates an existing macOS Configuration Profile by its name on the Jamf Pro server
 // and returns the ID of the updated profile.
 func (c *Client) UpdateMacOSConfigurationProfileByName(name string, profile *ResourceMacOSConfigurationProfile) (int, error) {
+	c, done := c.startOperation("UpdateMacOSConfigurationProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMacOSConfigurationProfiles, name)
 
 	requestBody := struct {
@@ -290,7 +310,7 @@ func (c *Client) UpdateMacOSConfigurationProfileByName(name string, profile *Res
 
 	var response ResponseMacOSConfigurationProfileCreationUpdate
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return 0, fmt.Errorf(errMsgFailedUpdateByName, "macOS configuration profile", name, err)
 	}
@@ -304,9 +324,12 @@ func (c *Client) UpdateMacOSConfigurationProfileByName(name string, profile *Res
 
 // DeleteMacOSConfigurationProfileByID deletes a macOS Configuration Profile by its ID from the Jamf Pro server.
 func (c *Client) DeleteMacOSConfigurationProfileByID(id string) error {
+	c, done := c.startOperation("DeleteMacOSConfigurationProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMacOSConfigurationProfiles, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "macOS configuration profile", id, err)
 	}
@@ -320,9 +343,12 @@ func (c *Client) DeleteMacOSConfigurationProfileByID(id string) error {
 
 // DeleteMacOSConfigurationProfileByName deletes a macOS Configuration Profile by its name from the Jamf Pro server.
 func (c *Client) DeleteMacOSConfigurationProfileByName(name string) error {
+	c, done := c.startOperation("DeleteMacOSConfigurationProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMacOSConfigurationProfiles, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "macOS configuration profile", name, err)
 	}
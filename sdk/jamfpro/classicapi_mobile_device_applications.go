@@ -189,10 +189,13 @@ type MobileDeviceApplicationSubsetNetworkSegment struct {
 
 // GetMobileDeviceApplications retrieves a serialized list of mobile device applications.
 func (c *Client) GetMobileDeviceApplications() (*ResponseMobileDeviceApplicationsList, error) {
+	c, done := c.startOperation("GetMobileDeviceApplications")
+	defer done()
+
 	endpoint := uriMobileDeviceApplications
 
 	var mobileDeviceApps ResponseMobileDeviceApplicationsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &mobileDeviceApps)
+	resp, err := c.doRequest("GET", endpoint, nil, &mobileDeviceApps)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mobile device applications", err)
 	}
@@ -208,10 +211,13 @@ func (c *Client) GetMobileDeviceApplications() (*ResponseMobileDeviceApplication
 
 // GetMobileDeviceApplicationByID fetches a specific mobile device application by its ID from the Jamf Pro server.
 func (c *Client) GetMobileDeviceApplicationByID(id string) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("GetMobileDeviceApplicationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceApplications, id)
 
 	var app ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	resp, err := c.doRequest("GET", endpoint, nil, &app)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application", id, err)
 	}
@@ -225,10 +231,13 @@ func (c *Client) GetMobileDeviceApplicationByID(id string) (*ResourceMobileDevic
 
 // GetMobileDeviceApplicationByName fetches a specific mobile device application by its name from the Jamf Pro server.
 func (c *Client) GetMobileDeviceApplicationByName(name string) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("GetMobileDeviceApplicationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceApplications, name)
 
 	var app ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	resp, err := c.doRequest("GET", endpoint, nil, &app)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device application", name, err)
 	}
@@ -242,10 +251,13 @@ func (c *Client) GetMobileDeviceApplicationByName(name string) (*ResourceMobileD
 
 // GetMobileDeviceApplicationByAppBundleID fetches a specific mobile device application by its bundle ID from the Jamf Pro server.
 func (c *Client) GetMobileDeviceApplicationByAppBundleID(id string) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("GetMobileDeviceApplicationByAppBundleID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/bundleid/%s", uriMobileDeviceApplications, id)
 
 	var app ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	resp, err := c.doRequest("GET", endpoint, nil, &app)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application (app bundle id)", id, err)
 	}
@@ -259,10 +271,13 @@ func (c *Client) GetMobileDeviceApplicationByAppBundleID(id string) (*ResourceMo
 
 // GetMobileDeviceApplicationByAppBundleIDAndVersion fetches a specific mobile device application by its bundle ID and version from the Jamf Pro server.
 func (c *Client) GetMobileDeviceApplicationByAppBundleIDAndVersion(id string, version string) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("GetMobileDeviceApplicationByAppBundleIDAndVersion")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/bundleid/%s/version/%s", uriMobileDeviceApplications, id, version)
 
 	var app ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	resp, err := c.doRequest("GET", endpoint, nil, &app)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application (by bundle id and version)", id, err)
 	}
@@ -276,10 +291,13 @@ func (c *Client) GetMobileDeviceApplicationByAppBundleIDAndVersion(id string, ve
 
 // GetMobileDeviceApplicationByIDAndDataSubset fetches a specific mobile device application by its ID and a specified data subset from the Jamf Pro server.
 func (c *Client) GetMobileDeviceApplicationByIDAndDataSubset(id string, subset string) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("GetMobileDeviceApplicationByIDAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/subset/%s", uriMobileDeviceApplications, id, subset)
 
 	var app ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	resp, err := c.doRequest("GET", endpoint, nil, &app)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application with data subset", id, err)
 	}
@@ -293,10 +311,13 @@ func (c *Client) GetMobileDeviceApplicationByIDAndDataSubset(id string, subset s
 
 // GetMobileDeviceApplicationByNameAndDataSubset fetches a specific mobile device application by its name and a specified data subset from the Jamf Pro server.
 func (c *Client) GetMobileDeviceApplicationByNameAndDataSubset(name string, subset string) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("GetMobileDeviceApplicationByNameAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/subset/%s", uriMobileDeviceApplications, name, subset)
 
 	var app ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
+	resp, err := c.doRequest("GET", endpoint, nil, &app)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device application and data subset", name, err)
 	}
@@ -310,6 +331,9 @@ func (c *Client) GetMobileDeviceApplicationByNameAndDataSubset(name string, subs
 
 // CreateMobileDeviceApplication creates a new mobile device application on the Jamf Pro server.
 func (c *Client) CreateMobileDeviceApplication(app *ResourceMobileDeviceApplication) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("CreateMobileDeviceApplication")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriMobileDeviceApplications)
 
 	requestBody := struct {
@@ -320,7 +344,7 @@ func (c *Client) CreateMobileDeviceApplication(app *ResourceMobileDeviceApplicat
 	}
 
 	var responseApp ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseApp)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device application", err)
 	}
@@ -334,6 +358,9 @@ func (c *Client) CreateMobileDeviceApplication(app *ResourceMobileDeviceApplicat
 
 // UpdateMobileDeviceApplicationByID updates a mobile device application by its ID on the Jamf Pro server.
 func (c *Client) UpdateMobileDeviceApplicationByID(id string, app *ResourceMobileDeviceApplication) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("UpdateMobileDeviceApplicationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceApplications, id)
 
 	// Wrap the application with the desired XML name using an anonymous struct
@@ -345,7 +372,7 @@ func (c *Client) UpdateMobileDeviceApplicationByID(id string, app *ResourceMobil
 	}
 
 	var responseApp ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device application", id, err)
 	}
@@ -359,6 +386,9 @@ func (c *Client) UpdateMobileDeviceApplicationByID(id string, app *ResourceMobil
 
 // UpdateMobileDeviceApplicationByName updates a mobile device application by its name on the Jamf Pro server.
 func (c *Client) UpdateMobileDeviceApplicationByName(name string, app *ResourceMobileDeviceApplication) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("UpdateMobileDeviceApplicationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceApplications, name)
 
 	requestBody := struct {
@@ -369,7 +399,7 @@ func (c *Client) UpdateMobileDeviceApplicationByName(name string, app *ResourceM
 	}
 
 	var responseApp ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device application", name, err)
 	}
@@ -383,6 +413,9 @@ func (c *Client) UpdateMobileDeviceApplicationByName(name string, app *ResourceM
 
 // UpdateMobileDeviceApplicationByApplicationBundleID updates a mobile device application by its bundle ID on the Jamf Pro server.
 func (c *Client) UpdateMobileDeviceApplicationByApplicationBundleID(id string, app *ResourceMobileDeviceApplication) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("UpdateMobileDeviceApplicationByApplicationBundleID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/bundleid/%s", uriMobileDeviceApplications, id)
 
 	requestBody := struct {
@@ -393,7 +426,7 @@ func (c *Client) UpdateMobileDeviceApplicationByApplicationBundleID(id string, a
 	}
 
 	var responseApp ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device application (app bundle id)", id, err)
 	}
@@ -407,6 +440,9 @@ func (c *Client) UpdateMobileDeviceApplicationByApplicationBundleID(id string, a
 
 // UpdateMobileDeviceApplicationByIDAndAppVersion updates a mobile device application by its ID and application version on the Jamf Pro server.
 func (c *Client) UpdateMobileDeviceApplicationByIDAndAppVersion(id string, version string, app *ResourceMobileDeviceApplication) (*ResourceMobileDeviceApplication, error) {
+	c, done := c.startOperation("UpdateMobileDeviceApplicationByIDAndAppVersion")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/version/%s", uriMobileDeviceApplications, id, version)
 
 	requestBody := struct {
@@ -417,7 +453,7 @@ func (c *Client) UpdateMobileDeviceApplicationByIDAndAppVersion(id string, versi
 	}
 
 	var responseApp ResourceMobileDeviceApplication
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device application and app version", id, err)
 	}
@@ -431,9 +467,12 @@ func (c *Client) UpdateMobileDeviceApplicationByIDAndAppVersion(id string, versi
 
 // DeleteMobileDeviceApplicationpByID deletes a mobile device application by its ID from the Jamf Pro server.
 func (c *Client) DeleteMobileDeviceApplicationpByID(id string) error {
+	c, done := c.startOperation("DeleteMobileDeviceApplicationpByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceApplications, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device application", id, err)
 	}
@@ -447,9 +486,12 @@ func (c *Client) DeleteMobileDeviceApplicationpByID(id string) error {
 
 // DeleteMobileDeviceApplicationByName deletes a mobile device application by its name from the Jamf Pro server.
 func (c *Client) DeleteMobileDeviceApplicationByName(name string) error {
+	c, done := c.startOperation("DeleteMobileDeviceApplicationByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceApplications, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device application", name, err)
 	}
@@ -463,9 +505,12 @@ func (c *Client) DeleteMobileDeviceApplicationByName(name string) error {
 
 // DeleteMobileDeviceApplicationByBundleID deletes a mobile device application by its bundle ID from the Jamf Pro server.
 func (c *Client) DeleteMobileDeviceApplicationByBundleID(id string) error {
+	c, done := c.startOperation("DeleteMobileDeviceApplicationByBundleID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/bundleid/%s", uriMobileDeviceApplications, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device application (bundle id)", id, err)
 	}
@@ -479,9 +524,12 @@ func (c *Client) DeleteMobileDeviceApplicationByBundleID(id string) error {
 
 // DeleteMobileDeviceApplicationByBundleIDAndVersion deletes a mobile device application by its bundle ID and version from the Jamf Pro server.
 func (c *Client) DeleteMobileDeviceApplicationByBundleIDAndVersion(id string, version string) error {
+	c, done := c.startOperation("DeleteMobileDeviceApplicationByBundleIDAndVersion")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/bundleid/%s/version/%s", uriMobileDeviceApplications, id, version)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device application (bundle id and version)", id, err)
 	}
@@ -138,10 +138,13 @@ type MobileDeviceConfigurationProfileSubsetNetworkSegment struct {
 
 // GetMobileDeviceConfigurationProfiles retrieves a serialized list of mobile device configuration profiles.
 func (c *Client) GetMobileDeviceConfigurationProfiles() (*ResponseMobileDeviceConfigurationProfilesList, error) {
+	c, done := c.startOperation("GetMobileDeviceConfigurationProfiles")
+	defer done()
+
 	endpoint := uriMobileDeviceConfigurationProfiles
 
 	var profiles ResponseMobileDeviceConfigurationProfilesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profiles)
+	resp, err := c.doRequest("GET", endpoint, nil, &profiles)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mobile device configuration profiles", err)
 	}
@@ -155,10 +158,13 @@ func (c *Client) GetMobileDeviceConfigurationProfiles() (*ResponseMobileDeviceCo
 
 // GetMobileDeviceConfigurationProfileByID fetches a specific mobile device configuration profile by its ID.
 func (c *Client) GetMobileDeviceConfigurationProfileByID(id string) (*ResourceMobileDeviceConfigurationProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceConfigurationProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceConfigurationProfiles, id)
 
 	var profile ResourceMobileDeviceConfigurationProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device configuration profile", id, err)
 	}
@@ -172,10 +178,13 @@ func (c *Client) GetMobileDeviceConfigurationProfileByID(id string) (*ResourceMo
 
 // GetMobileDeviceConfigurationProfileByName fetches a specific mobile device configuration profile by its name.
 func (c *Client) GetMobileDeviceConfigurationProfileByName(name string) (*ResourceMobileDeviceConfigurationProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceConfigurationProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceConfigurationProfiles, name)
 
 	var profile ResourceMobileDeviceConfigurationProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device configuration profile", name, err)
 	}
@@ -189,10 +198,13 @@ func (c *Client) GetMobileDeviceConfigurationProfileByName(name string) (*Resour
 
 // GetMobileDeviceConfigurationProfileByIDBySubset fetches a specific mobile device configuration profile by its ID and a specified subset.
 func (c *Client) GetMobileDeviceConfigurationProfileByIDWithSubset(id string, subset string) (*ResourceMobileDeviceConfigurationProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceConfigurationProfileByIDWithSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/subset/%s", uriMobileDeviceConfigurationProfiles, id, subset)
 
 	var profile ResourceMobileDeviceConfigurationProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device configuration profile with data subset", id, err)
 	}
@@ -206,10 +218,13 @@ func (c *Client) GetMobileDeviceConfigurationProfileByIDWithSubset(id string, su
 
 // GetMobileDeviceConfigurationProfileByNameBySubset fetches a specific mobile device configuration profile by its name and a specified subset.
 func (c *Client) GetMobileDeviceConfigurationProfileByNameWithSubset(name string, subset string) (*ResourceMobileDeviceConfigurationProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceConfigurationProfileByNameWithSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/subset/%s", uriMobileDeviceConfigurationProfiles, name, subset)
 
 	var profile ResourceMobileDeviceConfigurationProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device configuration profile with data subset", name, err)
 	}
@@ -223,6 +238,9 @@ func (c *Client) GetMobileDeviceConfigurationProfileByNameWithSubset(name string
 
 // CreateMobileDeviceConfigurationProfile creates a new mobile device configuration profile on the Jamf Pro server.
 func (c *Client) CreateMobileDeviceConfigurationProfile(profile *ResourceMobileDeviceConfigurationProfile) (*ResponseMobileDeviceConfigurationProfileCreateAndUpdate, error) {
+	c, done := c.startOperation("CreateMobileDeviceConfigurationProfile")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriMobileDeviceConfigurationProfiles)
 
 	requestBody := struct {
@@ -233,7 +251,7 @@ func (c *Client) CreateMobileDeviceConfigurationProfile(profile *ResourceMobileD
 	}
 
 	var responseProfile ResponseMobileDeviceConfigurationProfileCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device configuration profile", err)
 	}
@@ -247,6 +265,9 @@ func (c *Client) CreateMobileDeviceConfigurationProfile(profile *ResourceMobileD
 
 // UpdateMobileDeviceConfigurationProfileByID updates a mobile device configuration profile by its ID on the Jamf Pro server.
 func (c *Client) UpdateMobileDeviceConfigurationProfileByID(id string, profile *ResourceMobileDeviceConfigurationProfile) (*ResponseMobileDeviceConfigurationProfileCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateMobileDeviceConfigurationProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceConfigurationProfiles, id)
 
 	requestBody := struct {
@@ -257,7 +278,7 @@ func (c *Client) UpdateMobileDeviceConfigurationProfileByID(id string, profile *
 	}
 
 	var responseProfile ResponseMobileDeviceConfigurationProfileCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device configuration profile", id, err)
 	}
@@ -271,6 +292,9 @@ func (c *Client) UpdateMobileDeviceConfigurationProfileByID(id string, profile *
 
 // UpdateMobileDeviceConfigurationProfileByName updates a mobile device configuration profile by its name on the Jamf Pro server.
 func (c *Client) UpdateMobileDeviceConfigurationProfileByName(name string, profile *ResourceMobileDeviceConfigurationProfile) (*ResponseMobileDeviceConfigurationProfileCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateMobileDeviceConfigurationProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceConfigurationProfiles, name)
 
 	requestBody := struct {
@@ -281,7 +305,7 @@ func (c *Client) UpdateMobileDeviceConfigurationProfileByName(name string, profi
 	}
 
 	var responseProfile ResponseMobileDeviceConfigurationProfileCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device configuration profile", name, err)
 	}
@@ -295,9 +319,12 @@ func (c *Client) UpdateMobileDeviceConfigurationProfileByName(name string, profi
 
 // DeleteMobileDeviceConfigurationProfileByID deletes a mobile device configuration profile by its ID from the Jamf Pro server.
 func (c *Client) DeleteMobileDeviceConfigurationProfileByID(id string) error {
+	c, done := c.startOperation("DeleteMobileDeviceConfigurationProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceConfigurationProfiles, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device configuration profile", id, err)
 	}
@@ -311,9 +338,12 @@ func (c *Client) DeleteMobileDeviceConfigurationProfileByID(id string) error {
 
 // DeleteMobileDeviceConfigurationProfileByName deletes a mobile device configuration profile by its name from the Jamf Pro server.
 func (c *Client) DeleteMobileDeviceConfigurationProfileByName(name string) error {
+	c, done := c.startOperation("DeleteMobileDeviceConfigurationProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceConfigurationProfiles, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device configuration profile", name, err)
 	}
@@ -94,10 +94,13 @@ type MobileDeviceEnrollmentProfileSubsetAttachments struct {
 
 // GetMobileDeviceEnrollmentProfiles retrieves a serialized list of mobile device enrollment profiles.
 func (c *Client) GetMobileDeviceEnrollmentProfiles() (*ResponseMobileDeviceEnrollmentProfilesList, error) {
+	c, done := c.startOperation("GetMobileDeviceEnrollmentProfiles")
+	defer done()
+
 	endpoint := uriMobileDeviceEnrollmentProfiles
 
 	var enrollmentProfiles ResponseMobileDeviceEnrollmentProfilesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &enrollmentProfiles)
+	resp, err := c.doRequest("GET", endpoint, nil, &enrollmentProfiles)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mobile device enrollment profiles", err)
 	}
@@ -111,10 +114,13 @@ func (c *Client) GetMobileDeviceEnrollmentProfiles() (*ResponseMobileDeviceEnrol
 
 // GetMobileDeviceEnrollmentProfileByID fetches a specific mobile device enrollment profile by its ID.
 func (c *Client) GetMobileDeviceEnrollmentProfileByID(id string) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceEnrollmentProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceEnrollmentProfiles, id)
 
 	var profile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device enrollment profile", id, err)
 	}
@@ -128,10 +134,13 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByID(id string) (*ResourceMobil
 
 // GetMobileDeviceEnrollmentProfileByName fetches a specific mobile device enrollment profile by its name.
 func (c *Client) GetMobileDeviceEnrollmentProfileByName(name string) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceEnrollmentProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceEnrollmentProfiles, name)
 
 	var profile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device enrollment profile", name, err)
 	}
@@ -145,10 +154,13 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByName(name string) (*ResourceM
 
 // GetProfileByInvitation fetches a specific mobile device enrollment profile by its invitation.
 func (c *Client) GetProfileByInvitation(invitation string) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("GetProfileByInvitation")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/invitation/%s", uriMobileDeviceEnrollmentProfiles, invitation)
 
 	var profile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByString, "mobile device enrollment profile", "invitation", invitation, err)
 	}
@@ -162,10 +174,13 @@ func (c *Client) GetProfileByInvitation(invitation string) (*ResourceMobileDevic
 
 // GetMobileDeviceEnrollmentProfileByIDBySubset fetches a specific mobile device configuration profile by its ID and a specified subset.
 func (c *Client) GetMobileDeviceEnrollmentProfileByIDWithSubset(id string, subset string) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceEnrollmentProfileByIDWithSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/subset/%s", uriMobileDeviceEnrollmentProfiles, id, subset)
 
 	var profile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device enrollment profile", id, err)
 	}
@@ -179,10 +194,13 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByIDWithSubset(id string, subse
 
 // GetMobileDeviceEnrollmentProfileByNameBySubset fetches a specific mobile device configuration profile by its name and a specified subset.
 func (c *Client) GetMobileDeviceEnrollmentProfileByNameWithSubset(name string, subset string) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceEnrollmentProfileByNameWithSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/subset/%s", uriMobileDeviceEnrollmentProfiles, name, subset)
 
 	var profile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device enrollment profile", name, err)
 	}
@@ -196,6 +214,9 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByNameWithSubset(name string, s
 
 // CreateMobileDeviceEnrollmentProfile creates a new mobile device enrollment profile on the Jamf Pro server.
 func (c *Client) CreateMobileDeviceEnrollmentProfile(profile *ResourceMobileDeviceEnrollmentProfile) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("CreateMobileDeviceEnrollmentProfile")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriMobileDeviceEnrollmentProfiles)
 
 	requestBody := struct {
@@ -206,7 +227,7 @@ func (c *Client) CreateMobileDeviceEnrollmentProfile(profile *ResourceMobileDevi
 	}
 
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device enrollment profile", err)
 	}
@@ -220,6 +241,9 @@ func (c *Client) CreateMobileDeviceEnrollmentProfile(profile *ResourceMobileDevi
 
 // UpdateMobileDeviceEnrollmentProfileByID updates a mobile device enrollment profile by its ID.
 func (c *Client) UpdateMobileDeviceEnrollmentProfileByID(id string, profile *ResourceMobileDeviceEnrollmentProfile) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("UpdateMobileDeviceEnrollmentProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceEnrollmentProfiles, id)
 
 	requestBody := struct {
@@ -230,7 +254,7 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByID(id string, profile *Res
 	}
 
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device enrollment profile", id, err)
 	}
@@ -244,6 +268,9 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByID(id string, profile *Res
 
 // UpdateMobileDeviceEnrollmentProfileByName updates a mobile device enrollment profile by its name.
 func (c *Client) UpdateMobileDeviceEnrollmentProfileByName(name string, profile *ResourceMobileDeviceEnrollmentProfile) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("UpdateMobileDeviceEnrollmentProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceEnrollmentProfiles, name)
 
 	requestBody := struct {
@@ -254,7 +281,7 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByName(name string, profile
 	}
 
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device enrollment profile", name, err)
 	}
@@ -268,6 +295,9 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByName(name string, profile
 
 // UpdateMobileDeviceEnrollmentProfileByInvitation updates a mobile device enrollment profile by its invitation.
 func (c *Client) UpdateMobileDeviceEnrollmentProfileByInvitation(invitation string, profile *ResourceMobileDeviceEnrollmentProfile) (*ResourceMobileDeviceEnrollmentProfile, error) {
+	c, done := c.startOperation("UpdateMobileDeviceEnrollmentProfileByInvitation")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/invitation/%s", uriMobileDeviceEnrollmentProfiles, invitation)
 
 	requestBody := struct {
@@ -278,7 +308,7 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByInvitation(invitation stri
 	}
 
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByString, "mobile device enrollment profile", "invitation", invitation, err)
 	}
@@ -292,9 +322,12 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByInvitation(invitation stri
 
 // DeleteMobileDeviceEnrollmentProfileByID deletes a mobile device enrollment profile by its ID.
 func (c *Client) DeleteMobileDeviceEnrollmentProfileByID(id string) error {
+	c, done := c.startOperation("DeleteMobileDeviceEnrollmentProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceEnrollmentProfiles, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device enrollment profile", id, err)
 	}
@@ -308,9 +341,12 @@ func (c *Client) DeleteMobileDeviceEnrollmentProfileByID(id string) error {
 
 // DeleteMobileDeviceEnrollmentProfileByName deletes a mobile device enrollment profile by its name.
 func (c *Client) DeleteMobileDeviceEnrollmentProfileByName(name string) error {
+	c, done := c.startOperation("DeleteMobileDeviceEnrollmentProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceEnrollmentProfiles, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device enrollment profile", name, err)
 	}
@@ -324,9 +360,12 @@ func (c *Client) DeleteMobileDeviceEnrollmentProfileByName(name string) error {
 
 // DeleteMobileDeviceEnrollmentProfileByInvitation deletes a mobile device enrollment profile by its invitation.
 func (c *Client) DeleteMobileDeviceEnrollmentProfileByInvitation(invitation string) error {
+	c, done := c.startOperation("DeleteMobileDeviceEnrollmentProfileByInvitation")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/invitation/%s", uriMobileDeviceEnrollmentProfiles, invitation)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByString, "mobile device enrollment profile", "invitation", invitation, err)
 	}
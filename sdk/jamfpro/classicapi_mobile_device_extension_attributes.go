@@ -45,10 +45,13 @@ type MobileExtensionAttributeSubsetInputType struct {
 
 // GetMobileExtensionAttributes retrieves a serialized list of mobile device extension attributes.
 func (c *Client) GetMobileExtensionAttributes() (*ResponseMobileDeviceExtensionAttributesList, error) {
+	c, done := c.startOperation("GetMobileExtensionAttributes")
+	defer done()
+
 	endpoint := uriMobileDeviceExtensionAttributes
 
 	var extensionAttributes ResponseMobileDeviceExtensionAttributesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extensionAttributes)
+	resp, err := c.doRequest("GET", endpoint, nil, &extensionAttributes)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mobile device extension attributes", err)
 	}
@@ -62,10 +65,13 @@ func (c *Client) GetMobileExtensionAttributes() (*ResponseMobileDeviceExtensionA
 
 // GetMobileExtensionAttributeByID fetches a specific mobile extension attribute by its ID.
 func (c *Client) GetMobileExtensionAttributeByID(id string) (*ResourceMobileExtensionAttribute, error) {
+	c, done := c.startOperation("GetMobileExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceExtensionAttributes, id)
 
 	var attribute ResourceMobileExtensionAttribute
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &attribute)
+	resp, err := c.doRequest("GET", endpoint, nil, &attribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device extension attribute", id, err)
 	}
@@ -79,10 +85,13 @@ func (c *Client) GetMobileExtensionAttributeByID(id string) (*ResourceMobileExte
 
 // GetMobileExtensionAttributeByName fetches a specific mobile extension attribute by its name.
 func (c *Client) GetMobileExtensionAttributeByName(name string) (*ResourceMobileExtensionAttribute, error) {
+	c, done := c.startOperation("GetMobileExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceExtensionAttributes, name)
 
 	var attribute ResourceMobileExtensionAttribute
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &attribute)
+	resp, err := c.doRequest("GET", endpoint, nil, &attribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device extension attribute", name, err)
 	}
@@ -96,6 +105,9 @@ func (c *Client) GetMobileExtensionAttributeByName(name string) (*ResourceMobile
 
 // CreateMobileExtensionAttribute creates a new mobile device extension attribute.
 func (c *Client) CreateMobileExtensionAttribute(attribute *ResourceMobileExtensionAttribute) (*ResourceMobileExtensionAttribute, error) {
+	c, done := c.startOperation("CreateMobileExtensionAttribute")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriMobileDeviceExtensionAttributes)
 
 	requestBody := struct {
@@ -106,7 +118,7 @@ func (c *Client) CreateMobileExtensionAttribute(attribute *ResourceMobileExtensi
 	}
 
 	var responseAttribute ResourceMobileExtensionAttribute
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseAttribute)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device extension attribute", err)
 	}
@@ -120,6 +132,9 @@ func (c *Client) CreateMobileExtensionAttribute(attribute *ResourceMobileExtensi
 
 // UpdateMobileExtensionAttributeByID updates a mobile extension attribute by its ID.
 func (c *Client) UpdateMobileExtensionAttributeByID(id string, attribute *ResourceMobileExtensionAttribute) (*ResourceMobileExtensionAttribute, error) {
+	c, done := c.startOperation("UpdateMobileExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceExtensionAttributes, id)
 
 	requestBody := struct {
@@ -130,7 +145,7 @@ func (c *Client) UpdateMobileExtensionAttributeByID(id string, attribute *Resour
 	}
 
 	var responseAttribute ResourceMobileExtensionAttribute
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device extension attribute", id, err)
 	}
@@ -144,6 +159,9 @@ func (c *Client) UpdateMobileExtensionAttributeByID(id string, attribute *Resour
 
 // UpdateMobileExtensionAttributeByName updates a mobile extension attribute by its name.
 func (c *Client) UpdateMobileExtensionAttributeByName(name string, attribute *ResourceMobileExtensionAttribute) (*ResourceMobileExtensionAttribute, error) {
+	c, done := c.startOperation("UpdateMobileExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceExtensionAttributes, name)
 
 	requestBody := struct {
@@ -154,7 +172,7 @@ func (c *Client) UpdateMobileExtensionAttributeByName(name string, attribute *Re
 	}
 
 	var responseAttribute ResourceMobileExtensionAttribute
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device extension attribute", name, err)
 	}
@@ -168,9 +186,12 @@ func (c *Client) UpdateMobileExtensionAttributeByName(name string, attribute *Re
 
 // DeleteMobileExtensionAttributeByID deletes a mobile extension attribute by its ID.
 func (c *Client) DeleteMobileExtensionAttributeByID(id string) error {
+	c, done := c.startOperation("DeleteMobileExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceExtensionAttributes, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device extension attribute", id, err)
 	}
@@ -184,9 +205,12 @@ func (c *Client) DeleteMobileExtensionAttributeByID(id string) error {
 
 // DeleteMobileExtensionAttributeByName deletes a mobile extension attribute by its name.
 func (c *Client) DeleteMobileExtensionAttributeByName(name string) error {
+	c, done := c.startOperation("DeleteMobileExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceExtensionAttributes, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device extension attribute", name, err)
 	}
@@ -60,10 +60,13 @@ type MobileDeviceGroupSubsetDeviceItem struct {
 
 // GetMobileDeviceGroups retrieves a serialized list of mobile device groups.
 func (c *Client) GetMobileDeviceGroups() (*ResponseMobileDeviceGroupsList, error) {
+	c, done := c.startOperation("GetMobileDeviceGroups")
+	defer done()
+
 	endpoint := uriMobileDeviceGroups
 
 	var groups ResponseMobileDeviceGroupsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &groups)
+	resp, err := c.doRequest("GET", endpoint, nil, &groups)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mobile device groups", err)
 	}
@@ -77,10 +80,13 @@ func (c *Client) GetMobileDeviceGroups() (*ResponseMobileDeviceGroupsList, error
 
 // GetMobileDeviceGroupsByID retrieves a single mobile device group by its ID.
 func (c *Client) GetMobileDeviceGroupByID(id string) (*ResourceMobileDeviceGroup, error) {
+	c, done := c.startOperation("GetMobileDeviceGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceGroups, id)
 
 	var group ResourceMobileDeviceGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
+	resp, err := c.doRequest("GET", endpoint, nil, &group)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device group", id, err)
 	}
@@ -94,10 +100,13 @@ func (c *Client) GetMobileDeviceGroupByID(id string) (*ResourceMobileDeviceGroup
 
 // GetMobileDeviceGroupsByName retrieves a single mobile device group by its name.
 func (c *Client) GetMobileDeviceGroupByName(name string) (*ResourceMobileDeviceGroup, error) {
+	c, done := c.startOperation("GetMobileDeviceGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceGroups, name)
 
 	var group ResourceMobileDeviceGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
+	resp, err := c.doRequest("GET", endpoint, nil, &group)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device group", name, err)
 	}
@@ -111,6 +120,9 @@ func (c *Client) GetMobileDeviceGroupByName(name string) (*ResourceMobileDeviceG
 
 // CreateMobileDeviceGroup creates a new mobile device group on the Jamf Pro server.
 func (c *Client) CreateMobileDeviceGroup(group *ResourceMobileDeviceGroup) (*ResourceMobileDeviceGroup, error) {
+	c, done := c.startOperation("CreateMobileDeviceGroup")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriMobileDeviceGroups)
 
 	requestBody := struct {
@@ -121,7 +133,7 @@ func (c *Client) CreateMobileDeviceGroup(group *ResourceMobileDeviceGroup) (*Res
 	}
 
 	var responseGroup ResourceMobileDeviceGroup
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseGroup)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device group", err)
 	}
@@ -135,6 +147,9 @@ func (c *Client) CreateMobileDeviceGroup(group *ResourceMobileDeviceGroup) (*Res
 
 // UpdateMobileDeviceGroupByID updates a mobile device group by its ID.
 func (c *Client) UpdateMobileDeviceGroupByID(id string, group *ResourceMobileDeviceGroup) (*ResourceMobileDeviceGroup, error) {
+	c, done := c.startOperation("UpdateMobileDeviceGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceGroups, id)
 
 	requestBody := struct {
@@ -145,7 +160,7 @@ func (c *Client) UpdateMobileDeviceGroupByID(id string, group *ResourceMobileDev
 	}
 
 	var updatedGroup ResourceMobileDeviceGroup
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device group", id, err)
 	}
@@ -159,6 +174,9 @@ func (c *Client) UpdateMobileDeviceGroupByID(id string, group *ResourceMobileDev
 
 // UpdateMobileDeviceGroupByName updates a mobile device group by its name.
 func (c *Client) UpdateMobileDeviceGroupByName(name string, group *ResourceMobileDeviceGroup) (*ResourceMobileDeviceGroup, error) {
+	c, done := c.startOperation("UpdateMobileDeviceGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceGroups, name)
 
 	requestBody := struct {
@@ -169,7 +187,7 @@ func (c *Client) UpdateMobileDeviceGroupByName(name string, group *ResourceMobil
 	}
 
 	var updatedGroup ResourceMobileDeviceGroup
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device group", name, err)
 	}
@@ -183,9 +201,12 @@ func (c *Client) UpdateMobileDeviceGroupByName(name string, group *ResourceMobil
 
 // DeleteMobileDeviceGroupByID deletes a mobile device group by its ID.
 func (c *Client) DeleteMobileDeviceGroupByID(id string) error {
+	c, done := c.startOperation("DeleteMobileDeviceGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceGroups, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device group", id, err)
 	}
@@ -199,9 +220,12 @@ func (c *Client) DeleteMobileDeviceGroupByID(id string) error {
 
 // DeleteMobileDeviceGroupByName deletes a mobile device group by its name.
 func (c *Client) DeleteMobileDeviceGroupByName(name string) error {
+	c, done := c.startOperation("DeleteMobileDeviceGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceGroups, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device group", name, err)
 	}
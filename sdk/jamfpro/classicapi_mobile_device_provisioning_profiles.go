@@ -47,10 +47,13 @@ type MobileDeviceProvisioningProfileSubsetGeneral struct {
 
 // GetMobileDeviceProvisioningProfiles retrieves a serialized list of mobile device provisioning profiles.
 func (c *Client) GetMobileDeviceProvisioningProfiles() (*ResponseMobileDeviceProvisioningProfilesList, error) {
+	c, done := c.startOperation("GetMobileDeviceProvisioningProfiles")
+	defer done()
+
 	endpoint := uriMobileDeviceProvisioningProfiles
 
 	var profiles ResponseMobileDeviceProvisioningProfilesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profiles)
+	resp, err := c.doRequest("GET", endpoint, nil, &profiles)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mobile device provisioning profiles", err)
 	}
@@ -64,10 +67,13 @@ func (c *Client) GetMobileDeviceProvisioningProfiles() (*ResponseMobileDevicePro
 
 // GetMobileDeviceProvisioningProfileByID fetches a specific mobile device provisioning profile by its ID.
 func (c *Client) GetMobileDeviceProvisioningProfileByID(id string) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceProvisioningProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceProvisioningProfiles, id)
 
 	var profile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device provisioning profile", id, err)
 	}
@@ -81,10 +87,13 @@ func (c *Client) GetMobileDeviceProvisioningProfileByID(id string) (*ResourceMob
 
 // GetMobileDeviceProvisioningProfileByName fetches a specific mobile device provisioning profile by its name.
 func (c *Client) GetMobileDeviceProvisioningProfileByName(name string) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceProvisioningProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceProvisioningProfiles, name)
 
 	var profile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device provisioning profile", name, err)
 	}
@@ -98,10 +107,13 @@ func (c *Client) GetMobileDeviceProvisioningProfileByName(name string) (*Resourc
 
 // GetMobileDeviceProvisioningProfileByUUID fetches a specific mobile device provisioning profile by its UUID.
 func (c *Client) GetMobileDeviceProvisioningProfileByUUID(uuid string) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("GetMobileDeviceProvisioningProfileByUUID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/uuid/%s", uriMobileDeviceProvisioningProfiles, uuid)
 
 	var profile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
+	resp, err := c.doRequest("GET", endpoint, nil, &profile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByString, "mobile device provisioning profile", "uuid", uuid, err)
 	}
@@ -115,6 +127,9 @@ func (c *Client) GetMobileDeviceProvisioningProfileByUUID(uuid string) (*Resourc
 
 // CreateMobileDeviceProvisioningProfileByID creates a new mobile device provisioning profile by its ID.
 func (c *Client) CreateMobileDeviceProvisioningProfile(id string, profile *ResourceMobileDeviceProvisioningProfile) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("CreateMobileDeviceProvisioningProfile")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceProvisioningProfiles, id)
 
 	requestBody := struct {
@@ -125,7 +140,7 @@ func (c *Client) CreateMobileDeviceProvisioningProfile(id string, profile *Resou
 	}
 
 	var responseProfile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "id", id, err)
 	}
@@ -139,6 +154,9 @@ func (c *Client) CreateMobileDeviceProvisioningProfile(id string, profile *Resou
 
 // CreateMobileDeviceProvisioningProfileByName creates a new mobile device provisioning profile by its name.
 func (c *Client) CreateMobileDeviceProvisioningProfileByName(name string, profile *ResourceMobileDeviceProvisioningProfile) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("CreateMobileDeviceProvisioningProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceProvisioningProfiles, name)
 
 	requestBody := struct {
@@ -149,7 +167,7 @@ func (c *Client) CreateMobileDeviceProvisioningProfileByName(name string, profil
 	}
 
 	var responseProfile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "name", name, err)
 	}
@@ -165,6 +183,9 @@ func (c *Client) CreateMobileDeviceProvisioningProfileByName(name string, profil
 
 // CreateMobileDeviceProvisioningProfileByUUID creates a new mobile device provisioning profile by its UUID.
 func (c *Client) CreateMobileDeviceProvisioningProfileByUUID(uuid string, profile *ResourceMobileDeviceProvisioningProfile) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("CreateMobileDeviceProvisioningProfileByUUID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/uuid/%s", uriMobileDeviceProvisioningProfiles, uuid)
 
 	requestBody := struct {
@@ -175,7 +196,7 @@ func (c *Client) CreateMobileDeviceProvisioningProfileByUUID(uuid string, profil
 	}
 
 	var responseProfile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "uuid", uuid, err)
 	}
@@ -189,6 +210,9 @@ func (c *Client) CreateMobileDeviceProvisioningProfileByUUID(uuid string, profil
 
 // UpdateMobileDeviceProvisioningProfileByID updates a mobile device provisioning profile by its ID.
 func (c *Client) UpdateMobileDeviceProvisioningProfileByID(id string, profile *ResourceMobileDeviceProvisioningProfile) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("UpdateMobileDeviceProvisioningProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceProvisioningProfiles, id)
 
 	requestBody := struct {
@@ -199,7 +223,7 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByID(id string, profile *R
 	}
 
 	var updatedProfile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device provisioning profile", id, err)
 	}
@@ -213,6 +237,9 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByID(id string, profile *R
 
 // UpdateMobileDeviceProvisioningProfileByName updates a mobile device provisioning profile by its name.
 func (c *Client) UpdateMobileDeviceProvisioningProfileByName(name string, profile *ResourceMobileDeviceProvisioningProfile) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("UpdateMobileDeviceProvisioningProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceProvisioningProfiles, name)
 
 	requestBody := struct {
@@ -223,7 +250,7 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByName(name string, profil
 	}
 
 	var updatedProfile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device provisioning profile", name, err)
 	}
@@ -237,6 +264,9 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByName(name string, profil
 
 // UpdateMobileDeviceProvisioningProfileByUUID updates a mobile device provisioning profile by its UUID.
 func (c *Client) UpdateMobileDeviceProvisioningProfileByUUID(uuid string, profile *ResourceMobileDeviceProvisioningProfile) (*ResourceMobileDeviceProvisioningProfile, error) {
+	c, done := c.startOperation("UpdateMobileDeviceProvisioningProfileByUUID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/uuid/%s", uriMobileDeviceProvisioningProfiles, uuid)
 
 	requestBody := struct {
@@ -247,7 +277,7 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByUUID(uuid string, profil
 	}
 
 	var updatedProfile ResourceMobileDeviceProvisioningProfile
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByString, "mobile device provisioning profile", "uuid", uuid, err)
 	}
@@ -261,9 +291,12 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByUUID(uuid string, profil
 
 // DeleteMobileDeviceProvisioningProfileByID deletes a mobile device provisioning profile by ID
 func (c *Client) DeleteMobileDeviceProvisioningProfileByID(id string) error {
+	c, done := c.startOperation("DeleteMobileDeviceProvisioningProfileByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDeviceProvisioningProfiles, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device provisioning profile", id, err)
 	}
@@ -277,9 +310,12 @@ func (c *Client) DeleteMobileDeviceProvisioningProfileByID(id string) error {
 
 // DeleteMobileDeviceProvisioningProfileByName deletes a mobile device provisioning profile by Name
 func (c *Client) DeleteMobileDeviceProvisioningProfileByName(name string) error {
+	c, done := c.startOperation("DeleteMobileDeviceProvisioningProfileByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDeviceProvisioningProfiles, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device provisioning profile", name, err)
 	}
@@ -293,9 +329,12 @@ func (c *Client) DeleteMobileDeviceProvisioningProfileByName(name string) error
 
 // DeleteMobileDeviceProvisioningProfileByUUID deletes a mobile device provisioning profile by UUID
 func (c *Client) DeleteMobileDeviceProvisioningProfileByUUID(uuid string) error {
+	c, done := c.startOperation("DeleteMobileDeviceProvisioningProfileByUUID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/uuid/%s", uriMobileDeviceProvisioningProfiles, uuid)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByString, "mobile device provisioning profile", "uuid", uuid, err)
 	}
@@ -225,10 +225,13 @@ type MobileDeviceSubsetExtensionAttribute struct {
 
 // GetMobileDevices retrieves a list of all mobile devices.
 func (c *Client) GetMobileDevices() (*ResponseMobileDeviceList, error) {
+	c, done := c.startOperation("GetMobileDevices")
+	defer done()
+
 	endpoint := uriMobileDevices
 
 	var mobileDevices ResponseMobileDeviceList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &mobileDevices)
+	resp, err := c.doRequest("GET", endpoint, nil, &mobileDevices)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "mobile devices", err)
 	}
@@ -242,10 +245,13 @@ func (c *Client) GetMobileDevices() (*ResponseMobileDeviceList, error) {
 
 // GetMobileDeviceByID retrieves a specific mobile device by its ID.
 func (c *Client) GetMobileDeviceByID(id string) (*ResourceMobileDevice, error) {
+	c, done := c.startOperation("GetMobileDeviceByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDevices, id)
 
 	var device ResourceMobileDevice
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &device)
+	resp, err := c.doRequest("GET", endpoint, nil, &device)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device", id, err)
 	}
@@ -259,10 +265,13 @@ func (c *Client) GetMobileDeviceByID(id string) (*ResourceMobileDevice, error) {
 
 // GetMobileDeviceByName retrieves a specific mobile device by its name.
 func (c *Client) GetMobileDeviceByName(name string) (*ResourceMobileDevice, error) {
+	c, done := c.startOperation("GetMobileDeviceByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDevices, name)
 
 	var device ResourceMobileDevice
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &device)
+	resp, err := c.doRequest("GET", endpoint, nil, &device)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device", name, err)
 	}
@@ -276,10 +285,13 @@ func (c *Client) GetMobileDeviceByName(name string) (*ResourceMobileDevice, erro
 
 // GetMobileDeviceByIDAndDataSubset retrieves a specific subset of data for a mobile device by its ID.
 func (c *Client) GetMobileDeviceByIDAndDataSubset(id string, subset string) (*ResourceMobileDevice, error) {
+	c, done := c.startOperation("GetMobileDeviceByIDAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/subset/%s", uriMobileDevices, id, subset)
 
 	var deviceSubset ResourceMobileDevice
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &deviceSubset)
+	resp, err := c.doRequest("GET", endpoint, nil, &deviceSubset)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device with data subset", id, err)
 	}
@@ -293,10 +305,13 @@ func (c *Client) GetMobileDeviceByIDAndDataSubset(id string, subset string) (*Re
 
 // GetMobileDeviceByNameAndDataSubset retrieves a specific subset of data for a mobile device by its name.
 func (c *Client) GetMobileDeviceByNameAndDataSubset(name, subset string) (*ResourceMobileDevice, error) {
+	c, done := c.startOperation("GetMobileDeviceByNameAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s/subset/%s", uriMobileDevices, name, subset)
 
 	var deviceSubset ResourceMobileDevice
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &deviceSubset)
+	resp, err := c.doRequest("GET", endpoint, nil, &deviceSubset)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device with data subset", name, err)
 	}
@@ -310,6 +325,9 @@ func (c *Client) GetMobileDeviceByNameAndDataSubset(name, subset string) (*Resou
 
 // CreateMobileDevice creates a new mobile device device.
 func (c *Client) CreateMobileDevice(attribute *ResourceMobileDevice) (*ResourceMobileDevice, error) {
+	c, done := c.startOperation("CreateMobileDevice")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriMobileDevices)
 
 	requestBody := struct {
@@ -320,7 +338,7 @@ func (c *Client) CreateMobileDevice(attribute *ResourceMobileDevice) (*ResourceM
 	}
 
 	var responseAttribute ResourceMobileDevice
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseAttribute)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device", err)
 	}
@@ -334,6 +352,9 @@ func (c *Client) CreateMobileDevice(attribute *ResourceMobileDevice) (*ResourceM
 
 // UpdateMobileDeviceByID updates a mobile device by its ID.
 func (c *Client) UpdateMobileDeviceByID(id string, attribute *ResourceMobileDevice) (*ResourceMobileDevice, error) {
+	c, done := c.startOperation("UpdateMobileDeviceByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDevices, id)
 
 	requestBody := struct {
@@ -344,7 +365,7 @@ func (c *Client) UpdateMobileDeviceByID(id string, attribute *ResourceMobileDevi
 	}
 
 	var responseAttribute ResourceMobileDevice
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device", id, err)
 	}
@@ -358,6 +379,9 @@ func (c *Client) UpdateMobileDeviceByID(id string, attribute *ResourceMobileDevi
 
 // UpdateMobileDeviceByName updates a mobile device by its name.
 func (c *Client) UpdateMobileDeviceByName(name string, attribute *ResourceMobileDevice) (*ResourceMobileDevice, error) {
+	c, done := c.startOperation("UpdateMobileDeviceByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDevices, name)
 
 	requestBody := struct {
@@ -368,7 +392,7 @@ func (c *Client) UpdateMobileDeviceByName(name string, attribute *ResourceMobile
 	}
 
 	var responseAttribute ResourceMobileDevice
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device", name, err)
 	}
@@ -382,9 +406,12 @@ func (c *Client) UpdateMobileDeviceByName(name string, attribute *ResourceMobile
 
 // DeleteMobileDeviceByID deletes a mobile device by its ID.
 func (c *Client) DeleteMobileDeviceByID(id string) error {
+	c, done := c.startOperation("DeleteMobileDeviceByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriMobileDevices, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device", id, err)
 	}
@@ -398,9 +425,12 @@ func (c *Client) DeleteMobileDeviceByID(id string) error {
 
 // DeleteMobileDeviceByName deletes a mobile device by its name.
 func (c *Client) DeleteMobileDeviceByName(name string) error {
+	c, done := c.startOperation("DeleteMobileDeviceByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriMobileDevices, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device", name, err)
 	}
@@ -51,10 +51,13 @@ type ResourceNetworkSegment struct {
 
 // GetNetworkSegments retrieves a list of network segments.
 func (c *Client) GetNetworkSegments() (*ResponseNetworkSegmentList, error) {
+	c, done := c.startOperation("GetNetworkSegments")
+	defer done()
+
 	endpoint := uriNetworkSegments
 
 	var segments ResponseNetworkSegmentList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &segments)
+	resp, err := c.doRequest("GET", endpoint, nil, &segments)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "network segments", err)
 	}
@@ -68,10 +71,13 @@ func (c *Client) GetNetworkSegments() (*ResponseNetworkSegmentList, error) {
 
 // GetNetworkSegmentByID retrieves a specific network segment by its ID.
 func (c *Client) GetNetworkSegmentByID(id string) (*ResourceNetworkSegment, error) {
+	c, done := c.startOperation("GetNetworkSegmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriNetworkSegments, id)
 
 	var segment ResourceNetworkSegment
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &segment)
+	resp, err := c.doRequest("GET", endpoint, nil, &segment)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "network segment", id, err)
 	}
@@ -85,10 +91,13 @@ func (c *Client) GetNetworkSegmentByID(id string) (*ResourceNetworkSegment, erro
 
 // GetNetworkSegmentByName retrieves a specific network segment by its name.
 func (c *Client) GetNetworkSegmentByName(name string) (*ResourceNetworkSegment, error) {
+	c, done := c.startOperation("GetNetworkSegmentByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriNetworkSegments, name)
 
 	var segment ResourceNetworkSegment
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &segment)
+	resp, err := c.doRequest("GET", endpoint, nil, &segment)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "network segment", name, err)
 	}
@@ -102,6 +111,9 @@ func (c *Client) GetNetworkSegmentByName(name string) (*ResourceNetworkSegment,
 
 // CreateNetworkSegment creates a new network segment on the Jamf Pro server.
 func (c *Client) CreateNetworkSegment(segment *ResourceNetworkSegment) (*ResponseNetworkSegmentCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreateNetworkSegment")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriNetworkSegments)
 
 	requestBody := struct {
@@ -112,7 +124,7 @@ func (c *Client) CreateNetworkSegment(segment *ResourceNetworkSegment) (*Respons
 	}
 
 	var responseSegment ResponseNetworkSegmentCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseSegment)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseSegment)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "network segment", err)
 	}
@@ -126,6 +138,9 @@ func (c *Client) CreateNetworkSegment(segment *ResourceNetworkSegment) (*Respons
 
 // UpdateNetworkSegmentByID updates a specific network segment by its ID.
 func (c *Client) UpdateNetworkSegmentByID(id string, segment *ResourceNetworkSegment) (*ResponseNetworkSegmentCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateNetworkSegmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriNetworkSegments, id)
 
 	requestBody := struct {
@@ -136,7 +151,7 @@ func (c *Client) UpdateNetworkSegmentByID(id string, segment *ResourceNetworkSeg
 	}
 
 	var responseSegment ResponseNetworkSegmentCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseSegment)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseSegment)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "network segment", id, err)
 	}
@@ -150,6 +165,9 @@ func (c *Client) UpdateNetworkSegmentByID(id string, segment *ResourceNetworkSeg
 
 // UpdateNetworkSegmentByName updates a specific network segment by its name.
 func (c *Client) UpdateNetworkSegmentByName(name string, segment *ResourceNetworkSegment) (*ResponseNetworkSegmentCreatedAndUpdated, error) {
+	c, done := c.startOperation("UpdateNetworkSegmentByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriNetworkSegments, name)
 
 	requestBody := struct {
@@ -160,7 +178,7 @@ func (c *Client) UpdateNetworkSegmentByName(name string, segment *ResourceNetwor
 	}
 
 	var responseSegment ResponseNetworkSegmentCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseSegment)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseSegment)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "network segment", name, err)
 	}
@@ -174,8 +192,11 @@ func (c *Client) UpdateNetworkSegmentByName(name string, segment *ResourceNetwor
 
 // DeleteNetworkSegmentByID deletes a policy by its ID.
 func (c *Client) DeleteNetworkSegmentByID(id string) error {
+	c, done := c.startOperation("DeleteNetworkSegmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriNetworkSegments, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "network segment", id, err)
 	}
@@ -189,8 +210,11 @@ func (c *Client) DeleteNetworkSegmentByID(id string) error {
 
 // DeleteNetworkSegmentByName deletes a policy by its name.
 func (c *Client) DeleteNetworkSegmentByName(name string) error {
+	c, done := c.startOperation("DeleteNetworkSegmentByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriNetworkSegments, name)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "network segment", name, err)
 	}
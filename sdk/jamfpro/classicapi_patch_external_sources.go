@@ -44,10 +44,13 @@ type ResourcePatchExternalSource struct {
 
 // GetPatchExternalSources retrieves all patch external sources.
 func (c *Client) GetPatchExternalSources() (*ResponsePatchExternalSourcesList, error) {
+	c, done := c.startOperation("GetPatchExternalSources")
+	defer done()
+
 	endpoint := uriPatchExternalSources
 
 	var externalSources ResponsePatchExternalSourcesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &externalSources)
+	resp, err := c.doRequest("GET", endpoint, nil, &externalSources)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "patch external sources", err)
 	}
@@ -61,10 +64,13 @@ func (c *Client) GetPatchExternalSources() (*ResponsePatchExternalSourcesList, e
 
 // GetPatchExternalSourceByID retrieves a specific patch external source by its ID.
 func (c *Client) GetPatchExternalSourceByID(id string) (*ResourcePatchExternalSource, error) {
+	c, done := c.startOperation("GetPatchExternalSourceByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPatchExternalSources, id)
 
 	var externalSource ResourcePatchExternalSource
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &externalSource)
+	resp, err := c.doRequest("GET", endpoint, nil, &externalSource)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "patch external source", id, err)
 	}
@@ -78,10 +84,13 @@ func (c *Client) GetPatchExternalSourceByID(id string) (*ResourcePatchExternalSo
 
 // GetPatchExternalSourceByName retrieves a specific patch external source by its name.
 func (c *Client) GetPatchExternalSourceByName(name string) (*ResourcePatchExternalSource, error) {
+	c, done := c.startOperation("GetPatchExternalSourceByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPatchExternalSources, name)
 
 	var externalSource ResourcePatchExternalSource
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &externalSource)
+	resp, err := c.doRequest("GET", endpoint, nil, &externalSource)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "patch external source", name, err)
 	}
@@ -95,6 +104,9 @@ func (c *Client) GetPatchExternalSourceByName(name string) (*ResourcePatchExtern
 
 // CreateExternalPatchSource creates a new external patch source on the Jamf Pro server.
 func (c *Client) CreateExternalPatchSource(patchSource *ResourcePatchExternalSource) (*ResourcePatchExternalSource, error) {
+	c, done := c.startOperation("CreateExternalPatchSource")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriPatchExternalSources)
 
 	requestBody := struct {
@@ -105,7 +117,7 @@ func (c *Client) CreateExternalPatchSource(patchSource *ResourcePatchExternalSou
 	}
 
 	var responseSource ResourcePatchExternalSource
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseSource)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseSource)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "patch external source", err)
 	}
@@ -119,6 +131,9 @@ func (c *Client) CreateExternalPatchSource(patchSource *ResourcePatchExternalSou
 
 // UpdateExternalPatchSourceByID updates an existing external patch source by its ID on the Jamf Pro server.
 func (c *Client) UpdateExternalPatchSourceByID(id string, patchSource *ResourcePatchExternalSource) (*ResourcePatchExternalSource, error) {
+	c, done := c.startOperation("UpdateExternalPatchSourceByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriPatchExternalSources)
 
 	requestBody := struct {
@@ -129,7 +144,7 @@ func (c *Client) UpdateExternalPatchSourceByID(id string, patchSource *ResourceP
 	}
 
 	var responseSource ResourcePatchExternalSource
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseSource)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseSource)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "patch external source", id, err)
 	}
@@ -143,6 +158,9 @@ func (c *Client) UpdateExternalPatchSourceByID(id string, patchSource *ResourceP
 
 // UpdateExternalPatchSourceByName updates an existing external patch source by its name on the Jamf Pro server.
 func (c *Client) UpdateExternalPatchSourceByName(name string, patchSource *ResourcePatchExternalSource) (*ResourcePatchExternalSource, error) {
+	c, done := c.startOperation("UpdateExternalPatchSourceByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPatchExternalSources, name)
 
 	requestBody := struct {
@@ -153,7 +171,7 @@ func (c *Client) UpdateExternalPatchSourceByName(name string, patchSource *Resou
 	}
 
 	var responseSource ResourcePatchExternalSource
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseSource)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseSource)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "patch external source", name, err)
 	}
@@ -167,9 +185,12 @@ func (c *Client) UpdateExternalPatchSourceByName(name string, patchSource *Resou
 
 // DeleteExternalPatchSourceByID deletes an external patch source by its ID from the Jamf Pro server.
 func (c *Client) DeleteExternalPatchSourceByID(id string) error {
+	c, done := c.startOperation("DeleteExternalPatchSourceByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPatchExternalSources, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "patch external source", id, err)
 	}
@@ -162,10 +162,13 @@ type PatchPoliciesSubsetIBeaconItem struct {
 
 // GetPatchPoliciesByID retrieves the details of a patch policy by its ID.
 func (c *Client) GetPatchPoliciesByID(id string) (*ResourcePatchPolicies, error) {
+	c, done := c.startOperation("GetPatchPoliciesByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPatchPolicies, id)
 
 	var patchPolicyDetails ResourcePatchPolicies
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &patchPolicyDetails)
+	resp, err := c.doRequest("GET", endpoint, nil, &patchPolicyDetails)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "patch policy", id, err)
 	}
@@ -179,10 +182,13 @@ func (c *Client) GetPatchPoliciesByID(id string) (*ResourcePatchPolicies, error)
 
 // GetPatchPolicyByIDAndDataSubset retrieves a specific subset of data for a patch policy by its ID.
 func (c *Client) GetPatchPolicyByIDAndDataSubset(id string, subset string) (*ResourcePatchPolicies, error) {
+	c, done := c.startOperation("GetPatchPolicyByIDAndDataSubset")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s/subset/%s", uriPatchPolicies, id, subset)
 
 	var patchPolicySubset ResourcePatchPolicies
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &patchPolicySubset)
+	resp, err := c.doRequest("GET", endpoint, nil, &patchPolicySubset)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "patch policy", id, err)
 	}
@@ -196,6 +202,9 @@ func (c *Client) GetPatchPolicyByIDAndDataSubset(id string, subset string) (*Res
 
 // CreatePatchPolicy creates a new patch policy.
 func (c *Client) CreatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleConfigID int) (*ResourcePatchPolicies, error) {
+	c, done := c.startOperation("CreatePatchPolicy")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/softwaretitleconfig/id/%d", uriPatchPolicies, softwareTitleConfigID)
 
 	requestBody := struct {
@@ -206,7 +215,7 @@ func (c *Client) CreatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleC
 	}
 
 	var responsePolicy ResourcePatchPolicies
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responsePolicy)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responsePolicy)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "patch policy", err)
 	}
@@ -220,6 +229,9 @@ func (c *Client) CreatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleC
 
 // UpdatePatchPolicy creates a new patch policy.
 func (c *Client) UpdatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleConfigID int) (*ResourcePatchPolicies, error) {
+	c, done := c.startOperation("UpdatePatchPolicy")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/softwaretitleconfig/id/%d", uriPatchPolicies, softwareTitleConfigID)
 
 	requestBody := struct {
@@ -230,7 +242,7 @@ func (c *Client) UpdatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleC
 	}
 
 	var responsePolicy ResourcePatchPolicies
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responsePolicy)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responsePolicy)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "patch policy", err)
 	}
@@ -244,9 +256,12 @@ func (c *Client) UpdatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleC
 
 // DeletePatchPolicyByID deletes a patch policy by its ID.
 func (c *Client) DeletePatchPolicyByID(id string) error {
+	c, done := c.startOperation("DeletePatchPolicyByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPatchPolicies, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "patch policy", id, err)
 	}
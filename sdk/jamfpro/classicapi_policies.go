@@ -401,12 +401,15 @@ type PolicySubsetIBeacon struct {
 
 // GetPolicies retrieves a list of all policies.
 func (c *Client) GetPolicies() (*ResponsePoliciesList, error) {
+	c, done := c.startOperation("GetPolicies")
+	defer done()
+
 	endpoint := uriPolicies
 
 	var policiesList ResponsePoliciesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &policiesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &policiesList)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch all policies: %v", err)
+		return nil, fmt.Errorf("failed to fetch all policies: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -418,12 +421,15 @@ func (c *Client) GetPolicies() (*ResponsePoliciesList, error) {
 
 // GetPolicyByID retrieves the details of a policy by its ID.
 func (c *Client) GetPolicyByID(id string) (*ResourcePolicy, error) {
+	c, done := c.startOperation("GetPolicyByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPolicies, id)
 
 	var policyDetails ResourcePolicy
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &policyDetails)
+	resp, err := c.doRequest("GET", endpoint, nil, &policyDetails)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch policy by ID: %v", err)
+		return nil, fmt.Errorf("failed to fetch policy by ID: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -435,12 +441,15 @@ func (c *Client) GetPolicyByID(id string) (*ResourcePolicy, error) {
 
 // GetPolicyByName retrieves a policy by its name.
 func (c *Client) GetPolicyByName(name string) (*ResourcePolicy, error) {
+	c, done := c.startOperation("GetPolicyByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPolicies, name)
 
 	var policyDetails ResourcePolicy
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &policyDetails)
+	resp, err := c.doRequest("GET", endpoint, nil, &policyDetails)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch policy by name: %v", err)
+		return nil, fmt.Errorf("failed to fetch policy by name: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -452,12 +461,15 @@ func (c *Client) GetPolicyByName(name string) (*ResourcePolicy, error) {
 
 // GetPolicyByCategory retrieves policies by their category.
 func (c *Client) GetPolicyByCategory(category string) (*ResponsePoliciesList, error) {
+	c, done := c.startOperation("GetPolicyByCategory")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/category/%s", uriPolicies, category)
 
 	var policiesList ResponsePoliciesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &policiesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &policiesList)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch policies by category: %v", err)
+		return nil, fmt.Errorf("failed to fetch policies by category: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -470,12 +482,15 @@ func (c *Client) GetPolicyByCategory(category string) (*ResponsePoliciesList, er
 // GetPoliciesByType retrieves policies by the type of entity that created them.
 // The createdBy param can be either the value 'casper' which refers to Casper Remote. Or the value 'jss', which refers to policies created in the GUI or via the API.
 func (c *Client) GetPoliciesByType(createdBy string) (*ResponsePoliciesList, error) {
+	c, done := c.startOperation("GetPoliciesByType")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/createdBy/%s", uriPolicies, createdBy)
 
 	var policiesList ResponsePoliciesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &policiesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &policiesList)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch policies by type: %v", err)
+		return nil, fmt.Errorf("failed to fetch policies by type: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -487,6 +502,9 @@ func (c *Client) GetPoliciesByType(createdBy string) (*ResponsePoliciesList, err
 
 // CreatePolicy creates a new policy.
 func (c *Client) CreatePolicy(policy *ResourcePolicy) (*ResponsePolicyCreateAndUpdate, error) {
+	c, done := c.startOperation("CreatePolicy")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%d", uriPolicies, policy.General.ID)
 
 	requestBody := struct {
@@ -497,9 +515,9 @@ func (c *Client) CreatePolicy(policy *ResourcePolicy) (*ResponsePolicyCreateAndU
 	}
 
 	var ResourcePolicy ResponsePolicyCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &ResourcePolicy)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &ResourcePolicy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create policy: %v", err)
+		return nil, fmt.Errorf("failed to create policy: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -511,6 +529,9 @@ func (c *Client) CreatePolicy(policy *ResourcePolicy) (*ResponsePolicyCreateAndU
 
 // UpdatePolicyByID updates an existing policy by its ID.
 func (c *Client) UpdatePolicyByID(id string, policy *ResourcePolicy) (*ResponsePolicyCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdatePolicyByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPolicies, id)
 
 	requestBody := struct {
@@ -521,9 +542,9 @@ func (c *Client) UpdatePolicyByID(id string, policy *ResourcePolicy) (*ResponseP
 	}
 
 	var response ResponsePolicyCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update policy: %v", err)
+		return nil, fmt.Errorf("failed to update policy: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -535,6 +556,9 @@ func (c *Client) UpdatePolicyByID(id string, policy *ResourcePolicy) (*ResponseP
 
 // UpdatePolicyByName updates an existing policy by its name.
 func (c *Client) UpdatePolicyByName(name string, policy *ResourcePolicy) (*ResponsePolicyCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdatePolicyByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPolicies, name)
 
 	requestBody := struct {
@@ -545,9 +569,9 @@ func (c *Client) UpdatePolicyByName(name string, policy *ResourcePolicy) (*Respo
 	}
 
 	var response ResponsePolicyCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update policy: %v", err)
+		return nil, fmt.Errorf("failed to update policy: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -559,10 +583,13 @@ func (c *Client) UpdatePolicyByName(name string, policy *ResourcePolicy) (*Respo
 
 // DeletePolicyByID deletes a policy by its ID.
 func (c *Client) DeletePolicyByID(id string) error {
+	c, done := c.startOperation("DeletePolicyByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPolicies, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete policy: %v", err)
+		return fmt.Errorf("failed to delete policy: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -574,10 +601,13 @@ func (c *Client) DeletePolicyByID(id string) error {
 
 // DeletePolicyByName deletes a policy by its name.
 func (c *Client) DeletePolicyByName(name string) error {
+	c, done := c.startOperation("DeletePolicyByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPolicies, name)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete policy: %v", err)
+		return fmt.Errorf("failed to delete policy: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -55,10 +55,13 @@ type ResourcePrinter struct {
 
 // GetPrinters retrieves a serialized list of printers.
 func (c *Client) GetPrinters() (*ResponsePrintersList, error) {
+	c, done := c.startOperation("GetPrinters")
+	defer done()
+
 	endpoint := uriPrinters
 
 	var printers ResponsePrintersList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &printers)
+	resp, err := c.doRequest("GET", endpoint, nil, &printers)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "printers", err)
 	}
@@ -72,10 +75,13 @@ func (c *Client) GetPrinters() (*ResponsePrintersList, error) {
 
 // GetPrinterByID fetches a specific printer by its ID.
 func (c *Client) GetPrinterByID(id string) (*ResourcePrinter, error) {
+	c, done := c.startOperation("GetPrinterByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPrinters, id)
 
 	var printer ResourcePrinter
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &printer)
+	resp, err := c.doRequest("GET", endpoint, nil, &printer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "printer", id, err)
 	}
@@ -89,10 +95,13 @@ func (c *Client) GetPrinterByID(id string) (*ResourcePrinter, error) {
 
 // GetPrinterByName fetches a specific printer by its name.
 func (c *Client) GetPrinterByName(name string) (*ResourcePrinter, error) {
+	c, done := c.startOperation("GetPrinterByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPrinters, name)
 
 	var printer ResourcePrinter
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &printer)
+	resp, err := c.doRequest("GET", endpoint, nil, &printer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "printer", name, err)
 	}
@@ -106,6 +115,9 @@ func (c *Client) GetPrinterByName(name string) (*ResourcePrinter, error) {
 
 // CreatePrinters creates a new printer on the Jamf Pro server.
 func (c *Client) CreatePrinter(printer *ResourcePrinter) (*ResponsePrinterCreateAndUpdate, error) {
+	c, done := c.startOperation("CreatePrinter")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriPrinters)
 
 	requestBody := struct {
@@ -116,7 +128,7 @@ func (c *Client) CreatePrinter(printer *ResourcePrinter) (*ResponsePrinterCreate
 	}
 
 	var responsePrinter ResponsePrinterCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responsePrinter)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responsePrinter)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "printer", err)
 	}
@@ -130,6 +142,9 @@ func (c *Client) CreatePrinter(printer *ResourcePrinter) (*ResponsePrinterCreate
 
 // UpdatePrinterByID updates a printer by its ID.
 func (c *Client) UpdatePrinterByID(id string, printer *ResourcePrinter) (*ResponsePrinterCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdatePrinterByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPrinters, id)
 
 	requestBody := struct {
@@ -140,7 +155,7 @@ func (c *Client) UpdatePrinterByID(id string, printer *ResourcePrinter) (*Respon
 	}
 
 	var responsePrinter ResponsePrinterCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responsePrinter)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responsePrinter)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "printer", id, err)
 	}
@@ -154,6 +169,9 @@ func (c *Client) UpdatePrinterByID(id string, printer *ResourcePrinter) (*Respon
 
 // UpdatePrinterByName updates a printer by its name.
 func (c *Client) UpdatePrinterByName(name string, printer *ResourcePrinter) (*ResponsePrinterCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdatePrinterByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPrinters, name)
 
 	requestBody := struct {
@@ -164,7 +182,7 @@ func (c *Client) UpdatePrinterByName(name string, printer *ResourcePrinter) (*Re
 	}
 
 	var responsePrinter ResponsePrinterCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responsePrinter)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responsePrinter)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "printer", name, err)
 	}
@@ -178,9 +196,12 @@ func (c *Client) UpdatePrinterByName(name string, printer *ResourcePrinter) (*Re
 
 // DeletePrinterByID deletes a printer by its ID.
 func (c *Client) DeletePrinterByID(id string) error {
+	c, done := c.startOperation("DeletePrinterByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriPrinters, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "printer", id, err)
 	}
@@ -194,9 +215,12 @@ func (c *Client) DeletePrinterByID(id string) error {
 
 // DeletePrinterByName deletes a printer by its name.
 func (c *Client) DeletePrinterByName(name string) error {
+	c, done := c.startOperation("DeletePrinterByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriPrinters, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "printer", name, err)
 	}
@@ -27,10 +27,13 @@ type ResourceRemovableMacAddress struct {
 
 // GetRemovableMACAddresses retrieves a list of all removable MAC addresses.
 func (c *Client) GetRemovableMACAddresses() (*ResponseRemovableMacAddressesList, error) {
+	c, done := c.startOperation("GetRemovableMACAddresses")
+	defer done()
+
 	endpoint := uriRemovableMacAddresses
 
 	var macAddressesList ResponseRemovableMacAddressesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macAddressesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &macAddressesList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "removeable macaddresses", err)
 	}
@@ -44,10 +47,13 @@ func (c *Client) GetRemovableMACAddresses() (*ResponseRemovableMacAddressesList,
 
 // GetRemovableMACAddressByID retrieves the details of a removable MAC address by its ID.
 func (c *Client) GetRemovableMACAddressByID(id string) (*ResourceRemovableMacAddress, error) {
+	c, done := c.startOperation("GetRemovableMACAddressByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriRemovableMacAddresses, id)
 
 	var macAddressDetails ResourceRemovableMacAddress
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macAddressDetails)
+	resp, err := c.doRequest("GET", endpoint, nil, &macAddressDetails)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "removeable macaddress", id, err)
 	}
@@ -61,10 +67,13 @@ func (c *Client) GetRemovableMACAddressByID(id string) (*ResourceRemovableMacAdd
 
 // GetRemovableMACAddressByName retrieves the details of a removable MAC address by its name.
 func (c *Client) GetRemovableMACAddressByName(name string) (*ResourceRemovableMacAddress, error) {
+	c, done := c.startOperation("GetRemovableMACAddressByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriRemovableMacAddresses, name)
 
 	var macAddressDetails ResourceRemovableMacAddress
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macAddressDetails)
+	resp, err := c.doRequest("GET", endpoint, nil, &macAddressDetails)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "removeable macaddress", name, err)
 	}
@@ -78,6 +87,9 @@ func (c *Client) GetRemovableMACAddressByName(name string) (*ResourceRemovableMa
 
 // CreateRemovableMACAddress creates a new removable MAC address.
 func (c *Client) CreateRemovableMACAddress(macAddress *ResourceRemovableMacAddress) (*ResourceRemovableMacAddress, error) {
+	c, done := c.startOperation("CreateRemovableMACAddress")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%d", uriRemovableMacAddresses, macAddress.ID)
 
 	requestBody := struct {
@@ -88,7 +100,7 @@ func (c *Client) CreateRemovableMACAddress(macAddress *ResourceRemovableMacAddre
 	}
 
 	var responseMacAddress ResourceRemovableMacAddress
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseMacAddress)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseMacAddress)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "removeable macaddress", err)
 	}
@@ -102,6 +114,9 @@ func (c *Client) CreateRemovableMACAddress(macAddress *ResourceRemovableMacAddre
 
 // UpdateRemovableMACAddressByID updates an existing removable MAC address by its ID.
 func (c *Client) UpdateRemovableMACAddressByID(id string, macAddress *ResourceRemovableMacAddress) (*ResourceRemovableMacAddress, error) {
+	c, done := c.startOperation("UpdateRemovableMACAddressByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriRemovableMacAddresses, id)
 
 	requestBody := struct {
@@ -112,7 +127,7 @@ func (c *Client) UpdateRemovableMACAddressByID(id string, macAddress *ResourceRe
 	}
 
 	var responseMacAddress ResourceRemovableMacAddress
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseMacAddress)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseMacAddress)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "removeable macaddress", id, err)
 	}
@@ -126,6 +141,9 @@ func (c *Client) UpdateRemovableMACAddressByID(id string, macAddress *ResourceRe
 
 // UpdateRemovableMACAddressByName updates an existing removable MAC address by its name.
 func (c *Client) UpdateRemovableMACAddressByName(name string, macAddress *ResourceRemovableMacAddress) (*ResourceRemovableMacAddress, error) {
+	c, done := c.startOperation("UpdateRemovableMACAddressByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriRemovableMacAddresses, name)
 
 	requestBody := struct {
@@ -136,7 +154,7 @@ func (c *Client) UpdateRemovableMACAddressByName(name string, macAddress *Resour
 	}
 
 	var responseMacAddress ResourceRemovableMacAddress
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseMacAddress)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseMacAddress)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "removeable macaddress", name, err)
 	}
@@ -150,9 +168,12 @@ func (c *Client) UpdateRemovableMACAddressByName(name string, macAddress *Resour
 
 // DeleteRemovableMACAddressByID deletes a removable MAC address by its ID.
 func (c *Client) DeleteRemovableMACAddressByID(id string) error {
+	c, done := c.startOperation("DeleteRemovableMACAddressByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriRemovableMacAddresses, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "removeable macaddress", id, err)
 	}
@@ -166,9 +187,12 @@ func (c *Client) DeleteRemovableMACAddressByID(id string) error {
 
 // DeleteRemovableMACAddressByName deletes a removable MAC address by its name.
 func (c *Client) DeleteRemovableMACAddressByName(name string) error {
+	c, done := c.startOperation("DeleteRemovableMACAddressByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriRemovableMacAddresses, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "removeable macaddress", name, err)
 	}
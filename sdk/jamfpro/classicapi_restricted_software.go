@@ -88,10 +88,13 @@ type RestrictedSoftwareSubsetScopeEntity struct {
 
 // GetRestrictedSoftwares retrieves a list of all restricted software.
 func (c *Client) GetRestrictedSoftwares() (*ResponseRestrictedSoftwaresList, error) {
+	c, done := c.startOperation("GetRestrictedSoftwares")
+	defer done()
+
 	endpoint := uriRestrictedSoftware
 
 	var restrictedSoftwaresList ResponseRestrictedSoftwaresList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &restrictedSoftwaresList)
+	resp, err := c.doRequest("GET", endpoint, nil, &restrictedSoftwaresList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "restricted softwares", err)
 	}
@@ -105,10 +108,13 @@ func (c *Client) GetRestrictedSoftwares() (*ResponseRestrictedSoftwaresList, err
 
 // GetRestrictedSoftwareByID fetches the details of a specific restricted software entry by its ID.
 func (c *Client) GetRestrictedSoftwareByID(id string) (*ResourceRestrictedSoftware, error) {
+	c, done := c.startOperation("GetRestrictedSoftwareByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriRestrictedSoftware, id)
 
 	var restrictedSoftware ResourceRestrictedSoftware
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &restrictedSoftware)
+	resp, err := c.doRequest("GET", endpoint, nil, &restrictedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "restricted software", id, err)
 	}
@@ -122,10 +128,13 @@ func (c *Client) GetRestrictedSoftwareByID(id string) (*ResourceRestrictedSoftwa
 
 // GetRestrictedSoftwareByName retrieves the details of a specific restricted software entry by its name.
 func (c *Client) GetRestrictedSoftwareByName(name string) (*ResourceRestrictedSoftware, error) {
+	c, done := c.startOperation("GetRestrictedSoftwareByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriRestrictedSoftware, name)
 
 	var restrictedSoftware ResourceRestrictedSoftware
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &restrictedSoftware)
+	resp, err := c.doRequest("GET", endpoint, nil, &restrictedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "restricted software", name, err)
 	}
@@ -139,6 +148,9 @@ func (c *Client) GetRestrictedSoftwareByName(name string) (*ResourceRestrictedSo
 
 // CreateRestrictedSoftware creates a new restricted software entry in Jamf Pro.
 func (c *Client) CreateRestrictedSoftware(restrictedSoftware *ResourceRestrictedSoftware) (*ResponseRestrictedSoftwareCreateAndUpdate, error) {
+	c, done := c.startOperation("CreateRestrictedSoftware")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%d", uriRestrictedSoftware, restrictedSoftware.General.ID)
 
 	requestBody := struct {
@@ -149,7 +161,7 @@ func (c *Client) CreateRestrictedSoftware(restrictedSoftware *ResourceRestricted
 	}
 
 	var responseRestrictedSoftware ResponseRestrictedSoftwareCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseRestrictedSoftware)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseRestrictedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "restricted software", err)
 	}
@@ -163,6 +175,9 @@ func (c *Client) CreateRestrictedSoftware(restrictedSoftware *ResourceRestricted
 
 // UpdateRestrictedSoftwareByID updates an existing restricted software entry by its ID.
 func (c *Client) UpdateRestrictedSoftwareByID(id string, restrictedSoftware *ResourceRestrictedSoftware) (*ResponseRestrictedSoftwareCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateRestrictedSoftwareByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriRestrictedSoftware, id)
 
 	requestBody := struct {
@@ -173,7 +188,7 @@ func (c *Client) UpdateRestrictedSoftwareByID(id string, restrictedSoftware *Res
 	}
 
 	var responseRestrictedSoftware ResponseRestrictedSoftwareCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseRestrictedSoftware)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &responseRestrictedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "restricted software", id, err)
 	}
@@ -187,6 +202,9 @@ func (c *Client) UpdateRestrictedSoftwareByID(id string, restrictedSoftware *Res
 
 // UpdateRestrictedSoftwareByName updates an existing restricted software entry by its name.
 func (c *Client) UpdateRestrictedSoftwareByName(name string, restrictedSoftware *ResourceRestrictedSoftware) (*ResponseRestrictedSoftwareCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateRestrictedSoftwareByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriRestrictedSoftware, name)
 
 	requestBody := struct {
@@ -197,7 +215,7 @@ func (c *Client) UpdateRestrictedSoftwareByName(name string, restrictedSoftware
 	}
 
 	var responseRestrictedSoftware ResponseRestrictedSoftwareCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseRestrictedSoftware)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &responseRestrictedSoftware)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "restricted software", name, err)
 	}
@@ -211,9 +229,12 @@ func (c *Client) UpdateRestrictedSoftwareByName(name string, restrictedSoftware
 
 // DeleteRestrictedSoftwareByID deletes a restricted software entry by its ID.
 func (c *Client) DeleteRestrictedSoftwareByID(id string) error {
+	c, done := c.startOperation("DeleteRestrictedSoftwareByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriRestrictedSoftware, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "restricted software", id, err)
 	}
@@ -227,9 +248,12 @@ func (c *Client) DeleteRestrictedSoftwareByID(id string) error {
 
 // DeleteRestrictedSoftwareByName deletes a restricted software entry by its name.
 func (c *Client) DeleteRestrictedSoftwareByName(name string) error {
+	c, done := c.startOperation("DeleteRestrictedSoftwareByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriRestrictedSoftware, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "restricted software", name, err)
 	}
@@ -32,10 +32,13 @@ type ResponseSitesList struct {
 
 // GetSites gets a list of all sites
 func (c *Client) GetSites() (*ResponseSitesList, error) {
+	c, done := c.startOperation("GetSites")
+	defer done()
+
 	endpoint := uriSites
 
 	var sites ResponseSitesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &sites)
+	resp, err := c.doRequest("GET", endpoint, nil, &sites)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "sites", err)
 	}
@@ -49,10 +52,13 @@ func (c *Client) GetSites() (*ResponseSitesList, error) {
 
 // GetSiteByID retrieves a site by its ID.
 func (c *Client) GetSiteByID(id string) (*SharedResourceSite, error) {
+	c, done := c.startOperation("GetSiteByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriSites, id)
 
 	var site SharedResourceSite
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &site)
+	resp, err := c.doRequest("GET", endpoint, nil, &site)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "site", id, err)
 	}
@@ -66,10 +72,13 @@ func (c *Client) GetSiteByID(id string) (*SharedResourceSite, error) {
 
 // GetSiteByName retrieves a site by its name.
 func (c *Client) GetSiteByName(name string) (*SharedResourceSite, error) {
+	c, done := c.startOperation("GetSiteByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriSites, name)
 
 	var site SharedResourceSite
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &site)
+	resp, err := c.doRequest("GET", endpoint, nil, &site)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "site", name, err)
 	}
@@ -83,6 +92,9 @@ func (c *Client) GetSiteByName(name string) (*SharedResourceSite, error) {
 
 // CreateSite creates a new site.
 func (c *Client) CreateSite(site *SharedResourceSite) (*SharedResourceSite, error) {
+	c, done := c.startOperation("CreateSite")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriSites)
 
 	requestBody := struct {
@@ -93,7 +105,7 @@ func (c *Client) CreateSite(site *SharedResourceSite) (*SharedResourceSite, erro
 	}
 
 	var createdSite SharedResourceSite
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdSite)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdSite)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "site", err)
 	}
@@ -107,6 +119,9 @@ func (c *Client) CreateSite(site *SharedResourceSite) (*SharedResourceSite, erro
 
 // UpdateSiteByID updates an existing site by its ID.
 func (c *Client) UpdateSiteByID(id string, site *SharedResourceSite) (*SharedResourceSite, error) {
+	c, done := c.startOperation("UpdateSiteByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriSites, id)
 
 	requestBody := struct {
@@ -117,7 +132,7 @@ func (c *Client) UpdateSiteByID(id string, site *SharedResourceSite) (*SharedRes
 	}
 
 	var updatedSite SharedResourceSite
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSite)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSite)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "site", id, err)
 	}
@@ -131,6 +146,9 @@ func (c *Client) UpdateSiteByID(id string, site *SharedResourceSite) (*SharedRes
 
 // UpdateSiteByName updates an existing site by its name.
 func (c *Client) UpdateSiteByName(name string, site *SharedResourceSite) (*SharedResourceSite, error) {
+	c, done := c.startOperation("UpdateSiteByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriSites, name)
 
 	requestBody := struct {
@@ -141,7 +159,7 @@ func (c *Client) UpdateSiteByName(name string, site *SharedResourceSite) (*Share
 	}
 
 	var updatedSite SharedResourceSite
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSite)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedSite)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "site", name, err)
 	}
@@ -155,9 +173,12 @@ func (c *Client) UpdateSiteByName(name string, site *SharedResourceSite) (*Share
 
 // DeleteSiteByID deletes a site by its ID.
 func (c *Client) DeleteSiteByID(id string) error {
+	c, done := c.startOperation("DeleteSiteByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriSites, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "site", id, err)
 	}
@@ -171,9 +192,12 @@ func (c *Client) DeleteSiteByID(id string) error {
 
 // DeleteSiteByName deletes a site by its name.
 func (c *Client) DeleteSiteByName(name string) error {
+	c, done := c.startOperation("DeleteSiteByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriSites, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "site", name, err)
 	}
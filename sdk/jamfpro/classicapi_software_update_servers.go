@@ -41,10 +41,13 @@ type ResourceSoftwareUpdateServer struct {
 
 // GetSoftwareUpdateServers retrieves a list of all software update servers.
 func (c *Client) GetSoftwareUpdateServers() (*ResponseSoftwareUpdateServersList, error) {
+	c, done := c.startOperation("GetSoftwareUpdateServers")
+	defer done()
+
 	endpoint := uriSoftwareUpdateServers
 
 	var response ResponseSoftwareUpdateServersList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "software update servers", err)
 	}
@@ -58,10 +61,13 @@ func (c *Client) GetSoftwareUpdateServers() (*ResponseSoftwareUpdateServersList,
 
 // GetSoftwareUpdateServersByID retrieves a specific software update server by its ID.
 func (c *Client) GetSoftwareUpdateServerByID(id string) (*ResourceSoftwareUpdateServer, error) {
+	c, done := c.startOperation("GetSoftwareUpdateServerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriSoftwareUpdateServers, id)
 
 	var response ResourceSoftwareUpdateServer
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "software update server", id, err)
 	}
@@ -75,10 +81,13 @@ func (c *Client) GetSoftwareUpdateServerByID(id string) (*ResourceSoftwareUpdate
 
 // GetSoftwareUpdateServersByName retrieves a specific software update server by its name.
 func (c *Client) GetSoftwareUpdateServerByName(name string) (*ResourceSoftwareUpdateServer, error) {
+	c, done := c.startOperation("GetSoftwareUpdateServerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriSoftwareUpdateServers, name)
 
 	var response ResourceSoftwareUpdateServer
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "software update server", name, err)
 	}
@@ -92,6 +101,9 @@ func (c *Client) GetSoftwareUpdateServerByName(name string) (*ResourceSoftwareUp
 
 // CreateSoftwareUpdateServer creates a new software update server.
 func (c *Client) CreateSoftwareUpdateServer(server *ResourceSoftwareUpdateServer) (*ResourceSoftwareUpdateServer, error) {
+	c, done := c.startOperation("CreateSoftwareUpdateServer")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriSoftwareUpdateServers)
 
 	requestBody := struct {
@@ -102,7 +114,7 @@ func (c *Client) CreateSoftwareUpdateServer(server *ResourceSoftwareUpdateServer
 	}
 
 	var response ResourceSoftwareUpdateServer
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "software update server", err)
 	}
@@ -116,6 +128,9 @@ func (c *Client) CreateSoftwareUpdateServer(server *ResourceSoftwareUpdateServer
 
 // UpdateSoftwareUpdateServerByID updates a software update server by its ID.
 func (c *Client) UpdateSoftwareUpdateServerByID(id string, server *ResourceSoftwareUpdateServer) (*ResourceSoftwareUpdateServer, error) {
+	c, done := c.startOperation("UpdateSoftwareUpdateServerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriSoftwareUpdateServers, id)
 
 	requestBody := struct {
@@ -126,7 +141,7 @@ func (c *Client) UpdateSoftwareUpdateServerByID(id string, server *ResourceSoftw
 	}
 
 	var response ResourceSoftwareUpdateServer
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "software update server", id, err)
 	}
@@ -140,6 +155,9 @@ func (c *Client) UpdateSoftwareUpdateServerByID(id string, server *ResourceSoftw
 
 // UpdateSoftwareUpdateServerByName updates a software update server by its name.
 func (c *Client) UpdateSoftwareUpdateServerByName(name string, server *ResourceSoftwareUpdateServer) (*ResourceSoftwareUpdateServer, error) {
+	c, done := c.startOperation("UpdateSoftwareUpdateServerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriSoftwareUpdateServers, name)
 
 	requestBody := struct {
@@ -150,7 +168,7 @@ func (c *Client) UpdateSoftwareUpdateServerByName(name string, server *ResourceS
 	}
 
 	var response ResourceSoftwareUpdateServer
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "software update server", name, err)
 	}
@@ -164,9 +182,12 @@ func (c *Client) UpdateSoftwareUpdateServerByName(name string, server *ResourceS
 
 // DeleteSoftwareUpdateServerByID deletes a software update server by its ID.
 func (c *Client) DeleteSoftwareUpdateServerByID(id string) error {
+	c, done := c.startOperation("DeleteSoftwareUpdateServerByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriSoftwareUpdateServers, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "software update server", id, err)
 	}
@@ -180,9 +201,12 @@ func (c *Client) DeleteSoftwareUpdateServerByID(id string) error {
 
 // DeleteSoftwareUpdateServerByName deletes a software update server by its name.
 func (c *Client) DeleteSoftwareUpdateServerByName(name string) error {
+	c, done := c.startOperation("DeleteSoftwareUpdateServerByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriSoftwareUpdateServers, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "software update server", name, err)
 	}
@@ -49,10 +49,13 @@ type ResourceUserExtensionAttributeSubsetInputType struct {
 
 // GetUserExtensionAttributes retrieves a list of all user extension attributes.
 func (c *Client) GetUserExtensionAttributes() (*ResponseUserExtensionAttributesList, error) {
+	c, done := c.startOperation("GetUserExtensionAttributes")
+	defer done()
+
 	endpoint := uriUserExtensionAttributes
 
 	var extAttributes ResponseUserExtensionAttributesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extAttributes)
+	resp, err := c.doRequest("GET", endpoint, nil, &extAttributes)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "user extension attributes", err)
 	}
@@ -66,10 +69,13 @@ func (c *Client) GetUserExtensionAttributes() (*ResponseUserExtensionAttributesL
 
 // GetUserExtensionAttributeByID retrieves a user extension attribute by its ID.
 func (c *Client) GetUserExtensionAttributeByID(id string) (*ResourceUserExtensionAttribute, error) {
+	c, done := c.startOperation("GetUserExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUserExtensionAttributes, id)
 
 	var userExtAttr ResourceUserExtensionAttribute
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userExtAttr)
+	resp, err := c.doRequest("GET", endpoint, nil, &userExtAttr)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "user extension attribute", id, err)
 	}
@@ -83,10 +89,13 @@ func (c *Client) GetUserExtensionAttributeByID(id string) (*ResourceUserExtensio
 
 // GetUserExtensionAttributeByName retrieves a user extension attribute by its name.
 func (c *Client) GetUserExtensionAttributeByName(name string) (*ResourceUserExtensionAttribute, error) {
+	c, done := c.startOperation("GetUserExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUserExtensionAttributes, name)
 
 	var userExtAttr ResourceUserExtensionAttribute
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userExtAttr)
+	resp, err := c.doRequest("GET", endpoint, nil, &userExtAttr)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "user extension attribute", name, err)
 	}
@@ -100,6 +109,9 @@ func (c *Client) GetUserExtensionAttributeByName(name string) (*ResourceUserExte
 
 // CreateUserExtensionAttribute creates a new user extension attribute.
 func (c *Client) CreateUserExtensionAttribute(attribute *ResourceUserExtensionAttribute) (*ResourceUserExtensionAttribute, error) {
+	c, done := c.startOperation("CreateUserExtensionAttribute")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriUserExtensionAttributes)
 
 	requestBody := struct {
@@ -110,7 +122,7 @@ func (c *Client) CreateUserExtensionAttribute(attribute *ResourceUserExtensionAt
 	}
 
 	var createdAttribute ResourceUserExtensionAttribute
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdAttribute)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "user extension attribute", err)
 	}
@@ -124,6 +136,9 @@ func (c *Client) CreateUserExtensionAttribute(attribute *ResourceUserExtensionAt
 
 // UpdateUserExtensionAttributeByID updates a user extension attribute by its ID.
 func (c *Client) UpdateUserExtensionAttributeByID(id string, attribute *ResourceUserExtensionAttribute) (*ResourceUserExtensionAttribute, error) {
+	c, done := c.startOperation("UpdateUserExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUserExtensionAttributes, id)
 
 	requestBody := struct {
@@ -134,7 +149,7 @@ func (c *Client) UpdateUserExtensionAttributeByID(id string, attribute *Resource
 	}
 
 	var updatedAttribute ResourceUserExtensionAttribute
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "user extension attribute", id, err)
 	}
@@ -148,6 +163,9 @@ func (c *Client) UpdateUserExtensionAttributeByID(id string, attribute *Resource
 
 // UpdateUserExtensionAttributeByName updates a user extension attribute by its name.
 func (c *Client) UpdateUserExtensionAttributeByName(name string, attribute *ResourceUserExtensionAttribute) (*ResourceUserExtensionAttribute, error) {
+	c, done := c.startOperation("UpdateUserExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUserExtensionAttributes, name)
 
 	requestBody := struct {
@@ -158,7 +176,7 @@ func (c *Client) UpdateUserExtensionAttributeByName(name string, attribute *Reso
 	}
 
 	var updatedAttribute ResourceUserExtensionAttribute
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedAttribute)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedAttribute)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "user extension attribute", name, err)
 	}
@@ -172,9 +190,12 @@ func (c *Client) UpdateUserExtensionAttributeByName(name string, attribute *Reso
 
 // DeleteUserExtensionAttributeByID deletes a user extension attribute by its ID.
 func (c *Client) DeleteUserExtensionAttributeByID(id string) error {
+	c, done := c.startOperation("DeleteUserExtensionAttributeByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUserExtensionAttributes, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "user extension attribute", id, err)
 	}
@@ -188,9 +209,12 @@ func (c *Client) DeleteUserExtensionAttributeByID(id string) error {
 
 // DeleteUserExtensionAttributeByName deletes a user extension attribute by its name.
 func (c *Client) DeleteUserExtensionAttributeByName(name string) error {
+	c, done := c.startOperation("DeleteUserExtensionAttributeByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUserExtensionAttributes, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "user extension attribute", name, err)
 	}
@@ -67,10 +67,13 @@ type UserGroupSubsetUserItem struct {
 
 // GetUserGroups retrieves a list of all user groups.
 func (c *Client) GetUserGroups() (*ResponseUserGroupsList, error) {
+	c, done := c.startOperation("GetUserGroups")
+	defer done()
+
 	endpoint := uriUserGroups
 
 	var userGroupsList ResponseUserGroupsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userGroupsList)
+	resp, err := c.doRequest("GET", endpoint, nil, &userGroupsList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "user groups", err)
 	}
@@ -84,10 +87,13 @@ func (c *Client) GetUserGroups() (*ResponseUserGroupsList, error) {
 
 // GetUserGroupsByID retrieves the details of a user group by its ID.
 func (c *Client) GetUserGroupByID(id string) (*ResourceUserGroup, error) {
+	c, done := c.startOperation("GetUserGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUserGroups, id)
 
 	var userGroupDetail ResourceUserGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userGroupDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &userGroupDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "user group", id, err)
 	}
@@ -101,10 +107,13 @@ func (c *Client) GetUserGroupByID(id string) (*ResourceUserGroup, error) {
 
 // GetUserGroupsByName retrieves the details of a user group by its name.
 func (c *Client) GetUserGroupByName(name string) (*ResourceUserGroup, error) {
+	c, done := c.startOperation("GetUserGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUserGroups, name)
 
 	var userGroupDetail ResourceUserGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userGroupDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &userGroupDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "user group", name, err)
 	}
@@ -118,6 +127,9 @@ func (c *Client) GetUserGroupByName(name string) (*ResourceUserGroup, error) {
 
 // CreateUserGroup creates a new user group.
 func (c *Client) CreateUserGroup(userGroup *ResourceUserGroup) (*ResponseUserGroupCreateAndUpdate, error) {
+	c, done := c.startOperation("CreateUserGroup")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriUserGroups)
 
 	requestBody := struct {
@@ -128,7 +140,7 @@ func (c *Client) CreateUserGroup(userGroup *ResourceUserGroup) (*ResponseUserGro
 	}
 
 	var createdUserGroup ResponseUserGroupCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdUserGroup)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdUserGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "user group", err)
 	}
@@ -142,6 +154,9 @@ func (c *Client) CreateUserGroup(userGroup *ResourceUserGroup) (*ResponseUserGro
 
 // UpdateUserGroupByID updates an existing user group by its ID.
 func (c *Client) UpdateUserGroupByID(id string, userGroup *ResourceUserGroup) (*ResponseUserGroupCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateUserGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUserGroups, id)
 
 	requestBody := struct {
@@ -152,7 +167,7 @@ func (c *Client) UpdateUserGroupByID(id string, userGroup *ResourceUserGroup) (*
 	}
 
 	var updatedUserGroup ResponseUserGroupCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedUserGroup)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedUserGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "user group", id, err)
 	}
@@ -166,6 +181,9 @@ func (c *Client) UpdateUserGroupByID(id string, userGroup *ResourceUserGroup) (*
 
 // UpdateUserGroupByName updates an existing user group by its name.
 func (c *Client) UpdateUserGroupByName(name string, userGroup *ResourceUserGroup) (*ResponseUserGroupCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateUserGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUserGroups, name)
 
 	requestBody := struct {
@@ -176,7 +194,7 @@ func (c *Client) UpdateUserGroupByName(name string, userGroup *ResourceUserGroup
 	}
 
 	var updatedUserGroup ResponseUserGroupCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedUserGroup)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &updatedUserGroup)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "user group", name, err)
 	}
@@ -190,8 +208,11 @@ func (c *Client) UpdateUserGroupByName(name string, userGroup *ResourceUserGroup
 
 // DeleteUserGroupByID deletes a user group by its ID.
 func (c *Client) DeleteUserGroupByID(id string) error {
+	c, done := c.startOperation("DeleteUserGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUserGroups, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "user group", id, err)
 	}
@@ -205,8 +226,11 @@ func (c *Client) DeleteUserGroupByID(id string) error {
 
 // DeleteUserGroupByName deletes a user group by its name.
 func (c *Client) DeleteUserGroupByName(name string) error {
+	c, done := c.startOperation("DeleteUserGroupByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUserGroups, name)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "user group", name, err)
 	}
@@ -88,10 +88,13 @@ type UserSubsetLinksListItem struct {
 
 // GetUsers retrieves a list of all users.
 func (c *Client) GetUsers() (*ResponseUsersList, error) {
+	c, done := c.startOperation("GetUsers")
+	defer done()
+
 	endpoint := uriUsers
 
 	var usersList ResponseUsersList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &usersList)
+	resp, err := c.doRequest("GET", endpoint, nil, &usersList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "users", err)
 	}
@@ -105,10 +108,13 @@ func (c *Client) GetUsers() (*ResponseUsersList, error) {
 
 // GetUserByID retrieves the details of a user by their ID.
 func (c *Client) GetUserByID(id string) (*ResourceUser, error) {
+	c, done := c.startOperation("GetUserByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUsers, id)
 
 	var userDetail ResourceUser
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &userDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "user", id, err)
 	}
@@ -122,10 +128,13 @@ func (c *Client) GetUserByID(id string) (*ResourceUser, error) {
 
 // GetUserByName retrieves the details of a user by their name.
 func (c *Client) GetUserByName(name string) (*ResourceUser, error) {
+	c, done := c.startOperation("GetUserByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUsers, name)
 
 	var userDetail ResourceUser
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &userDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "user", name, err)
 	}
@@ -139,10 +148,13 @@ func (c *Client) GetUserByName(name string) (*ResourceUser, error) {
 
 // GetUserByEmail retrieves the details of a user by their email.
 func (c *Client) GetUserByEmail(email string) (*ResourceUser, error) {
+	c, done := c.startOperation("GetUserByEmail")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/email/%s", uriUsers, email)
 
 	var userDetail ResourceUser
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &userDetail)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByEmail, "user", email, err)
 	}
@@ -156,6 +168,9 @@ func (c *Client) GetUserByEmail(email string) (*ResourceUser, error) {
 
 // CreateUser creates a new user.
 func (c *Client) CreateUser(newUser *ResourceUser) (*ResourceUser, error) {
+	c, done := c.startOperation("CreateUser")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriUsers)
 
 	requestBody := struct {
@@ -166,7 +181,7 @@ func (c *Client) CreateUser(newUser *ResourceUser) (*ResourceUser, error) {
 	}
 
 	var createdUser ResourceUser
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdUser)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &createdUser)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "user", err)
 	}
@@ -180,6 +195,9 @@ func (c *Client) CreateUser(newUser *ResourceUser) (*ResourceUser, error) {
 
 // UpdateUserByID updates a user's details by their ID.
 func (c *Client) UpdateUserByID(id string, updatedUser *ResourceUser) (*ResourceUser, error) {
+	c, done := c.startOperation("UpdateUserByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUsers, id)
 
 	requestBody := struct {
@@ -190,7 +208,7 @@ func (c *Client) UpdateUserByID(id string, updatedUser *ResourceUser) (*Resource
 	}
 
 	var user ResourceUser
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &user)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &user)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "user", id, err)
 	}
@@ -204,6 +222,9 @@ func (c *Client) UpdateUserByID(id string, updatedUser *ResourceUser) (*Resource
 
 // UpdateUserByName updates a user's details by their name.
 func (c *Client) UpdateUserByName(name string, updatedUser *ResourceUser) (*ResourceUser, error) {
+	c, done := c.startOperation("UpdateUserByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUsers, name)
 
 	requestBody := struct {
@@ -214,7 +235,7 @@ func (c *Client) UpdateUserByName(name string, updatedUser *ResourceUser) (*Reso
 	}
 
 	var user ResourceUser
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &user)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &user)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "user", name, err)
 	}
@@ -228,6 +249,9 @@ func (c *Client) UpdateUserByName(name string, updatedUser *ResourceUser) (*Reso
 
 // UpdateUserByEmail updates a user's details by their email.
 func (c *Client) UpdateUserByEmail(email string, updatedUser *ResourceUser) (*ResourceUser, error) {
+	c, done := c.startOperation("UpdateUserByEmail")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/email/%s", uriUsers, email)
 
 	requestBody := struct {
@@ -238,7 +262,7 @@ func (c *Client) UpdateUserByEmail(email string, updatedUser *ResourceUser) (*Re
 	}
 
 	var user ResourceUser
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &user)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &user)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByEmail, "user", email, err)
 	}
@@ -252,8 +276,11 @@ func (c *Client) UpdateUserByEmail(email string, updatedUser *ResourceUser) (*Re
 
 // DeleteUserByID deletes a user by their ID.
 func (c *Client) DeleteUserByID(id string) error {
+	c, done := c.startOperation("DeleteUserByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriUsers, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "user", id, err)
 	}
@@ -267,8 +294,11 @@ func (c *Client) DeleteUserByID(id string) error {
 
 // DeleteUserByName deletes a user by their name.
 func (c *Client) DeleteUserByName(name string) error {
+	c, done := c.startOperation("DeleteUserByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriUsers, name)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "user", name, err)
 	}
@@ -282,8 +312,11 @@ func (c *Client) DeleteUserByName(name string) error {
 
 // DeleteUserByEmail deletes a user by their email.
 func (c *Client) DeleteUserByEmail(email string) error {
+	c, done := c.startOperation("DeleteUserByEmail")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/email/%s", uriUsers, email)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByEmail, "user", email, err)
 	}
@@ -52,10 +52,13 @@ type ResourceVPPAccount struct {
 
 // GetVPPAccounts retrieves a list of all VPP accounts.
 func (c *Client) GetVPPAccounts() (*ResponseVPPAccountsList, error) {
+	c, done := c.startOperation("GetVPPAccounts")
+	defer done()
+
 	endpoint := uriVPPAccounts
 
 	var response ResponseVPPAccountsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "vpp accounts", err)
 	}
@@ -69,10 +72,13 @@ func (c *Client) GetVPPAccounts() (*ResponseVPPAccountsList, error) {
 
 // GetVPPAccountByID retrieves a specific VPP account by its ID.
 func (c *Client) GetVPPAccountByID(id string) (*ResourceVPPAccount, error) {
+	c, done := c.startOperation("GetVPPAccountByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPAccounts, id)
 
 	var response ResourceVPPAccount
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp account", id, err)
 	}
@@ -86,6 +92,9 @@ func (c *Client) GetVPPAccountByID(id string) (*ResourceVPPAccount, error) {
 
 // CreateVPPAccount creates a new VPP account.
 func (c *Client) CreateVPPAccount(account *ResourceVPPAccount) (*ResourceVPPAccount, error) {
+	c, done := c.startOperation("CreateVPPAccount")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriVPPAccounts)
 
 	requestBody := struct {
@@ -96,7 +105,7 @@ func (c *Client) CreateVPPAccount(account *ResourceVPPAccount) (*ResourceVPPAcco
 	}
 
 	var response ResourceVPPAccount
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "vpp account", err)
 	}
@@ -110,6 +119,9 @@ func (c *Client) CreateVPPAccount(account *ResourceVPPAccount) (*ResourceVPPAcco
 
 // UpdateVPPAccount updates an existing VPP account.
 func (c *Client) UpdateVPPAccountByID(id string, account *ResourceVPPAccount) (*ResourceVPPAccount, error) {
+	c, done := c.startOperation("UpdateVPPAccountByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPAccounts, id)
 
 	requestBody := struct {
@@ -120,7 +132,7 @@ func (c *Client) UpdateVPPAccountByID(id string, account *ResourceVPPAccount) (*
 	}
 
 	var response ResourceVPPAccount
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "vpp account", id, err)
 	}
@@ -134,9 +146,12 @@ func (c *Client) UpdateVPPAccountByID(id string, account *ResourceVPPAccount) (*
 
 // DeleteVPPAccountByID deletes a specific VPP account by its ID.
 func (c *Client) DeleteVPPAccountByID(id string) error {
+	c, done := c.startOperation("DeleteVPPAccountByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPAccounts, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "vpp account", id, err)
 	}
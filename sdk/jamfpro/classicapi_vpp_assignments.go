@@ -91,10 +91,13 @@ type VPPSubsetVPPUserGroup struct {
 
 // GetVPPAssignments fetches a list of VPP assignments
 func (c *Client) GetVPPAssignments() (*ResponseVPPAssignmentsList, error) {
+	c, done := c.startOperation("GetVPPAssignments")
+	defer done()
+
 	endpoint := uriVPPAssignments
 
 	var assignments ResponseVPPAssignmentsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
+	resp, err := c.doRequest("GET", endpoint, nil, &assignments)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "vpp assignments", err)
 	}
@@ -108,10 +111,13 @@ func (c *Client) GetVPPAssignments() (*ResponseVPPAssignmentsList, error) {
 
 // GetVPPAssignmentByID fetches a VPP assignment by its ID
 func (c *Client) GetVPPAssignmentByID(id string) (*ResourceVPPAssignment, error) {
+	c, done := c.startOperation("GetVPPAssignmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPAssignments, id)
 
 	var assignment ResourceVPPAssignment
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignment)
+	resp, err := c.doRequest("GET", endpoint, nil, &assignment)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp assignment", id, err)
 	}
@@ -125,6 +131,9 @@ func (c *Client) GetVPPAssignmentByID(id string) (*ResourceVPPAssignment, error)
 
 // CreateVPPAssignment creates a new VPP assignment
 func (c *Client) CreateVPPAssignment(assignment *ResourceVPPAssignment) error {
+	c, done := c.startOperation("CreateVPPAssignment")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriVPPAssignments)
 
 	requestBody := struct {
@@ -136,7 +145,7 @@ func (c *Client) CreateVPPAssignment(assignment *ResourceVPPAssignment) error {
 
 	var handleResponse struct{}
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &handleResponse)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &handleResponse)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedCreate, "vpp assignment", err)
 	}
@@ -150,6 +159,9 @@ func (c *Client) CreateVPPAssignment(assignment *ResourceVPPAssignment) error {
 
 // UpdateVPPAssignmentByID updates a VPP assignment by its ID
 func (c *Client) UpdateVPPAssignmentByID(id string, assignment *ResourceVPPAssignment) error {
+	c, done := c.startOperation("UpdateVPPAssignmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPAssignments, id)
 
 	requestBody := struct {
@@ -161,7 +173,7 @@ func (c *Client) UpdateVPPAssignmentByID(id string, assignment *ResourceVPPAssig
 
 	var handleResponse struct{}
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdateByID, "vpp assignment", id, err)
 	}
@@ -175,9 +187,12 @@ func (c *Client) UpdateVPPAssignmentByID(id string, assignment *ResourceVPPAssig
 
 // DeleteVPPAssignmentByID deletes a VPP assignment by its ID
 func (c *Client) DeleteVPPAssignmentByID(id string) error {
+	c, done := c.startOperation("DeleteVPPAssignmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriVPPAssignments, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "vpp assignment", id, err)
 	}
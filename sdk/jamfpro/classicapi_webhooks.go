@@ -56,10 +56,13 @@ type ResourceWebhook struct {
 
 // GetWebhooks retrieves a list of all webhooks.
 func (c *Client) GetWebhooks() (*ResponseWebhooksList, error) {
+	c, done := c.startOperation("GetWebhooks")
+	defer done()
+
 	endpoint := uriWebhooks
 
 	var response ResponseWebhooksList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "webhooks", err)
 	}
@@ -73,10 +76,13 @@ func (c *Client) GetWebhooks() (*ResponseWebhooksList, error) {
 
 // GetWebhookByID retrieves a specific webhook by its ID.
 func (c *Client) GetWebhookByID(id string) (*ResourceWebhook, error) {
+	c, done := c.startOperation("GetWebhookByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriWebhooks, id)
 
 	var response ResourceWebhook
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "webhook", id, err)
 	}
@@ -90,10 +96,13 @@ func (c *Client) GetWebhookByID(id string) (*ResourceWebhook, error) {
 
 // GetWebhookByName retrieves a specific webhook by its name.
 func (c *Client) GetWebhookByName(name string) (*ResourceWebhook, error) {
+	c, done := c.startOperation("GetWebhookByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriWebhooks, name)
 
 	var response ResourceWebhook
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "webhook", name, err)
 	}
@@ -107,6 +116,9 @@ func (c *Client) GetWebhookByName(name string) (*ResourceWebhook, error) {
 
 // CreateWebhook creates a new webhook.
 func (c *Client) CreateWebhook(webhook *ResourceWebhook) (*ResourceWebhook, error) {
+	c, done := c.startOperation("CreateWebhook")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/0", uriWebhooks)
 
 	requestBody := struct {
@@ -117,7 +129,7 @@ func (c *Client) CreateWebhook(webhook *ResourceWebhook) (*ResourceWebhook, erro
 	}
 
 	var response ResourceWebhook
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "webhook", err)
 	}
@@ -131,6 +143,9 @@ func (c *Client) CreateWebhook(webhook *ResourceWebhook) (*ResourceWebhook, erro
 
 // UpdateWebhookByID updates a specific webhook by its ID.
 func (c *Client) UpdateWebhookByID(id string, webhook *ResourceWebhook) (*ResourceWebhook, error) {
+	c, done := c.startOperation("UpdateWebhookByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriWebhooks, id)
 
 	requestBody := struct {
@@ -141,7 +156,7 @@ func (c *Client) UpdateWebhookByID(id string, webhook *ResourceWebhook) (*Resour
 	}
 
 	var response ResourceWebhook
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "webhook", id, err)
 	}
@@ -155,6 +170,9 @@ func (c *Client) UpdateWebhookByID(id string, webhook *ResourceWebhook) (*Resour
 
 // UpdateWebhookByName updates a specific webhook by its name.
 func (c *Client) UpdateWebhookByName(name string, webhook *ResourceWebhook) (*ResourceWebhook, error) {
+	c, done := c.startOperation("UpdateWebhookByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriWebhooks, name)
 
 	requestBody := struct {
@@ -165,7 +183,7 @@ func (c *Client) UpdateWebhookByName(name string, webhook *ResourceWebhook) (*Re
 	}
 
 	var response ResourceWebhook
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	resp, err := c.doRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByName, "webhook", name, err)
 	}
@@ -179,9 +197,12 @@ func (c *Client) UpdateWebhookByName(name string, webhook *ResourceWebhook) (*Re
 
 // DeleteWebhookByID deletes a specific webhook by its ID.
 func (c *Client) DeleteWebhookByID(id string) error {
+	c, done := c.startOperation("DeleteWebhookByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/id/%s", uriWebhooks, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "webhook", id, err)
 	}
@@ -195,9 +216,12 @@ func (c *Client) DeleteWebhookByID(id string) error {
 
 // DeleteWebhookByName deletes a specific webhook by its name.
 func (c *Client) DeleteWebhookByName(name string) error {
+	c, done := c.startOperation("DeleteWebhookByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/name/%s", uriWebhooks, name)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByName, "webhook", name, err)
 	}
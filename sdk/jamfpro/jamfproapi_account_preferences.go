@@ -48,10 +48,13 @@ type ResourceAccountPreferences struct {
 
 // GetAccountPreferences retrieves the jamf pro account settings.
 func (c *Client) GetAccountPreferences() (*ResourceAccountPreferences, error) {
+	c, done := c.startOperation("GetAccountPreferences")
+	defer done()
+
 	endpoint := uriAccountPreferences
 
 	var accountPreferences ResourceAccountPreferences
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &accountPreferences)
+	resp, err := c.doRequest("GET", endpoint, nil, &accountPreferences)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "Account Preferences", err)
 	}
@@ -65,10 +68,13 @@ func (c *Client) GetAccountPreferences() (*ResourceAccountPreferences, error) {
 
 // UpdateAccountPreferences updates the jamf pro account settings.
 func (c *Client) UpdateAccountPreferences(updatedSettings ResourceAccountPreferences) (*ResourceAccountPreferences, error) {
+	c, done := c.startOperation("UpdateAccountPreferences")
+	defer done()
+
 	endpoint := uriUserEnrollmentTokenSettings
 	var out ResourceAccountPreferences
 
-	resp, err := c.HTTP.DoRequest("PATCH", endpoint, updatedSettings, &out)
+	resp, err := c.doRequest("PATCH", endpoint, updatedSettings, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "Account Preferences", err)
 	}
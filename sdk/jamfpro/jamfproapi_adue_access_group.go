@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriAccountDrivenUserEnrollment = "/api/v3/enrollment"
@@ -44,33 +42,34 @@ type ResourceAccountDrivenUserEnrollmentAccessGroup struct {
 // CRUD
 
 // GetAccountDrivenUserEnrollmentAccessGroups fetches all ADUE access groups
-func (c *Client) GetAccountDrivenUserEnrollmentAccessGroups(sort_filter string) (*ResponseAccountDrivenUserEnrollmentAccessGroupsList, error) {
-	endpoint := uriAccountDrivenUserEnrollment
-	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, 0, sort_filter)
+//
+// opts filter and sort the access groups by fields such as name or siteId, for example
+// jamfpro.Where(rsql.Eq(rsql.FieldName, "Staff")).
+func (c *Client) GetAccountDrivenUserEnrollmentAccessGroups(sort_filter string, opts ...ListOption) (*ResponseAccountDrivenUserEnrollmentAccessGroupsList, error) {
+	c, done := c.startOperation("GetAccountDrivenUserEnrollmentAccessGroups")
+	defer done()
+
+	resp, err := NewPaginator[ResourceAccountDrivenUserEnrollmentAccessGroup](c, uriAccountDrivenUserEnrollment).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "ADUE Access Group List", err)
 	}
 
 	var OutStruct ResponseAccountDrivenUserEnrollmentAccessGroupsList
-	OutStruct.TotalCount = resp.Size
-	for _, value := range resp.Results {
-		var newObj ResourceAccountDrivenUserEnrollmentAccessGroup
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "ADUE Access Group List", err)
-		}
-		OutStruct.Results = append(OutStruct.Results, newObj)
-	}
+	OutStruct.TotalCount = resp.TotalCount
+	OutStruct.Results = resp.Results
 
 	return &OutStruct, nil
 }
 
 // Retrieves AccountDrivenUserEnrollmentAccessGroup from provided ID & returns ResourceAccountDrivenUserEnrollmentAccessGroup
 func (c *Client) GetAccountDrivenUserEnrollmentAccessGroupByID(id string) (*ResourceAccountDrivenUserEnrollmentAccessGroup, error) {
+	c, done := c.startOperation("GetAccountDrivenUserEnrollmentAccessGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/access-groups/%s", uriAccountDrivenUserEnrollment, id)
 
 	var ADUEGroup ResourceAccountDrivenUserEnrollmentAccessGroup
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ADUEGroup)
+	resp, err := c.doRequest("GET", endpoint, nil, &ADUEGroup)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "ADUE Access Group", id, err)
@@ -83,28 +82,29 @@ func (c *Client) GetAccountDrivenUserEnrollmentAccessGroupByID(id string) (*Reso
 	return &ADUEGroup, nil
 }
 
-// GetAccountDrivenUserEnrollmentAccessGroupByName retrieves an Account Driven User Enrollment Access Group by its name
+// GetAccountDrivenUserEnrollmentAccessGroupByName retrieves an Account Driven User Enrollment Access Group by its name.
+// The endpoint does not support filtering, so the access groups are listed and matched locally.
 func (c *Client) GetAccountDrivenUserEnrollmentAccessGroupByName(name string) (*ResourceAccountDrivenUserEnrollmentAccessGroup, error) {
+	c, done := c.startOperation("GetAccountDrivenUserEnrollmentAccessGroupByName")
+	defer done()
+
 	accessGroupsList, err := c.GetAccountDrivenUserEnrollmentAccessGroups("")
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "ADUE access group", err)
 	}
 
-	for _, group := range accessGroupsList.Results {
-		if group.Name == name {
-			return &group, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "ADUE access group", name, errMsgNoName)
+	return findOneByName(accessGroupsList.Results, "ADUE access group", name, func(value ResourceAccountDrivenUserEnrollmentAccessGroup) string { return value.Name })
 }
 
 // Creates Account Driven User Enrollment Access Group from ResourceScript struct
 func (c *Client) CreateAccountDrivenUserEnrollmentAccessGroup(script *ResourceAccountDrivenUserEnrollmentAccessGroup) (*ResponseAccountDrivenUserEnrollmentAccessGroupCreateAndUpdate, error) {
+	c, done := c.startOperation("CreateAccountDrivenUserEnrollmentAccessGroup")
+	defer done()
+
 	endpoint := uriScripts
 	var out ResponseAccountDrivenUserEnrollmentAccessGroupCreateAndUpdate
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, script, &out)
+	resp, err := c.doRequest("POST", endpoint, script, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "ADUE access group", err)
 	}
@@ -118,10 +118,13 @@ func (c *Client) CreateAccountDrivenUserEnrollmentAccessGroup(script *ResourceAc
 
 // UpdateAccountDrivenUserEnrollmentAccessGroupByID updates an ADUE access group by resource ID
 func (c *Client) UpdateAccountDrivenUserEnrollmentAccessGroupByID(id string, groupUpdate *ResourceAccountDrivenUserEnrollmentAccessGroup) (*ResourceAccountDrivenUserEnrollmentAccessGroup, error) {
+	c, done := c.startOperation("UpdateAccountDrivenUserEnrollmentAccessGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/access-groups/%s", uriAccountDrivenUserEnrollment, id)
 	var out ResourceAccountDrivenUserEnrollmentAccessGroup
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, groupUpdate, &out)
+	resp, err := c.doRequest("PUT", endpoint, groupUpdate, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ADUE Access Group", id, err)
 	}
@@ -135,6 +138,9 @@ func (c *Client) UpdateAccountDrivenUserEnrollmentAccessGroupByID(id string, gro
 
 // UpdateAccountDrivenUserEnrollmentAccessGroupByName updates an ADUE access group by resource name
 func (c *Client) UpdateAccountDrivenUserEnrollmentAccessGroupByName(targetName string, groupUpdate *ResourceAccountDrivenUserEnrollmentAccessGroup) (*ResourceAccountDrivenUserEnrollmentAccessGroup, error) {
+	c, done := c.startOperation("UpdateAccountDrivenUserEnrollmentAccessGroupByName")
+	defer done()
+
 	target, err := c.GetAccountDrivenUserEnrollmentAccessGroupByName(targetName)
 
 	if err != nil {
@@ -151,8 +157,11 @@ func (c *Client) UpdateAccountDrivenUserEnrollmentAccessGroupByName(targetName s
 
 // DeleteAccountDrivenUserEnrollmentAccessGroupByID deletes an ADUE access group with given id
 func (c *Client) DeleteAccountDrivenUserEnrollmentAccessGroupByID(id string) error {
+	c, done := c.startOperation("DeleteAccountDrivenUserEnrollmentAccessGroupByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/access-groups/%s", uriAccountDrivenUserEnrollment, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 
 	if err != nil || resp.StatusCode != 204 {
 		return fmt.Errorf(errMsgFailedDeleteByID, "ADUE access group", id, err)
@@ -167,6 +176,9 @@ func (c *Client) DeleteAccountDrivenUserEnrollmentAccessGroupByID(id string) err
 
 // DeleteAccountDrivenUserEnrollmentAccessGroupByName deletes an ADUE access group with given name, leverages GetAccountDrivenUserEnrollmentAccessGroupByName
 func (c *Client) DeleteAccountDrivenUserEnrollmentAccessGroupByName(targetName string) error {
+	c, done := c.startOperation("DeleteAccountDrivenUserEnrollmentAccessGroupByName")
+	defer done()
+
 	target, err := c.GetAccountDrivenUserEnrollmentAccessGroupByName(targetName)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "ADUE access group", targetName, err)
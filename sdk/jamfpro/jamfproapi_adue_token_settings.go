@@ -20,10 +20,13 @@ type ResourceADUETokenSettings struct {
 // CRUD
 
 func (c *Client) GetADUESessionTokenSettings() (*ResourceADUETokenSettings, error) {
+	c, done := c.startOperation("GetADUESessionTokenSettings")
+	defer done()
+
 	endpoint := uriUserEnrollmentTokenSettings
 	var out ResourceADUETokenSettings
 
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "ADUE token settings", err)
 	}
@@ -37,10 +40,13 @@ func (c *Client) GetADUESessionTokenSettings() (*ResourceADUETokenSettings, erro
 }
 
 func (c *Client) UpdateADUESessionTokenSettings(updatedSettings ResourceADUETokenSettings) (*ResourceADUETokenSettings, error) {
+	c, done := c.startOperation("UpdateADUESessionTokenSettings")
+	defer done()
+
 	endpoint := uriUserEnrollmentTokenSettings
 	var out ResourceADUETokenSettings
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
+	resp, err := c.doRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "ADUE token settings", err)
 	}
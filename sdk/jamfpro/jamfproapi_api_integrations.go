@@ -9,7 +9,7 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 const uriApiIntegrations = "/api/v1/api-integrations"
@@ -44,33 +44,34 @@ type ResourceClientCredentials struct {
 // CRUD
 
 // GetApiIntegrations fetches all API integrations
-func (c *Client) GetApiIntegrations(sort_filter string) (*ResponseApiIntegrationsList, error) {
-	endpoint := uriApiIntegrations
-	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, 0, sort_filter)
+//
+// opts narrow the integrations with typed rsql expressions on fields such as displayName,
+// clientId or enabled, for example jamfpro.Where(rsql.Eq("enabled", true)).
+func (c *Client) GetApiIntegrations(sort_filter string, opts ...ListOption) (*ResponseApiIntegrationsList, error) {
+	c, done := c.startOperation("GetApiIntegrations")
+	defer done()
+
+	resp, err := NewPaginator[ResourceApiIntegration](c, uriApiIntegrations).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api integrations", err)
 	}
 
 	var OutStruct ResponseApiIntegrationsList
-	OutStruct.TotalCount = resp.Size
-	for _, value := range resp.Results {
-		var newObj ResourceApiIntegration
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "api integrations", err)
-		}
-		OutStruct.Results = append(OutStruct.Results, newObj)
-	}
+	OutStruct.TotalCount = resp.TotalCount
+	OutStruct.Results = resp.Results
 
 	return &OutStruct, nil
 }
 
 // GetApiIntegrationByID fetches an API integration by its ID
 func (c *Client) GetApiIntegrationByID(id string) (*ResourceApiIntegration, error) {
+	c, done := c.startOperation("GetApiIntegrationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriApiIntegrations, id)
 
 	var integration ResourceApiIntegration
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &integration)
+	resp, err := c.doRequest("GET", endpoint, nil, &integration)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "api integration", id, err)
 	}
@@ -82,28 +83,22 @@ func (c *Client) GetApiIntegrationByID(id string) (*ResourceApiIntegration, erro
 	return &integration, nil
 }
 
-// GetApiIntegrationNameByID fetches an API integration by its display name and then retrieves its details using its ID
+// GetApiIntegrationByName fetches an API integration by its display name using a server side filter
 func (c *Client) GetApiIntegrationByName(name string) (*ResourceApiIntegration, error) {
-	integrations, err := c.GetApiIntegrations("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api integration", err)
-	}
-
-	for _, integration := range integrations.Results {
-		if integration.DisplayName == name {
-			return &integration, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "api integration", name, errMsgNoName)
+	c, done := c.startOperation("GetApiIntegrationByName")
+	defer done()
+	return getOneByName[ResourceApiIntegration](c, uriApiIntegrations, "api integration", rsql.FieldDisplayName, name, func(value ResourceApiIntegration) string { return value.DisplayName })
 }
 
 // CreateApiIntegration creates a new API integration
 func (c *Client) CreateApiIntegration(integration *ResourceApiIntegration) (*ResourceApiIntegration, error) {
+	c, done := c.startOperation("CreateApiIntegration")
+	defer done()
+
 	endpoint := uriApiIntegrations
 
 	var response ResourceApiIntegration
-	resp, err := c.HTTP.DoRequest("POST", endpoint, integration, &response)
+	resp, err := c.doRequest("POST", endpoint, integration, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "api integration", err)
 	}
@@ -117,10 +112,13 @@ func (c *Client) CreateApiIntegration(integration *ResourceApiIntegration) (*Res
 
 // UpdateApiIntegrationByID updates an API integration by its ID
 func (c *Client) UpdateApiIntegrationByID(id string, integrationUpdate *ResourceApiIntegration) (*ResourceApiIntegration, error) {
+	c, done := c.startOperation("UpdateApiIntegrationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf(uriApiIntegrations+"/%s", id)
 
 	var updatedIntegration ResourceApiIntegration
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, integrationUpdate, &updatedIntegration)
+	resp, err := c.doRequest("PUT", endpoint, integrationUpdate, &updatedIntegration)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "api integration", id, err)
 	}
@@ -134,6 +132,9 @@ func (c *Client) UpdateApiIntegrationByID(id string, integrationUpdate *Resource
 
 // UpdateApiIntegrationByName updates an API integration based on its display name
 func (c *Client) UpdateApiIntegrationByName(name string, integrationUpdate *ResourceApiIntegration) (*ResourceApiIntegration, error) {
+	c, done := c.startOperation("UpdateApiIntegrationByName")
+	defer done()
+
 	target, err := c.GetApiIntegrationByName(name)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "api integration", name, err)
@@ -150,9 +151,12 @@ func (c *Client) UpdateApiIntegrationByName(name string, integrationUpdate *Reso
 
 // DeleteApiIntegrationByID deletes an API integration by its ID
 func (c *Client) DeleteApiIntegrationByID(id string) error {
+	c, done := c.startOperation("DeleteApiIntegrationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf(uriApiIntegrations+"/%s", id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "api integration", id, err)
 	}
@@ -166,6 +170,9 @@ func (c *Client) DeleteApiIntegrationByID(id string) error {
 
 // DeleteApiIntegrationByName deletes an API integration by its display name
 func (c *Client) DeleteApiIntegrationByName(name string) error {
+	c, done := c.startOperation("DeleteApiIntegrationByName")
+	defer done()
+
 	target, err := c.GetApiIntegrationByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "api integration", name, err)
@@ -185,10 +192,13 @@ func (c *Client) DeleteApiIntegrationByName(name string) error {
 
 // RefreshClientCredentialsByApiRoleID creates new client credentials for an API integration by its ID
 func (c *Client) RefreshClientCredentialsByApiRoleID(id string) (*ResourceClientCredentials, error) {
+	c, done := c.startOperation("RefreshClientCredentialsByApiRoleID")
+	defer done()
+
 	endpoint := fmt.Sprintf(uriApiIntegrations+"/%s/client-credentials", id)
 
 	var response ResourceClientCredentials
-	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &response)
+	resp, err := c.doRequest("POST", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedRefreshClientCreds, id, err)
 	}
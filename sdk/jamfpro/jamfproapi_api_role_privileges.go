@@ -23,8 +23,11 @@ type ResourceApiRolePrivilegesList struct {
 
 // GetJamfAPIPrivileges fetches a list of Jamf API role privileges
 func (c *Client) GetJamfAPIPrivileges() (*ResourceApiRolePrivilegesList, error) {
+	c, done := c.startOperation("GetJamfAPIPrivileges")
+	defer done()
+
 	var privilegesList ResourceApiRolePrivilegesList
-	resp, err := c.HTTP.DoRequest("GET", uriApiRolePrivileges, nil, &privilegesList)
+	resp, err := c.doRequest("GET", uriApiRolePrivileges, nil, &privilegesList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "API Privileges", err)
 	}
@@ -38,11 +41,14 @@ func (c *Client) GetJamfAPIPrivileges() (*ResourceApiRolePrivilegesList, error)
 
 // GetJamfAPIPrivilegesByName fetches a list of Jamf API role privileges by name
 func (c *Client) GetJamfAPIPrivilegesByName(name string, limit int) (*ResourceApiRolePrivilegesList, error) {
+	c, done := c.startOperation("GetJamfAPIPrivilegesByName")
+	defer done()
+
 	encodedName := url.QueryEscape(name)
 	endpoint := fmt.Sprintf(uriApiRolePrivileges+"/search?name=%s&limit=%d", encodedName, limit)
 
 	var privilegesList ResourceApiRolePrivilegesList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &privilegesList)
+	resp, err := c.doRequest("GET", endpoint, nil, &privilegesList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "API Privilege", name, err)
 	}
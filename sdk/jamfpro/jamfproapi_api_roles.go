@@ -8,7 +8,7 @@ package jamfpro
 import (
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 const uriApiRoles = "/api/v1/api-roles"
@@ -33,34 +33,34 @@ type ResourceAPIRole struct {
 // CRUD
 
 // GetJamfAPIRoles fetches a list of Jamf API roles
-func (c *Client) GetJamfAPIRoles(sort_filter string) (*ResponseApiRolesList, error) {
-	endpoint := uriApiRoles
-
-	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, 0, sort_filter)
+//
+// opts filter and sort the roles by displayName or id, for example
+// jamfpro.OrderBy(rsql.Asc(rsql.FieldDisplayName)).
+func (c *Client) GetJamfAPIRoles(sort_filter string, opts ...ListOption) (*ResponseApiRolesList, error) {
+	c, done := c.startOperation("GetJamfAPIRoles")
+	defer done()
+
+	resp, err := NewPaginator[ResourceAPIRole](c, uriApiRoles).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api roles", err)
 	}
 
 	var outStruct ResponseApiRolesList
-	outStruct.TotalCount = resp.Size
-	for _, value := range resp.Results {
-		var newObj ResourceAPIRole
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "api role", err)
-		}
-		outStruct.Results = append(outStruct.Results, newObj)
-	}
+	outStruct.TotalCount = resp.TotalCount
+	outStruct.Results = resp.Results
 
 	return &outStruct, nil
 }
 
 // GetJamfApiRolesByID fetches a Jamf API role by its ID.
 func (c *Client) GetJamfApiRoleByID(id string) (*ResourceAPIRole, error) {
+	c, done := c.startOperation("GetJamfApiRoleByID")
+	defer done()
+
 	endpoint := fmt.Sprintf(uriApiRoles+"/%s", id)
 
 	var ApiRole ResourceAPIRole
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ApiRole)
+	resp, err := c.doRequest("GET", endpoint, nil, &ApiRole)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "api role", id, err)
 	}
@@ -72,28 +72,22 @@ func (c *Client) GetJamfApiRoleByID(id string) (*ResourceAPIRole, error) {
 	return &ApiRole, nil
 }
 
-// GetJamfApiRolesNameById fetches a Jamf API role by its display name and then retrieves its details using its ID.
+// GetJamfApiRoleByName fetches a Jamf API role by its display name using a server side filter.
 func (c *Client) GetJamfApiRoleByName(name string) (*ResourceAPIRole, error) {
-	roles, err := c.GetJamfAPIRoles("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api role", err)
-	}
-
-	for _, value := range roles.Results {
-		if value.DisplayName == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "api role", name, errMsgNoName)
+	c, done := c.startOperation("GetJamfApiRoleByName")
+	defer done()
+	return getOneByName[ResourceAPIRole](c, uriApiRoles, "api role", rsql.FieldDisplayName, name, func(value ResourceAPIRole) string { return value.DisplayName })
 }
 
 // CreateJamfApiRole creates a new Jamf API role
 func (c *Client) CreateJamfApiRole(role *ResourceAPIRole) (*ResourceAPIRole, error) {
+	c, done := c.startOperation("CreateJamfApiRole")
+	defer done()
+
 	endpoint := uriApiRoles
 	var response ResourceAPIRole
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, role, &response)
+	resp, err := c.doRequest("POST", endpoint, role, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "api role", err)
 	}
@@ -107,10 +101,13 @@ func (c *Client) CreateJamfApiRole(role *ResourceAPIRole) (*ResourceAPIRole, err
 
 // UpdateJamfApiRoleByID updates a Jamf API role by its ID
 func (c *Client) UpdateJamfApiRoleByID(id string, roleUpdate *ResourceAPIRole) (*ResourceAPIRole, error) {
+	c, done := c.startOperation("UpdateJamfApiRoleByID")
+	defer done()
+
 	endpoint := fmt.Sprintf(uriApiRoles+"/%s", id)
 
 	var updatedRole ResourceAPIRole
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, roleUpdate, &updatedRole)
+	resp, err := c.doRequest("PUT", endpoint, roleUpdate, &updatedRole)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "api role", id, err)
 	}
@@ -124,6 +121,9 @@ func (c *Client) UpdateJamfApiRoleByID(id string, roleUpdate *ResourceAPIRole) (
 
 // UpdateJamfApiRoleByName updates a Jamf API role based on its display name
 func (c *Client) UpdateJamfApiRoleByName(name string, roleUpdate *ResourceAPIRole) (*ResourceAPIRole, error) {
+	c, done := c.startOperation("UpdateJamfApiRoleByName")
+	defer done()
+
 	target, err := c.GetJamfApiRoleByName(name)
 
 	if err != nil {
@@ -142,9 +142,12 @@ func (c *Client) UpdateJamfApiRoleByName(name string, roleUpdate *ResourceAPIRol
 
 // DeleteJamfApiRoleByID deletes a Jamf API role by its ID
 func (c *Client) DeleteJamfApiRoleByID(id string) error {
+	c, done := c.startOperation("DeleteJamfApiRoleByID")
+	defer done()
+
 	endpoint := fmt.Sprintf(uriApiRoles+"/%s", id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "api role", id, err)
 	}
@@ -158,6 +161,9 @@ func (c *Client) DeleteJamfApiRoleByID(id string) error {
 
 // DeleteJamfApiRoleByName deletes a Jamf API role by its display name
 func (c *Client) DeleteJamfApiRoleByName(name string) error {
+	c, done := c.startOperation("DeleteJamfApiRoleByName")
+	defer done()
+
 	target, err := c.GetJamfApiRoleByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "api role", name, err)
@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriJamfAppCatalogAppInstaller = "/api/v1/app-installers"
@@ -116,10 +114,13 @@ type JamfAppCatalogDeploymentSubsetCategory struct {
 
 // GetJamfAppCatalogAppInstallerTermsAndConditionsStatus returns the terms and conditions status for the Jamf App Catalog
 func (c *Client) GetJamfAppCatalogAppInstallerTermsAndConditionsStatus() (*ResponseJamfAppCatalogDeploymentTermsAndConditionsStatus, error) {
+	c, done := c.startOperation("GetJamfAppCatalogAppInstallerTermsAndConditionsStatus")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/terms-and-conditions", uriJamfAppCatalogAppInstaller)
 
 	var globalSettings ResponseJamfAppCatalogDeploymentTermsAndConditionsStatus
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &globalSettings)
+	resp, err := c.doRequest("GET", endpoint, nil, &globalSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "printers", err)
 	}
@@ -134,10 +135,13 @@ func (c *Client) GetJamfAppCatalogAppInstallerTermsAndConditionsStatus() (*Respo
 // AcceptJamfAppCatalogAppInstallerTermsAndConditions accepts the terms and conditions for the Jamf App Catalog
 // This is required on an account by account basis.
 func (c *Client) AcceptJamfAppCatalogAppInstallerTermsAndConditions() (*ResponseJamfAppCatalogDeploymentTermsAndConditionsStatus, error) {
+	c, done := c.startOperation("AcceptJamfAppCatalogAppInstallerTermsAndConditions")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/terms-and-conditions/accept", uriJamfAppCatalogAppInstaller)
 
 	var globalSettings ResponseJamfAppCatalogDeploymentTermsAndConditionsStatus
-	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &globalSettings)
+	resp, err := c.doRequest("POST", endpoint, nil, &globalSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "printers", err)
 	}
@@ -150,29 +154,21 @@ func (c *Client) AcceptJamfAppCatalogAppInstallerTermsAndConditions() (*Response
 }
 
 // Gets full list of Get Jamf App Catalog App Installer Titles & handles pagination
-func (c *Client) GetJamfAppCatalogAppInstallerTitles(sort_filter string) (*ResponseJamfAppCatalogTitleList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriJamfAppCatalogAppInstaller+"/titles",
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
-
+//
+// opts filter and sort the titles by fields such as titleName or publisher, for example
+// jamfpro.Where(rsql.Like("titleName", "Google*")).
+func (c *Client) GetJamfAppCatalogAppInstallerTitles(sort_filter string, opts ...ListOption) (*ResponseJamfAppCatalogTitleList, error) {
+	c, done := c.startOperation("GetJamfAppCatalogAppInstallerTitles")
+	defer done()
+
+	resp, err := NewPaginator[ResourceJamfAppCatalogAppInstaller](c, uriJamfAppCatalogAppInstaller+"/titles").RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "Jamf App Catalog Titles", err)
 	}
 
 	var out ResponseJamfAppCatalogTitleList
-	out.Size = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceJamfAppCatalogAppInstaller
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "Jamf App Catalog Titles", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.Size = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 
@@ -180,9 +176,12 @@ func (c *Client) GetJamfAppCatalogAppInstallerTitles(sort_filter string) (*Respo
 
 // GetJamfAppCatalogAppInstallerTitleByID retrieves by title ID & returns ResourceJamfAppCatalogAppInstaller
 func (c *Client) GetJamfAppCatalogAppInstallerTitleByID(id string) (*ResourceJamfAppCatalogAppInstaller, error) {
+	c, done := c.startOperation("GetJamfAppCatalogAppInstallerTitleByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/titles/%s", uriJamfAppCatalogAppInstaller, id)
 	var appInstaller ResourceJamfAppCatalogAppInstaller
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &appInstaller)
+	resp, err := c.doRequest("GET", endpoint, nil, &appInstaller)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "Jamf App Catalog Title", id, err)
@@ -197,9 +196,12 @@ func (c *Client) GetJamfAppCatalogAppInstallerTitleByID(id string) (*ResourceJam
 
 // GetJamfAppCatalogAppInstallerGlobalSettings retrieves the global settings for the app catalog & returns ResourceJamfAppCatalogAppInstaller
 func (c *Client) GetJamfAppCatalogAppInstallerGlobalSettings(id string) (*JamfAppCatalogDeploymentSubsetNotificationSettings, error) {
+	c, done := c.startOperation("GetJamfAppCatalogAppInstallerGlobalSettings")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/global-settings", uriJamfAppCatalogAppInstaller)
 	var globalSettings JamfAppCatalogDeploymentSubsetNotificationSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &globalSettings)
+	resp, err := c.doRequest("GET", endpoint, nil, &globalSettings)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "Jamf App Catalog Title", id, err)
@@ -214,9 +216,12 @@ func (c *Client) GetJamfAppCatalogAppInstallerGlobalSettings(id string) (*JamfAp
 
 // Retrieves Jamf App Catalog installer deployment by provided ID & returns ResourceJamfAppCatalogDeployment
 func (c *Client) GetJamfAppCatalogAppInstallerDeploymentByID(id string) (*ResourceJamfAppCatalogDeployment, error) {
+	c, done := c.startOperation("GetJamfAppCatalogAppInstallerDeploymentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/deployments/%s", uriJamfAppCatalogAppInstaller, id)
 	var appInstaller ResourceJamfAppCatalogDeployment
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &appInstaller)
+	resp, err := c.doRequest("GET", endpoint, nil, &appInstaller)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "jamf app catalog deployments", id, err)
@@ -231,10 +236,13 @@ func (c *Client) GetJamfAppCatalogAppInstallerDeploymentByID(id string) (*Resour
 
 // Creates Jamf App Catalog Deployment from ResponseJamfAppCatalogDeploymentCreateAndUpdate struct
 func (c *Client) CreateJamfAppCatalogAppInstallerDeployment(payload *ResourceJamfAppCatalogDeployment) (*ResponseJamfAppCatalogDeploymentCreateAndUpdate, error) {
+	c, done := c.startOperation("CreateJamfAppCatalogAppInstallerDeployment")
+	defer done()
+
 	endpoint := uriJamfAppCatalogAppInstaller + "/deployments"
 	var response ResponseJamfAppCatalogDeploymentCreateAndUpdate
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &response)
+	resp, err := c.doRequest("POST", endpoint, payload, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "jamf app catalog deployment", err)
 	}
@@ -248,9 +256,12 @@ func (c *Client) CreateJamfAppCatalogAppInstallerDeployment(payload *ResourceJam
 
 // UpdateJamfAppCatalogDeploymentByID updates Jamf App Catalog Deployment by provided ID & returns ResourceJamfAppCatalogDeployment
 func (c *Client) UpdateJamfAppCatalogAppInstallerDeploymentByID(id string, payload *ResourceJamfAppCatalogDeployment) (*ResourceJamfAppCatalogDeployment, error) {
+	c, done := c.startOperation("UpdateJamfAppCatalogAppInstallerDeploymentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/deployments/%s", uriJamfAppCatalogAppInstaller, id)
 	var response ResourceJamfAppCatalogDeployment
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, payload, &response)
+	resp, err := c.doRequest("PUT", endpoint, payload, &response)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "script", id, err)
@@ -266,9 +277,12 @@ func (c *Client) UpdateJamfAppCatalogAppInstallerDeploymentByID(id string, paylo
 
 // DeleteJamfAppCatalogDeploymentByID deletes deployment by provided ID & returns ResponseJamfAppCatalogDeploymentCreateAndUpdate
 func (c *Client) DeleteJamfAppCatalogAppInstallerDeploymentByID(id string) error {
+	c, done := c.startOperation("DeleteJamfAppCatalogAppInstallerDeploymentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/deployments/%s", uriJamfAppCatalogAppInstaller, id)
 	var response interface{}
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, &response)
+	resp, err := c.doRequest("DELETE", endpoint, nil, &response)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "script", id, err)
 	}
@@ -8,7 +8,7 @@ package jamfpro
 import (
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 const uriBuildings = "/api/v1/buildings"
@@ -61,39 +61,34 @@ type ResourceBuildingResourceHistory struct {
 // CRUD
 
 // GetBuildings retrieves all building information with optional sorting.
-func (c *Client) GetBuildings(sort_filter string) (*ResponseBuildingsList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriBuildings,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
-
+//
+// opts filter and sort the buildings by fields such as name, city or country, for example
+// jamfpro.Where(rsql.Eq("country", "Australia")).
+func (c *Client) GetBuildings(sort_filter string, opts ...ListOption) (*ResponseBuildingsList, error) {
+	c, done := c.startOperation("GetBuildings")
+	defer done()
+
+	resp, err := NewPaginator[ResourceBuilding](c, uriBuildings).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "buildings", err)
 	}
 
 	var out ResponseBuildingsList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceBuilding
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "building", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetBuildingByID retrieves a single building information by its ID.
 func (c *Client) GetBuildingByID(id string) (*ResourceBuilding, error) {
+	c, done := c.startOperation("GetBuildingByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriBuildings, id)
 
 	var building ResourceBuilding
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &building)
+	resp, err := c.doRequest("GET", endpoint, nil, &building)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "building", id, err)
 	}
@@ -105,28 +100,22 @@ func (c *Client) GetBuildingByID(id string) (*ResourceBuilding, error) {
 	return &building, nil
 }
 
-// GetBuildingByNameByID retrieves a single building information by its name using GetBuildingByID.
+// GetBuildingByName retrieves a single building by its name using a server side filter.
 func (c *Client) GetBuildingByName(name string) (*ResourceBuilding, error) {
-	buildings, err := c.GetBuildings("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "building", err)
-	}
-
-	for _, value := range buildings.Results {
-		if value.Name == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "building", name, errMsgNoName)
+	c, done := c.startOperation("GetBuildingByName")
+	defer done()
+	return getOneByName[ResourceBuilding](c, uriBuildings, "building", rsql.FieldName, name, func(value ResourceBuilding) string { return value.Name })
 }
 
 // CreateBuilding creates a new building in Jamf Pro
 func (c *Client) CreateBuilding(building *ResourceBuilding) (*ResponseBuildingCreate, error) {
+	c, done := c.startOperation("CreateBuilding")
+	defer done()
+
 	endpoint := uriBuildings
 
 	var responseBuildingCreate ResponseBuildingCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, building, &responseBuildingCreate)
+	resp, err := c.doRequest("POST", endpoint, building, &responseBuildingCreate)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "building", err)
 	}
@@ -140,10 +129,13 @@ func (c *Client) CreateBuilding(building *ResourceBuilding) (*ResponseBuildingCr
 
 // UpdateBuildingByID updates a building's information in Jamf Pro by its ID.
 func (c *Client) UpdateBuildingByID(id string, buildingUpdate *ResourceBuilding) (*ResourceBuilding, error) {
+	c, done := c.startOperation("UpdateBuildingByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriBuildings, id)
 
 	var updatedBuilding ResourceBuilding
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, buildingUpdate, &updatedBuilding)
+	resp, err := c.doRequest("PUT", endpoint, buildingUpdate, &updatedBuilding)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "building", id, err)
 	}
@@ -157,6 +149,9 @@ func (c *Client) UpdateBuildingByID(id string, buildingUpdate *ResourceBuilding)
 
 // UpdateBuildingByNameByID updates a building's information in Jamf Pro by its name.
 func (c *Client) UpdateBuildingByName(name string, buildingUpdate *ResourceBuilding) (*ResourceBuilding, error) {
+	c, done := c.startOperation("UpdateBuildingByName")
+	defer done()
+
 	target, err := c.GetBuildingByName(name)
 
 	if err != nil {
@@ -176,9 +171,12 @@ func (c *Client) UpdateBuildingByName(name string, buildingUpdate *ResourceBuild
 
 // DeleteBuildingByID deletes a building in Jamf Pro by its ID.
 func (c *Client) DeleteBuildingByID(id string) error {
+	c, done := c.startOperation("DeleteBuildingByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriBuildings, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "buidling", id, err)
 	}
@@ -192,6 +190,9 @@ func (c *Client) DeleteBuildingByID(id string) error {
 
 // DeleteBuildingByNameByID deletes a building in Jamf Pro by its name.
 func (c *Client) DeleteBuildingByName(name string) error {
+	c, done := c.startOperation("DeleteBuildingByName")
+	defer done()
+
 	target, err := c.GetBuildingByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "building", name, err)
@@ -208,6 +209,9 @@ func (c *Client) DeleteBuildingByName(name string) error {
 
 // DeleteMultipleBuildingsByID deletes multiple buildings in Jamf Pro by their IDs.
 func (c *Client) DeleteMultipleBuildingsByID(ids []string) error {
+	c, done := c.startOperation("DeleteMultipleBuildingsByID")
+	defer done()
+
 	endpoint := "/api/v1/buildings/delete-multiple"
 
 	payload := struct {
@@ -216,7 +220,7 @@ func (c *Client) DeleteMultipleBuildingsByID(ids []string) error {
 		IDs: ids,
 	}
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, nil)
+	resp, err := c.doRequest("POST", endpoint, payload, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteMultiple, "buildings", ids, err)
 	}
@@ -229,36 +233,35 @@ func (c *Client) DeleteMultipleBuildingsByID(ids []string) error {
 }
 
 // GetBuildingResourceHistoryByID retrieves the resource history of a specific building by its ID.
-func (c *Client) GetBuildingResourceHistoryByID(id, sort_filter string) (*ResponseBuildingResourceHistoryList, error) {
-	endpoint := fmt.Sprintf("%s/%s/history", uriBuildings, id)
+//
+// opts filter and sort the history entries by date, username or note, for example
+// jamfpro.OrderBy(rsql.Desc("date")) for the latest change first.
+func (c *Client) GetBuildingResourceHistoryByID(id, sort_filter string, opts ...ListOption) (*ResponseBuildingResourceHistoryList, error) {
+	c, done := c.startOperation("GetBuildingResourceHistoryByID")
+	defer done()
 
-	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sort_filter)
+	endpoint := fmt.Sprintf("%s/%s/history", uriBuildings, id)
+	resp, err := NewPaginator[ResourceBuildingResourceHistory](c, endpoint).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "building histories", err)
 	}
 
 	var out ResponseBuildingResourceHistoryList
-	out.Size = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceBuildingResourceHistory
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "buidling histories", err)
-		}
-		out.Results = append(out.Results, newObj)
-
-	}
+	out.Size = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // CreateBuildingResourceHistoryByID updates the resource history of a building in Jamf Pro by its ID.
 func (c *Client) CreateBuildingResourceHistoryByID(id string, historyUpdate *ResourceBuildingResourceHistory) (*ResourceBuildingResourceHistory, error) {
+	c, done := c.startOperation("CreateBuildingResourceHistoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/history", uriBuildings, id)
 
 	var updatedHistory ResourceBuildingResourceHistory
-	resp, err := c.HTTP.DoRequest("POST", endpoint, historyUpdate, &updatedHistory)
+	resp, err := c.doRequest("POST", endpoint, historyUpdate, &updatedHistory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "building histories", id, err)
 	}
@@ -43,10 +43,13 @@ type CacheSettingsSubsetMemcachedEndpoints struct {
 
 // GetCacheSettings gets the current cache settings.
 func (c *Client) GetCacheSettings() (*ResourceCacheSettings, error) {
+	c, done := c.startOperation("GetCacheSettings")
+	defer done()
+
 	endpoint := uriCacheSettings
 
 	var cacheSettings ResourceCacheSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &cacheSettings)
+	resp, err := c.doRequest("GET", endpoint, nil, &cacheSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "cache settings", err)
 	}
@@ -60,6 +63,9 @@ func (c *Client) GetCacheSettings() (*ResourceCacheSettings, error) {
 
 // UpdateCacheSettings updates the cache settings.
 func (c *Client) UpdateCacheSettings(cacheSettingsUpdate *ResourceCacheSettings) (*ResourceCacheSettings, error) {
+	c, done := c.startOperation("UpdateCacheSettings")
+	defer done()
+
 	endpoint := uriCacheSettings
 
 	requestBody, err := json.Marshal(cacheSettingsUpdate)
@@ -68,7 +74,7 @@ func (c *Client) UpdateCacheSettings(cacheSettingsUpdate *ResourceCacheSettings)
 	}
 
 	var updatedSettings ResourceCacheSettings
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedSettings)
+	resp, err := c.doRequest("PUT", endpoint, requestBody, &updatedSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "cache settings", err)
 	}
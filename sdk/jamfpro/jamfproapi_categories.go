@@ -8,7 +8,7 @@ package jamfpro
 import (
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 const uriCategories = "/api/v1/categories"
@@ -43,33 +43,34 @@ type ResponseCategoryCreateAndUpdate struct {
 // Parameters:
 // - sort: A string specifying the sorting order of the returned categories.
 // - filter: A string to filter the categories based on certain criteria.
-func (c *Client) GetCategories(sort_filter string) (*ResponseCategoriesList, error) {
-	resp, err := c.DoPaginatedGet(uriCategories, standardPageSize, startingPageNumber, sort_filter)
+//
+// opts filter and sort the categories by name or priority, for example
+// jamfpro.OrderBy(rsql.Asc("priority")).
+func (c *Client) GetCategories(sort_filter string, opts ...ListOption) (*ResponseCategoriesList, error) {
+	c, done := c.startOperation("GetCategories")
+	defer done()
+
+	resp, err := NewPaginator[ResourceCategory](c, uriCategories).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "categories", err)
 	}
 
 	var out ResponseCategoriesList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceCategory
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "category", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetCategoryByID retrieves a category by its ID
 func (c *Client) GetCategoryByID(id string) (*ResourceCategory, error) {
+	c, done := c.startOperation("GetCategoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriCategories, id)
 
 	var category ResourceCategory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &category)
+	resp, err := c.doRequest("GET", endpoint, nil, &category)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "categories", id, err)
 	}
@@ -81,28 +82,22 @@ func (c *Client) GetCategoryByID(id string) (*ResourceCategory, error) {
 	return &category, nil
 }
 
-// GetCategoryNameByID retrieves a category by its name and then retrieves its details using its ID
+// GetCategoryByName retrieves a category by its name using a server side filter
 func (c *Client) GetCategoryByName(name string) (*ResourceCategory, error) {
-	categories, err := c.GetCategories("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "categories", err)
-	}
-
-	for _, value := range categories.Results {
-		if value.Name == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "category", name, errMsgNoName)
+	c, done := c.startOperation("GetCategoryByName")
+	defer done()
+	return getOneByName[ResourceCategory](c, uriCategories, "category", rsql.FieldName, name, func(value ResourceCategory) string { return value.Name })
 }
 
 // CreateCategory creates a new category
 func (c *Client) CreateCategory(category *ResourceCategory) (*ResponseCategoryCreateAndUpdate, error) {
+	c, done := c.startOperation("CreateCategory")
+	defer done()
+
 	endpoint := uriCategories
 
 	var response ResponseCategoryCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, category, &response)
+	resp, err := c.doRequest("POST", endpoint, category, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "category", err)
 	}
@@ -116,10 +111,13 @@ func (c *Client) CreateCategory(category *ResourceCategory) (*ResponseCategoryCr
 
 // UpdateCategoryByID updates an existing category by its ID
 func (c *Client) UpdateCategoryByID(id string, categoryUpdate *ResourceCategory) (*ResponseCategoryCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateCategoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriCategories, id)
 
 	var response ResponseCategoryCreateAndUpdate
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, categoryUpdate, &response)
+	resp, err := c.doRequest("PUT", endpoint, categoryUpdate, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "category", id, err)
 	}
@@ -133,6 +131,9 @@ func (c *Client) UpdateCategoryByID(id string, categoryUpdate *ResourceCategory)
 
 // UpdateCategoryByNameByID updates a category by its name and then updates its details using its ID.
 func (c *Client) UpdateCategoryByName(name string, categoryUpdate *ResourceCategory) (*ResponseCategoryCreateAndUpdate, error) {
+	c, done := c.startOperation("UpdateCategoryByName")
+	defer done()
+
 	category, err := c.GetCategoryByName(name)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "category", name, err)
@@ -150,9 +151,12 @@ func (c *Client) UpdateCategoryByName(name string, categoryUpdate *ResourceCateg
 
 // DeleteCategoryByID deletes a category by its ID
 func (c *Client) DeleteCategoryByID(id string) error {
+	c, done := c.startOperation("DeleteCategoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriCategories, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "category", id, err)
 	}
@@ -166,6 +170,9 @@ func (c *Client) DeleteCategoryByID(id string) error {
 
 // DeleteCategoryByNameByID deletes a category by its name after inferring its ID.
 func (c *Client) DeleteCategoryByName(name string) error {
+	c, done := c.startOperation("DeleteCategoryByName")
+	defer done()
+
 	category, err := c.GetCategoryByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "category", name, err)
@@ -183,6 +190,9 @@ func (c *Client) DeleteCategoryByName(name string) error {
 
 // DeleteMultipleCategoriesByID deletes multiple categories by their IDs
 func (c *Client) DeleteMultipleCategoriesByID(ids []string) error {
+	c, done := c.startOperation("DeleteMultipleCategoriesByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/delete-multiple", uriCategories)
 
 	// Construct the request payload
@@ -192,7 +202,7 @@ func (c *Client) DeleteMultipleCategoriesByID(ids []string) error {
 		IDs: ids,
 	}
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, nil)
+	resp, err := c.doRequest("POST", endpoint, payload, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteMultiple, "categories", ids, err)
 	}
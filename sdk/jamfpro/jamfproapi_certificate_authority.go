@@ -40,10 +40,13 @@ type ActiveCertificateAuthoritySubsetSignature struct {
 
 // GetActiveCertificateAuthority retrieves the active certificate authority details.
 func (c *Client) GetActiveCertificateAuthority() (*ResponseActiveCertificateAuthority, error) {
+	c, done := c.startOperation("GetActiveCertificateAuthority")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/active", uriCertificateAuthority)
 
 	var certAuth ResponseActiveCertificateAuthority
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &certAuth)
+	resp, err := c.doRequest("GET", endpoint, nil, &certAuth)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "certificate authority", err)
 	}
@@ -28,10 +28,13 @@ type ResourceClientCheckinSettings struct {
 // CRUD
 
 func (c *Client) GetClientCheckinSettings() (*ResourceClientCheckinSettings, error) {
+	c, done := c.startOperation("GetClientCheckinSettings")
+	defer done()
+
 	endpoint := UriClientCheckinSettings
 
 	var out ResourceClientCheckinSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "client checkin settings", err)
 	}
@@ -44,10 +47,13 @@ func (c *Client) GetClientCheckinSettings() (*ResourceClientCheckinSettings, err
 }
 
 func (c *Client) UpdateClientCheckinSettings(settingsUpdate ResourceClientCheckinSettings) (*ResourceClientCheckinSettings, error) {
+	c, done := c.startOperation("UpdateClientCheckinSettings")
+	defer done()
+
 	endpoint := UriClientCheckinSettings
 
 	var out ResourceClientCheckinSettings
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, settingsUpdate, &out)
+	resp, err := c.doRequest("PUT", endpoint, settingsUpdate, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "client checkin settings", err)
 	}
@@ -61,10 +61,13 @@ type ResponseCloudIdpCreate struct {
 
 // GetDefaultCloudIdentityProvider retrieves the default server configuration for the Cloud Identity Provider.
 func (c *Client) GetDefaultCloudIdentityProvider() (*ResourceCloudIdpServer, error) {
+	c, done := c.startOperation("GetDefaultCloudIdentityProvider")
+	defer done()
+
 	endpoint := uriCloudIdentityProvider + "/defaults/server-configuration"
 
 	var defaultCloudIdPServer ResourceCloudIdpServer
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &defaultCloudIdPServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &defaultCloudIdPServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "Azure Cloud IDP", err)
 	}
@@ -78,10 +81,13 @@ func (c *Client) GetDefaultCloudIdentityProvider() (*ResourceCloudIdpServer, err
 
 // GetCloudIdentityProviderByID retrieves Cloud Identity Provider information.
 func (c *Client) GetCloudIdentityProviderByID(id string) (*ResourceCloudIdp, error) {
+	c, done := c.startOperation("GetCloudIdentityProviderByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriCloudIdentityProvider, id)
 
 	var cloudIDP ResourceCloudIdp
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &cloudIDP)
+	resp, err := c.doRequest("GET", endpoint, nil, &cloudIDP)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "Azure Cloud IDP", id, err)
 	}
@@ -95,10 +101,13 @@ func (c *Client) GetCloudIdentityProviderByID(id string) (*ResourceCloudIdp, err
 
 // CreateCloudIdentityProvider creates a new Cloud Identity Provider.
 func (c *Client) CreateCloudIdentityProvider(cloudIdP *ResourceCloudIdp) (*ResponseCloudIdpCreate, error) {
+	c, done := c.startOperation("CreateCloudIdentityProvider")
+	defer done()
+
 	endpoint := uriCloudIdentityProvider
 
 	var responseCreateCloudIDP ResponseCloudIdpCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, cloudIdP, &responseCreateCloudIDP)
+	resp, err := c.doRequest("POST", endpoint, cloudIdP, &responseCreateCloudIDP)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "Azure Cloud IDP", err)
 	}
@@ -112,10 +121,13 @@ func (c *Client) CreateCloudIdentityProvider(cloudIdP *ResourceCloudIdp) (*Respo
 
 // UpdateCloudIdentityProviderById updates an existing Cloud Identity Provider by its ID.
 func (c *Client) UpdateCloudIdentityProviderByID(id string, cloudIdPUpdate *ResourceCloudIdp) (*ResourceCloudIdp, error) {
+	c, done := c.startOperation("UpdateCloudIdentityProviderByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s%s", uriCloudIdentityProvider, id)
 
 	var updatedCloudIDP ResourceCloudIdp
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, cloudIdPUpdate, &updatedCloudIDP)
+	resp, err := c.doRequest("PUT", endpoint, cloudIdPUpdate, &updatedCloudIDP)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "Azure Cloud IDP", id, err)
 	}
@@ -129,9 +141,12 @@ func (c *Client) UpdateCloudIdentityProviderByID(id string, cloudIdPUpdate *Reso
 
 // DeleteCloudIdentityProviderById deletes a Cloud Identity Provider by its ID.
 func (c *Client) DeleteCloudIdentityProviderByID(id string) error {
+	c, done := c.startOperation("DeleteCloudIdentityProviderByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s%s", uriCloudIdentityProvider, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "Azure Cloud IDP", id, err)
 	}
@@ -145,10 +160,13 @@ func (c *Client) DeleteCloudIdentityProviderByID(id string) error {
 
 // GetCloudIdentityProviderDefaultServerMappings retrieves the default mappings for the Cloud Identity Provider.
 func (c *Client) GetCloudIdentityProviderDefaultServerMappings() (*CloudIdpServerSubsetCloudIdpServerMappings, error) {
+	c, done := c.startOperation("GetCloudIdentityProviderDefaultServerMappings")
+	defer done()
+
 	endpoint := uriCloudIdentityProvider + "defaults/mappings"
 
 	var defaultMappings CloudIdpServerSubsetCloudIdpServerMappings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &defaultMappings)
+	resp, err := c.doRequest("GET", endpoint, nil, &defaultMappings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "Azure Cloud IDP Server Mappings", err)
 	}
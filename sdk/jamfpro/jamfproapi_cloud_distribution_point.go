@@ -27,10 +27,13 @@ type ResourceCloudDistributionPointUploadCapability struct {
 
 // GetCloudDistributionPointUploadCapability retrieves the default server configuration for the Cloud Identity Provider.
 func (c *Client) GetCloudDistributionPointUploadCapability() (*ResourceCloudDistributionPointUploadCapability, error) {
+	c, done := c.startOperation("GetCloudDistributionPointUploadCapability")
+	defer done()
+
 	endpoint := uriCloudDistributionPoint + "/upload-capability"
 
 	var cloudDistributionPointUploadCapability ResourceCloudDistributionPointUploadCapability
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &cloudDistributionPointUploadCapability)
+	resp, err := c.doRequest("GET", endpoint, nil, &cloudDistributionPointUploadCapability)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "Cloud Distribution Point", err)
 	}
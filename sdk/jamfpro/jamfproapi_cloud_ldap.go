@@ -56,10 +56,13 @@ type CloudIdentityProviderDefaultMappingsSubsetMembershipMappings struct {
 // CRUD
 
 func (c *Client) GetDefaultCloudIdentityProviderDefaultMappings(providerName string) (*ResponseCloudIdentityProviderDefaultMappings, error) {
+	c, done := c.startOperation("GetDefaultCloudIdentityProviderDefaultMappings")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/mappings", uriCloudLdaps, providerName)
 	var out ResponseCloudIdentityProviderDefaultMappings
 
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "cloud identity provider default mappings", err)
 	}
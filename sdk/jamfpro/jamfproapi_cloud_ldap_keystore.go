@@ -29,9 +29,12 @@ type ResponseCloudLdapVerifyKeystore struct {
 // CRUD
 
 func (c *Client) ValidateCloudLdapKeystore(payload PayloadCloudLdapVerifyKeystore) (*ResponseCloudLdapVerifyKeystore, error) {
+	c, done := c.startOperation("ValidateCloudLdapKeystore")
+	defer done()
+
 	endpoint := uriCloudLdapKeystore
 	var out ResponseCloudLdapVerifyKeystore
-	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &out)
+	resp, err := c.doRequest("POST", endpoint, payload, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedValidateCloudLdapKeystore, err)
 	}
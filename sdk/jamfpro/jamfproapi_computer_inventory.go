@@ -13,11 +13,60 @@ package jamfpro
 import (
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 const uriComputersInventory = "/api/v1/computers-inventory"
 
+// Computer inventory sections which can be requested from the list endpoint.
+// Jamf Pro returns only the General section when no section is requested.
+const (
+	ComputerInventorySectionAll                   = "ALL"
+	ComputerInventorySectionGeneral               = "GENERAL"
+	ComputerInventorySectionDiskEncryption        = "DISK_ENCRYPTION"
+	ComputerInventorySectionPurchasing            = "PURCHASING"
+	ComputerInventorySectionApplications          = "APPLICATIONS"
+	ComputerInventorySectionStorage               = "STORAGE"
+	ComputerInventorySectionUserAndLocation       = "USER_AND_LOCATION"
+	ComputerInventorySectionConfigurationProfiles = "CONFIGURATION_PROFILES"
+	ComputerInventorySectionPrinters              = "PRINTERS"
+	ComputerInventorySectionServices              = "SERVICES"
+	ComputerInventorySectionHardware              = "HARDWARE"
+	ComputerInventorySectionLocalUserAccounts     = "LOCAL_USER_ACCOUNTS"
+	ComputerInventorySectionCertificates          = "CERTIFICATES"
+	ComputerInventorySectionAttachments           = "ATTACHMENTS"
+	ComputerInventorySectionPlugins               = "PLUGINS"
+	ComputerInventorySectionPackageReceipts       = "PACKAGE_RECEIPTS"
+	ComputerInventorySectionFonts                 = "FONTS"
+	ComputerInventorySectionSecurity              = "SECURITY"
+	ComputerInventorySectionOperatingSystem       = "OPERATING_SYSTEM"
+	ComputerInventorySectionLicensedSoftware      = "LICENSED_SOFTWARE"
+	ComputerInventorySectionIBeacons              = "IBEACONS"
+	ComputerInventorySectionSoftwareUpdates       = "SOFTWARE_UPDATES"
+	ComputerInventorySectionExtensionAttributes   = "EXTENSION_ATTRIBUTES"
+	ComputerInventorySectionContentCaching        = "CONTENT_CACHING"
+	ComputerInventorySectionGroupMemberships      = "GROUP_MEMBERSHIPS"
+)
+
+// ComputerInventoryListOptions controls which sections, and which computers, are returned when
+// listing computer inventory. The zero value returns the General section of every computer.
+type ComputerInventoryListOptions struct {
+	// Sections to include, such as ComputerInventorySectionHardware. Defaults to General only.
+	Sections []string
+	// Sort criteria in the form '<field>[:asc|:desc]', such as "general.name:asc".
+	Sort []string
+	// OrderBy are typed sort criteria, such as rsql.Asc(rsql.ComputerGeneralName). It cannot be
+	// combined with Sort.
+	OrderBy []rsql.SortCriterion
+	// Filter is an RSQL expression, such as `hardware.appleSilicon==true`.
+	Filter string
+	// Where is a typed filter, such as rsql.Eq(rsql.ComputerHardwareAppleSilicon, true). It cannot be
+	// combined with Filter.
+	Where rsql.Filter
+	// PageSize is the number of computers requested per page. Defaults to the standard page size.
+	PageSize int
+}
+
 // List
 
 // ResponseComputerInventoryList represents the top-level JSON response structure.
@@ -28,34 +77,40 @@ type ResponseComputerInventoryList struct {
 
 // Resource
 
-// ResponseComputerInventory represents an individual computer from the inventory.
+// ResourceComputerInventory represents an individual computer from the inventory.
+//
+// Only the sections requested from the list endpoint are populated. Jamf Pro returns sections which
+// were not requested as null, so every section, whether a struct or a list, is left nil when it was
+// not fetched, while a requested list section with no entries is an empty, non-nil slice. Nil and
+// empty sections are omitted when the resource is encoded, so UpdateComputerInventoryByID only sends
+// the sections which are set.
 type ResourceComputerInventory struct {
 	ID                    string                                        `json:"id"`
 	UDID                  string                                        `json:"udid"`
-	General               ComputerInventorySubsetGeneral                `json:"general"`
-	DiskEncryption        ComputerInventorySubsetDiskEncryption         `json:"diskEncryption"`
-	Purchasing            ComputerInventorySubsetPurchasing             `json:"purchasing"`
-	Applications          []ComputerInventorySubsetApplication          `json:"applications"`
-	Storage               ComputerInventorySubsetStorage                `json:"storage"`
-	UserAndLocation       ComputerInventorySubsetUserAndLocation        `json:"userAndLocation"`
-	ConfigurationProfiles []ComputerInventorySubsetConfigurationProfile `json:"configurationProfiles"`
-	Printers              []ComputerInventorySubsetPrinter              `json:"printers"`
-	Services              []ComputerInventorySubsetService              `json:"services"`
-	Hardware              ComputerInventorySubsetHardware               `json:"hardware"`
-	LocalUserAccounts     []ComputerInventorySubsetLocalUserAccount     `json:"localUserAccounts"`
-	Certificates          []ComputerInventorySubsetCertificate          `json:"certificates"`
-	Attachments           []ComputerInventorySubsetAttachment           `json:"attachments"`
-	Plugins               []ComputerInventorySubsetPlugin               `json:"plugins"`
-	PackageReceipts       ComputerInventorySubsetPackageReceipts        `json:"packageReceipts"`
-	Fonts                 []ComputerInventorySubsetFont                 `json:"fonts"`
-	Security              ComputerInventorySubsetSecurity               `json:"security"`
-	OperatingSystem       ComputerInventorySubsetOperatingSystem        `json:"operatingSystem"`
-	LicensedSoftware      []ComputerInventorySubsetLicensedSoftware     `json:"licensedSoftware"`
-	Ibeacons              []ComputerInventorySubsetIBeacon              `json:"ibeacons"`
-	SoftwareUpdates       []ComputerInventorySubsetSoftwareUpdate       `json:"softwareUpdates"`
-	ExtensionAttributes   []ComputerInventorySubsetExtensionAttribute   `json:"extensionAttributes"`
-	ContentCaching        ComputerInventorySubsetContentCaching         `json:"contentCaching"`
-	GroupMemberships      []ComputerInventorySubsetGroupMembership      `json:"groupMemberships"`
+	General               *ComputerInventorySubsetGeneral               `json:"general,omitempty"`
+	DiskEncryption        *ComputerInventorySubsetDiskEncryption        `json:"diskEncryption,omitempty"`
+	Purchasing            *ComputerInventorySubsetPurchasing            `json:"purchasing,omitempty"`
+	Applications          []ComputerInventorySubsetApplication          `json:"applications,omitempty"`
+	Storage               *ComputerInventorySubsetStorage               `json:"storage,omitempty"`
+	UserAndLocation       *ComputerInventorySubsetUserAndLocation       `json:"userAndLocation,omitempty"`
+	ConfigurationProfiles []ComputerInventorySubsetConfigurationProfile `json:"configurationProfiles,omitempty"`
+	Printers              []ComputerInventorySubsetPrinter              `json:"printers,omitempty"`
+	Services              []ComputerInventorySubsetService              `json:"services,omitempty"`
+	Hardware              *ComputerInventorySubsetHardware              `json:"hardware,omitempty"`
+	LocalUserAccounts     []ComputerInventorySubsetLocalUserAccount     `json:"localUserAccounts,omitempty"`
+	Certificates          []ComputerInventorySubsetCertificate          `json:"certificates,omitempty"`
+	Attachments           []ComputerInventorySubsetAttachment           `json:"attachments,omitempty"`
+	Plugins               []ComputerInventorySubsetPlugin               `json:"plugins,omitempty"`
+	PackageReceipts       *ComputerInventorySubsetPackageReceipts       `json:"packageReceipts,omitempty"`
+	Fonts                 []ComputerInventorySubsetFont                 `json:"fonts,omitempty"`
+	Security              *ComputerInventorySubsetSecurity              `json:"security,omitempty"`
+	OperatingSystem       *ComputerInventorySubsetOperatingSystem       `json:"operatingSystem,omitempty"`
+	LicensedSoftware      []ComputerInventorySubsetLicensedSoftware     `json:"licensedSoftware,omitempty"`
+	Ibeacons              []ComputerInventorySubsetIBeacon              `json:"ibeacons,omitempty"`
+	SoftwareUpdates       []ComputerInventorySubsetSoftwareUpdate       `json:"softwareUpdates,omitempty"`
+	ExtensionAttributes   []ComputerInventorySubsetExtensionAttribute   `json:"extensionAttributes,omitempty"`
+	ContentCaching        *ComputerInventorySubsetContentCaching        `json:"contentCaching,omitempty"`
+	GroupMemberships      []ComputerInventorySubsetGroupMembership      `json:"groupMemberships,omitempty"`
 }
 
 // Subsets
@@ -533,39 +588,63 @@ type ResponseUploadAttachment struct {
 // CRUD
 
 // GetComputersInventory retrieves all computer inventory information with optional sorting and section filters.
+// sort_filter is a query string such as 'section=HARDWARE&sort=id:desc&filter=general.name=="Mac-01"'.
 func (c *Client) GetComputersInventory(sort_filter string) (*ResponseComputerInventoryList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriComputersInventory,
-		standardPageSize,
-		startingPageNumber,
-		"",
-	)
+	c, done := c.startOperation("GetComputersInventory")
+	defer done()
+
+	resp, err := NewPaginator[ResourceComputerInventory](c, uriComputersInventory).RawQuery(sort_filter).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computers-inventories", err)
 	}
 
 	var out ResponseComputerInventoryList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceComputerInventory
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "computer-inventory", err)
-		}
-		out.Results = append(out.Results, newObj)
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
+
+	return &out, nil
+}
+
+// GetComputersInventoryWithOptions retrieves the requested sections of every computer matching opts.
+// Sections which were not requested are left nil on each result.
+func (c *Client) GetComputersInventoryWithOptions(opts ComputerInventoryListOptions) (*ResponseComputerInventoryList, error) {
+	c, done := c.startOperation("GetComputersInventoryWithOptions")
+	defer done()
+
+	resp, err := c.ComputersInventoryPaginator(opts).All()
+	if err != nil {
+		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computers-inventories", err)
 	}
 
+	var out ResponseComputerInventoryList
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
+
 	return &out, nil
 }
 
+// ComputersInventoryPaginator returns a Paginator over the computers matching opts, which can be
+// used to stream large fleets with Items rather than loading every computer at once.
+func (c *Client) ComputersInventoryPaginator(opts ComputerInventoryListOptions) *Paginator[ResourceComputerInventory] {
+	return NewPaginator[ResourceComputerInventory](c, uriComputersInventory).
+		PageSize(opts.PageSize).
+		Sections(opts.Sections...).
+		Sort(opts.Sort...).
+		OrderBy(opts.OrderBy...).
+		Filter(opts.Filter).
+		Where(opts.Where)
+}
+
 // GetComputerInventoryByID retrieves a specific computer's inventory information by its ID.
 func (c *Client) GetComputerInventoryByID(id string) (*ResourceComputerInventory, error) {
+	c, done := c.startOperation("GetComputerInventoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriComputersInventory, id)
 
 	// Fetch the computer inventory by ID
 	var responseInventory ResourceComputerInventory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseInventory)
+	resp, err := c.doRequest("GET", endpoint, nil, &responseInventory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer inventory", id, err)
 	}
@@ -577,28 +656,29 @@ func (c *Client) GetComputerInventoryByID(id string) (*ResourceComputerInventory
 	return &responseInventory, nil
 }
 
-// GetComputerInventoryByName retrieves a specific computer's inventory information by its name.
+// GetComputerInventoryByName retrieves a specific computer's inventory information by its name using a server side
+// filter. Only the General section is returned, use GetComputerInventoryByID for every section.
 func (c *Client) GetComputerInventoryByName(name string) (*ResourceComputerInventory, error) {
-	inventories, err := c.GetComputersInventory("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computer inventory", err)
-	}
-
-	for _, inventory := range inventories.Results {
-		if inventory.General.Name == name {
-			return &inventory, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "computer inventory", name, err)
+	c, done := c.startOperation("GetComputerInventoryByName")
+	defer done()
+	return getOneByName[ResourceComputerInventory](c, uriComputersInventory, "computer inventory", rsql.ComputerGeneralName, name,
+		func(value ResourceComputerInventory) string {
+			if value.General == nil {
+				return ""
+			}
+			return value.General.Name
+		})
 }
 
 // UpdateComputerInventoryByID updates a specific computer's inventory information by its ID.
 func (c *Client) UpdateComputerInventoryByID(id string, inventoryUpdate *ResourceComputerInventory) (*ResourceComputerInventory, error) {
+	c, done := c.startOperation("UpdateComputerInventoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriComputersInventory, id)
 
 	var updatedInventory ResourceComputerInventory
-	resp, err := c.HTTP.DoRequest("PATCH", endpoint, inventoryUpdate, &updatedInventory)
+	resp, err := c.doRequest("PATCH", endpoint, inventoryUpdate, &updatedInventory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "computer inventory", id, err)
 	}
@@ -612,9 +692,12 @@ func (c *Client) UpdateComputerInventoryByID(id string, inventoryUpdate *Resourc
 
 // DeleteComputerInventoryByID deletes a computer's inventory information by its ID.
 func (c *Client) DeleteComputerInventoryByID(id string) error {
+	c, done := c.startOperation("DeleteComputerInventoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriComputersInventory, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "computer-iventory", id, err)
 	}
@@ -627,38 +710,35 @@ func (c *Client) DeleteComputerInventoryByID(id string) error {
 }
 
 // GetComputersFileVaultInventory retrieves all computer inventory filevault information.
-func (c *Client) GetComputersFileVaultInventory(sort_filter string) (*FileVaultInventoryList, error) {
+//
+// opts filter and sort the results by fields such as name or computerInventoryId, for
+// example jamfpro.Where(rsql.Eq(rsql.FieldName, "Mac-01")).
+func (c *Client) GetComputersFileVaultInventory(sort_filter string, opts ...ListOption) (*FileVaultInventoryList, error) {
+	c, done := c.startOperation("GetComputersFileVaultInventory")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/filevault", uriComputersInventory)
-	resp, err := c.DoPaginatedGet(
-		endpoint,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
+	resp, err := NewPaginator[FileVaultInventory](c, endpoint).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "filevault inventories", err)
 	}
 
 	var out FileVaultInventoryList
-	out.TotalCount = resp.Size
-	for _, value := range resp.Results {
-		var newObj FileVaultInventory
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "filevault inventory", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetComputerFileVaultInventoryByID returns file vault details by the computer ID.
 func (c *Client) GetComputerFileVaultInventoryByID(id string) (*FileVaultInventory, error) {
+	c, done := c.startOperation("GetComputerFileVaultInventoryByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/filevault", uriComputersInventory, id)
 
 	var fileVaultInventory FileVaultInventory
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &fileVaultInventory)
+	resp, err := c.doRequest("GET", endpoint, nil, &fileVaultInventory)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "file value inventory", id, err)
 	}
@@ -672,10 +752,13 @@ func (c *Client) GetComputerFileVaultInventoryByID(id string) (*FileVaultInvento
 
 // GetComputerRecoveryLockPasswordByID returns a computer recover lock password by the computer ID.
 func (c *Client) GetComputerRecoveryLockPasswordByID(id string) (*ResponseRecoveryLockPassword, error) {
+	c, done := c.startOperation("GetComputerRecoveryLockPasswordByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/view-recovery-lock-password", uriComputersInventory, id)
 
 	var recoveryLockPasswordResponse ResponseRecoveryLockPassword
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &recoveryLockPasswordResponse)
+	resp, err := c.doRequest("GET", endpoint, nil, &recoveryLockPasswordResponse)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "recovery lock password", id, err)
 	}
@@ -710,7 +793,7 @@ func (c *Client) GetComputerRecoveryLockPasswordByID(id string) (*ResponseRecove
 // 	headersMap := map[string]http.Header{}
 
 // 	var response ResponseUploadAttachment
-// 	resp, err := c.HTTP.DoMultiPartRequest("POST", endpoint, files, formFields, contentTypes, headersMap, &response)
+// 	resp, err := c.doMultiPartRequest("POST", endpoint, files, formFields, contentTypes, headersMap, &response)
 // 	if err != nil {
 // 		return nil, fmt.Errorf("failed to upload package: %v", err)
 // 	}
@@ -724,11 +807,14 @@ func (c *Client) GetComputerRecoveryLockPasswordByID(id string) (*ResponseRecove
 // DeleteAttachmentByIDAndComputerID deletes a computer's inventory attached by computer ID
 // and the computer's attachment ID. Multiple attachments can be assigned to a single computer resource.
 func (c *Client) DeleteAttachmentByIDAndComputerID(computerID, attachmentID string) error {
+	c, done := c.startOperation("DeleteAttachmentByIDAndComputerID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/attachments/%s", uriComputersInventory, computerID, attachmentID)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete attachment: %v", err)
+		return fmt.Errorf("failed to delete attachment: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
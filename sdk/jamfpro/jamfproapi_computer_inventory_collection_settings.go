@@ -57,10 +57,13 @@ type ResourceComputerInventoryCollectionSettingsCustomPath struct {
 
 // GetComputerInventoryCollectionSettingss retrives a computer inventory collection list.
 func (c *Client) GetComputerInventoryCollectionSettings() (*ResourceComputerInventoryCollectionSettings, error) {
+	c, done := c.startOperation("GetComputerInventoryCollectionSettings")
+	defer done()
+
 	endpoint := uriComputerInventoryCollectionSettings
 
 	var settings ResourceComputerInventoryCollectionSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &settings)
+	resp, err := c.doRequest("GET", endpoint, nil, &settings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "computer inventory collection settings", err)
 	}
@@ -74,10 +77,13 @@ func (c *Client) GetComputerInventoryCollectionSettings() (*ResourceComputerInve
 
 // UpdateComputerInventoryCollectionSettings updates the computer inventory collection settings.
 func (c *Client) UpdateComputerInventoryCollectionSettings(settingsUpdate *ResourceComputerInventoryCollectionSettings) (*ResourceComputerInventoryCollectionSettings, error) {
+	c, done := c.startOperation("UpdateComputerInventoryCollectionSettings")
+	defer done()
+
 	endpoint := uriComputerInventoryCollectionSettings
 
 	var updatedSettings ResourceComputerInventoryCollectionSettings
-	resp, err := c.HTTP.DoRequest("PATCH", endpoint, settingsUpdate, &updatedSettings)
+	resp, err := c.doRequest("PATCH", endpoint, settingsUpdate, &updatedSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "computer inventory collection settings", err)
 	}
@@ -91,10 +97,13 @@ func (c *Client) UpdateComputerInventoryCollectionSettings(settingsUpdate *Resou
 
 // CreateComputerInventoryCollectionSettingsCustomPath creates a custom path for computer inventory collection settings.
 func (c *Client) CreateComputerInventoryCollectionSettingsCustomPath(customPath *ResourceComputerInventoryCollectionSettingsCustomPath) (*ResourceComputerInventoryCollectionSettingsCustomPath, error) {
+	c, done := c.startOperation("CreateComputerInventoryCollectionSettingsCustomPath")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/custom-path", uriComputerInventoryCollectionSettings)
 
 	var response ResourceComputerInventoryCollectionSettingsCustomPath
-	resp, err := c.HTTP.DoRequest("POST", endpoint, customPath, &response)
+	resp, err := c.doRequest("POST", endpoint, customPath, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "computer inventory collection settings custom path", err)
 	}
@@ -108,9 +117,12 @@ func (c *Client) CreateComputerInventoryCollectionSettingsCustomPath(customPath
 
 // DeleteComputerInventoryCollectionSettingsCustomPathByID deletes a custom path by ID.
 func (c *Client) DeleteComputerInventoryCollectionSettingsCustomPathByID(id string) error {
+	c, done := c.startOperation("DeleteComputerInventoryCollectionSettingsCustomPathByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/custom-path/%s", uriComputerInventoryCollectionSettings, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "computer inventory collection settings custom path", id, err)
 	}
@@ -0,0 +1,41 @@
+// jamfproapi_computer_inventory_test.go
+package jamfpro
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestComputerInventorySections(t *testing.T) {
+	// Jamf Pro returns unrequested sections as null and requested but empty lists as [].
+	body := `{"id":"1","udid":"u","general":{"name":"Mac-01"},"hardware":null,"applications":[],"extensionAttributes":null}`
+
+	var computer ResourceComputerInventory
+	if err := json.Unmarshal([]byte(body), &computer); err != nil {
+		t.Fatalf("Unmarshal() returned error: %v", err)
+	}
+
+	if computer.General == nil || computer.General.Name != "Mac-01" {
+		t.Errorf("General = %+v, want the requested section", computer.General)
+	}
+	if computer.Hardware != nil || computer.ExtensionAttributes != nil {
+		t.Errorf("Hardware = %+v, ExtensionAttributes = %+v, want nil for unrequested sections", computer.Hardware, computer.ExtensionAttributes)
+	}
+	if computer.Applications == nil || len(computer.Applications) != 0 {
+		t.Errorf("Applications = %#v, want an empty, non-nil slice for a requested section", computer.Applications)
+	}
+
+	encoded, err := json.Marshal(ResourceComputerInventory{ID: "1", General: computer.General})
+	if err != nil {
+		t.Fatalf("Marshal() returned error: %v", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatalf("Unmarshal() returned error: %v", err)
+	}
+	for _, section := range []string{"hardware", "applications", "extensionAttributes"} {
+		if _, ok := fields[section]; ok {
+			t.Errorf("encoded resource contains unset section %q: %s", section, encoded)
+		}
+	}
+}
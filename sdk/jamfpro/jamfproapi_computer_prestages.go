@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriComputerPrestagesV2 = "/api/v2/computer-prestages"
@@ -184,38 +182,34 @@ type OnboardingItem struct {
 // CRUD
 
 // GetComputerPrestagesV3 retrieves all computer prestage information with optional sorting.
-func (c *Client) GetComputerPrestages(sort_filter string) (*ResponseComputerPrestagesList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriComputerPrestagesV3,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
-
+//
+// opts filter and sort the prestages by fields such as displayName or id, for example
+// jamfpro.Where(rsql.Like(rsql.FieldDisplayName, "Staff*")).
+func (c *Client) GetComputerPrestages(sort_filter string, opts ...ListOption) (*ResponseComputerPrestagesList, error) {
+	c, done := c.startOperation("GetComputerPrestages")
+	defer done()
+
+	resp, err := NewPaginator[ResourceComputerPrestage](c, uriComputerPrestagesV3).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computer prestages", err)
 	}
 
 	var out ResponseComputerPrestagesList
-	out.TotalCount = &resp.Size
-	for _, value := range resp.Results {
-		var newObj ResourceComputerPrestage
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "computer prestages", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = &resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetComputerPrestageByID retrieves a specific computer prestage by its ID.
 func (c *Client) GetComputerPrestageByID(id string) (*ResourceComputerPrestage, error) {
+	c, done := c.startOperation("GetComputerPrestageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriComputerPrestagesV3, id)
 
 	var prestage ResourceComputerPrestage
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &prestage)
+	resp, err := c.doRequest("GET", endpoint, nil, &prestage)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer prestage", id, err)
 	}
@@ -227,28 +221,29 @@ func (c *Client) GetComputerPrestageByID(id string) (*ResourceComputerPrestage,
 	return &prestage, nil
 }
 
-// GetComputerPrestageByName retrieves a specific computer prestage by its name.
+// GetComputerPrestageByName retrieves a specific computer prestage by its name. The endpoint does not support
+// filtering, so the prestages are listed and matched locally.
 func (c *Client) GetComputerPrestageByName(name string) (*ResourceComputerPrestage, error) {
+	c, done := c.startOperation("GetComputerPrestageByName")
+	defer done()
+
 	prestages, err := c.GetComputerPrestages("")
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computer prestages", err)
 	}
 
-	for _, value := range prestages.Results {
-		if value.DisplayName == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "computer prestage", name, errMsgNoName)
+	return findOneByName(prestages.Results, "computer prestage", name, func(value ResourceComputerPrestage) string { return value.DisplayName })
 }
 
 // CreateComputerPrestage creates a new computer prestage with the given details.
 func (c *Client) CreateComputerPrestage(prestage *ResourceComputerPrestage) (*ResponseComputerPrestageCreate, error) {
+	c, done := c.startOperation("CreateComputerPrestage")
+	defer done()
+
 	endpoint := uriComputerPrestagesV3
 
 	var creationResponse ResponseComputerPrestageCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, prestage, &creationResponse)
+	resp, err := c.doRequest("POST", endpoint, prestage, &creationResponse)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "computer prestage", err)
 	}
@@ -262,12 +257,15 @@ func (c *Client) CreateComputerPrestage(prestage *ResourceComputerPrestage) (*Re
 
 // UpdateComputerPrestageByID updates a computer prestage by its ID.
 func (c *Client) UpdateComputerPrestageByID(id string, prestageUpdate *ResourceComputerPrestage) (*ResourceComputerPrestage, error) {
+	c, done := c.startOperation("UpdateComputerPrestageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriComputerPrestagesV3, id)
 
 	var updatedPrestage ResourceComputerPrestage
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, prestageUpdate, &updatedPrestage)
+	resp, err := c.doRequest("PUT", endpoint, prestageUpdate, &updatedPrestage)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update computer prestage with ID %s: %v", id, err)
+		return nil, fmt.Errorf("failed to update computer prestage with ID %s: %w", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -279,6 +277,9 @@ func (c *Client) UpdateComputerPrestageByID(id string, prestageUpdate *ResourceC
 
 // UpdateComputerPrestageByNameByID updates a computer prestage based on its display name.
 func (c *Client) UpdateComputerPrestageByName(name string, prestageUpdate *ResourceComputerPrestage) (*ResourceComputerPrestage, error) {
+	c, done := c.startOperation("UpdateComputerPrestageByName")
+	defer done()
+
 	target, err := c.GetComputerPrestageByName(name)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "computer prestage", name, err)
@@ -296,9 +297,12 @@ func (c *Client) UpdateComputerPrestageByName(name string, prestageUpdate *Resou
 
 // DeleteComputerPrestageByID deletes a computer prestage by its ID
 func (c *Client) DeleteComputerPrestageByID(id string) error {
+	c, done := c.startOperation("DeleteComputerPrestageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriComputerPrestagesV3, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "computer prestage", id, err)
 	}
@@ -312,6 +316,9 @@ func (c *Client) DeleteComputerPrestageByID(id string) error {
 
 // DeleteComputerPrestageByNameByID deletes a computer prestage by its name.
 func (c *Client) DeleteComputerPrestageByName(name string) error {
+	c, done := c.startOperation("DeleteComputerPrestageByName")
+	defer done()
+
 	target, err := c.GetComputerPrestageByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedPaginatedGet, "computer prestages", err)
@@ -329,10 +336,13 @@ func (c *Client) DeleteComputerPrestageByName(name string) error {
 
 // GetDeviceScopeForComputerPrestage retrieves the device scope for a specific computer prestage by its ID.
 func (c *Client) GetDeviceScopeForComputerPrestageByID(id string) (*ResponseDeviceScope, error) {
+	c, done := c.startOperation("GetDeviceScopeForComputerPrestageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/scope", uriComputerPrestagesV2, id)
 
 	var deviceScope ResponseDeviceScope
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &deviceScope)
+	resp, err := c.doRequest("GET", endpoint, nil, &deviceScope)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "computer prestage scope", id, err)
 	}
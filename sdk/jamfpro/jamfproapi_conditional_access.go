@@ -18,10 +18,13 @@ type ResourceConditionalAccessDeviceComplianceStatus struct {
 
 // GetConditionalAccessDeviceComplianceFeatureEnablement retrieves the enablement state of the device compliance settinfs for CA.
 func (c *Client) GetConditionalAccessDeviceComplianceFeatureEnablement() (*ResourceConditionalAccessDeviceComplianceStatus, error) {
+	c, done := c.startOperation("GetConditionalAccessDeviceComplianceFeatureEnablement")
+	defer done()
+
 	endpoint := uriConditionalAccess + "/device-compliance/feature-toggle"
 
 	var defaultCloudIdPServer ResourceConditionalAccessDeviceComplianceStatus
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &defaultCloudIdPServer)
+	resp, err := c.doRequest("GET", endpoint, nil, &defaultCloudIdPServer)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "Conditional Access Device Compliance", err)
 	}
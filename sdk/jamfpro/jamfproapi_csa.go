@@ -28,10 +28,13 @@ type ResponseCSATenantID struct {
 
 // GetCSATokenExchangeDetails retrieves details regarding the CSA token exchange.
 func (c *Client) GetCSATokenExchangeDetails() (*ResponseCSATokenExchangeDetails, error) {
+	c, done := c.startOperation("GetCSATokenExchangeDetails")
+	defer done()
+
 	endpoint := uriCSATokenExchange
 	var details ResponseCSATokenExchangeDetails
 
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &details)
+	resp, err := c.doRequest("GET", endpoint, nil, &details)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "csa token exchange details", err)
 	}
@@ -45,10 +48,13 @@ func (c *Client) GetCSATokenExchangeDetails() (*ResponseCSATokenExchangeDetails,
 
 // GetCSATenantID retrieves the CSA tenant ID.
 func (c *Client) GetCSATenantID() (*ResponseCSATenantID, error) {
+	c, done := c.startOperation("GetCSATenantID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/tenant-id", uriCSATokenExchange)
 	var tenantID ResponseCSATenantID
 
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &tenantID)
+	resp, err := c.doRequest("GET", endpoint, nil, &tenantID)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "CSA tenant ID", err)
 	}
@@ -62,10 +68,13 @@ func (c *Client) GetCSATenantID() (*ResponseCSATenantID, error) {
 
 // DeleteCSATokenExchange deletes the CSA token exchange, disabling Jamf Pro's ability to authenticate with cloud-hosted services.
 func (c *Client) DeleteCSATokenExchange() (*SharedResourcResponseError, error) {
+	c, done := c.startOperation("DeleteCSATokenExchange")
+	defer done()
+
 	endpoint := uriCSATokenExchange
 
 	var responseError SharedResourcResponseError
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, &responseError)
+	resp, err := c.doRequest("DELETE", endpoint, nil, &responseError)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedDelete, "CSA token exchange", err)
 	}
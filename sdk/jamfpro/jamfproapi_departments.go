@@ -8,7 +8,7 @@ package jamfpro
 import (
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 // Responses
@@ -33,38 +33,33 @@ type ResourceDepartment struct {
 }
 
 // GetDepartments retrieves a list of all departments in list
-func (c *Client) GetDepartments(sort_filter string) (*ResponseDepartmentsList, error) {
-	endpoint := uriDepartments
-	resp, err := c.DoPaginatedGet(
-		endpoint,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
+//
+// opts filter and sort the departments by name or id, for example
+// jamfpro.Where(rsql.Like(rsql.FieldName, "IT*")).
+func (c *Client) GetDepartments(sort_filter string, opts ...ListOption) (*ResponseDepartmentsList, error) {
+	c, done := c.startOperation("GetDepartments")
+	defer done()
+
+	resp, err := NewPaginator[ResourceDepartment](c, uriDepartments).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "departments", err)
 	}
 
 	var out ResponseDepartmentsList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceDepartment
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "department", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetDepartmentByID retrieves a department by ID.
 func (c *Client) GetDepartmentByID(id string) (*ResourceDepartment, error) {
+	c, done := c.startOperation("GetDepartmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%v", uriDepartments, id)
 	var out ResourceDepartment
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "department", id, err)
@@ -77,28 +72,22 @@ func (c *Client) GetDepartmentByID(id string) (*ResourceDepartment, error) {
 	return &out, nil
 }
 
-// GetDepartmentByName retrieves a department by Name.
+// GetDepartmentByName retrieves a department by Name using a server side filter.
 func (c *Client) GetDepartmentByName(name string) (*ResourceDepartment, error) {
-	depts, err := c.GetDepartments("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "departments", err)
-	}
-
-	for _, value := range depts.Results {
-		if value.Name == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "department", name, errMsgNoName)
+	c, done := c.startOperation("GetDepartmentByName")
+	defer done()
+	return getOneByName[ResourceDepartment](c, uriDepartments, "department", rsql.FieldName, name, func(value ResourceDepartment) string { return value.Name })
 }
 
 // CreateDepartment creates a new department.
 func (c *Client) CreateDepartment(department *ResourceDepartment) (*ResponseDepartmentCreate, error) {
+	c, done := c.startOperation("CreateDepartment")
+	defer done()
+
 	endpoint := uriDepartments
 	var out ResponseDepartmentCreate
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, department, &out)
+	resp, err := c.doRequest("POST", endpoint, department, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "department", err)
 	}
@@ -113,10 +102,13 @@ func (c *Client) CreateDepartment(department *ResourceDepartment) (*ResponseDepa
 
 // UpdateDepartmentByID Updates department by resource ID
 func (c *Client) UpdateDepartmentByID(id string, departmentUpdate *ResourceDepartment) (*ResourceDepartment, error) {
+	c, done := c.startOperation("UpdateDepartmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriDepartments, id)
 	var out ResourceDepartment
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, departmentUpdate, &out)
+	resp, err := c.doRequest("PUT", endpoint, departmentUpdate, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "department", id, err)
 	}
@@ -130,6 +122,9 @@ func (c *Client) UpdateDepartmentByID(id string, departmentUpdate *ResourceDepar
 
 // UpdateDepartmentByName Updates department by resource name
 func (c *Client) UpdateDepartmentByName(targetName string, departmentUpdate *ResourceDepartment) (*ResourceDepartment, error) {
+	c, done := c.startOperation("UpdateDepartmentByName")
+	defer done()
+
 	target, err := c.GetDepartmentByName(targetName)
 
 	if err != nil {
@@ -149,8 +144,11 @@ func (c *Client) UpdateDepartmentByName(targetName string, departmentUpdate *Res
 
 // DeleteDepartmentByID Deletes department with given id
 func (c *Client) DeleteDepartmentByID(id string) error {
+	c, done := c.startOperation("DeleteDepartmentByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriDepartments, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 
 	if err != nil || resp.StatusCode != 204 {
 		return fmt.Errorf(errMsgFailedDeleteByID, "department", id, err)
@@ -165,6 +163,9 @@ func (c *Client) DeleteDepartmentByID(id string) error {
 
 // DeleteDepartmentByName deletes a department with given name, leverages GetDepartmentByName
 func (c *Client) DeleteDepartmentByName(targetName string) error {
+	c, done := c.startOperation("DeleteDepartmentByName")
+	defer done()
+
 	target, err := c.GetDepartmentByName(targetName)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "department", targetName, err)
@@ -24,9 +24,12 @@ type ResourceDeviceCommunicationSettings struct {
 
 // Gets device communication settings
 func (c *Client) GetDeviceCommunicationSettings() (*ResourceDeviceCommunicationSettings, error) {
+	c, done := c.startOperation("GetDeviceCommunicationSettings")
+	defer done()
+
 	endpoint := uriDeviceCommunicationSettings
 	var out ResourceDeviceCommunicationSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "device communication settings", err)
 	}
@@ -40,9 +43,12 @@ func (c *Client) GetDeviceCommunicationSettings() (*ResourceDeviceCommunicationS
 
 // Updates device communication settings
 func (c *Client) UpdateDeviceCommunicationSettings(updatedSettings ResourceDeviceCommunicationSettings) (*ResourceDeviceCommunicationSettings, error) {
+	c, done := c.startOperation("UpdateDeviceCommunicationSettings")
+	defer done()
+
 	endpoint := uriDeviceCommunicationSettings
 	var out ResourceDeviceCommunicationSettings
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
+	resp, err := c.doRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "device communication settings", err)
 	}
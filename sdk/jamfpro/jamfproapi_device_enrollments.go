@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriDeviceEnrollments = "/api/v1/device-enrollments"
@@ -42,28 +40,21 @@ type ResourceDeviceEnrollment struct {
 // CRUD
 
 // GetDeviceEnrollments retrieves a paginated list of device enrollments.
-func (c *Client) GetDeviceEnrollments(sort_filter string) (*ResponseDeviceEnrollmentsList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriDeviceEnrollments,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
+//
+// opts filter and sort the device enrollment instances by fields such as name or
+// serverName, for example jamfpro.OrderBy(rsql.Asc(rsql.FieldName)).
+func (c *Client) GetDeviceEnrollments(sort_filter string, opts ...ListOption) (*ResponseDeviceEnrollmentsList, error) {
+	c, done := c.startOperation("GetDeviceEnrollments")
+	defer done()
+
+	resp, err := NewPaginator[ResourceDeviceEnrollment](c, uriDeviceEnrollments).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "device enrollments", err)
 	}
 
 	var out ResponseDeviceEnrollmentsList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceDeviceEnrollment
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "device enrollments", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
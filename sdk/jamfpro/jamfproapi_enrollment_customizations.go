@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriEnrollmentCustomizationSettings = "/api/v2/enrollment-customizations"
@@ -55,29 +53,21 @@ type EnrollmentCustomizationSubsetBrandingSettings struct {
 // TODO Download an image - https://developer.jamf.com/jamf-pro/reference/get_v2-enrollment-customizations-images-id
 
 // Returns paginated list of Enrollment Customization
-func (c *Client) GetEnrollmentCustomizations(sort_filter string) (*ResponseEnrollmentCustomizationList, error) {
-	endpoint := uriEnrollmentCustomizationSettings
-	resp, err := c.DoPaginatedGet(
-		endpoint,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
-
+//
+// opts filter and sort the customizations by fields such as displayName or siteId, for
+// example jamfpro.Where(rsql.Eq("siteId", "-1")) for those not assigned to a site.
+func (c *Client) GetEnrollmentCustomizations(sort_filter string, opts ...ListOption) (*ResponseEnrollmentCustomizationList, error) {
+	c, done := c.startOperation("GetEnrollmentCustomizations")
+	defer done()
+
+	resp, err := NewPaginator[ResourceEnrollmentCustomization](c, uriEnrollmentCustomizationSettings).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "enrollment customization", err)
 	}
 
 	var out ResponseEnrollmentCustomizationList
-	out.TotalCount = resp.Size
-	for _, value := range resp.Results {
-		var newObj ResourceEnrollmentCustomization
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "enrollment customization", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 
@@ -85,9 +75,12 @@ func (c *Client) GetEnrollmentCustomizations(sort_filter string) (*ResponseEnrol
 
 // Returns single ResourceEnrollmentCustomization object matching given id
 func (c *Client) GetEnrollmentCustomizationByID(id string) (*ResourceEnrollmentCustomization, error) {
+	c, done := c.startOperation("GetEnrollmentCustomizationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriEnrollmentCustomizationSettings, id)
 	var out ResourceEnrollmentCustomization
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "enrollment customization", id, err)
@@ -103,9 +96,12 @@ func (c *Client) GetEnrollmentCustomizationByID(id string) (*ResourceEnrollmentC
 
 // Creates new resource enrollment customization
 func (c *Client) CreateEnrollmentCustomization(enrollmentCustomization ResourceEnrollmentCustomization) (*ResponseEnrollmentCustomizationCreate, error) {
+	c, done := c.startOperation("CreateEnrollmentCustomization")
+	defer done()
+
 	endpoint := uriEnrollmentCustomizationSettings
 	var out ResponseEnrollmentCustomizationCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, enrollmentCustomization, &out)
+	resp, err := c.doRequest("POST", endpoint, enrollmentCustomization, &out)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "enrollment customization", err)
@@ -121,9 +117,12 @@ func (c *Client) CreateEnrollmentCustomization(enrollmentCustomization ResourceE
 
 // Updates resource enrollment customization by id
 func (c *Client) UpdateEnrollmentCustomizationByID(id string, updatedCustomization ResourceEnrollmentCustomization) (*ResourceEnrollmentCustomization, error) {
+	c, done := c.startOperation("UpdateEnrollmentCustomizationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriEnrollmentCustomizationSettings, id)
 	var out ResourceEnrollmentCustomization
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedCustomization, &out)
+	resp, err := c.doRequest("PUT", endpoint, updatedCustomization, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "enrollment customization settings", id, err)
 	}
@@ -137,9 +136,12 @@ func (c *Client) UpdateEnrollmentCustomizationByID(id string, updatedCustomizati
 
 // Deletes resource enrollment customization by id
 func (c *Client) DeleteEnrollmentCustomizationByID(id string) error {
+	c, done := c.startOperation("DeleteEnrollmentCustomizationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriEnrollmentCustomizationSettings, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "enrollment customization settings", id, err)
@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriGSXConnection = "/api/v1/gsx-connection"
@@ -59,13 +57,15 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(connection)
 */
 func (c *Client) GetGSXConnectionInformation() (*ResourceGSXConnection, error) {
+	c, done := c.startOperation("GetGSXConnectionInformation")
+	defer done()
+
 	endpoint := uriGSXConnection
 
 	var gsxConnectionSettings ResourceGSXConnection
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &gsxConnectionSettings)
+	resp, err := c.doRequest("GET", endpoint, nil, &gsxConnectionSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "gsx connection information", err)
 	}
@@ -104,13 +104,15 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 */
 func (c *Client) UpdateGSXConnectionInformation(gsxConnectionSettingsUpdate *ResourceGSXConnection) (*ResourceGSXConnection, error) {
+	c, done := c.startOperation("UpdateGSXConnectionInformation")
+	defer done()
+
 	endpoint := uriGSXConnection
 
 	var out ResourceGSXConnection
-	resp, err := c.HTTP.DoRequest("PATCH", endpoint, gsxConnectionSettingsUpdate, &out)
+	resp, err := c.doRequest("PATCH", endpoint, gsxConnectionSettingsUpdate, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "gsx connection information", err)
 	}
@@ -129,6 +131,8 @@ Path: /api/v1/gsx-connection/history
 Description: Retrieves all GSX connection history.
 Parameters:
   - sort_filter (string): A string specifying the sorting criteria.
+  - opts (...ListOption): Typed rsql filters and sort criteria on date, username or note, such as
+    jamfpro.Where(rsql.Eq("username", "admin")).
 
 Returns: ResponseGSXConnectionHistoryList - A list of GSX connection history.
 Errors: Returns an error if the request fails.
@@ -138,30 +142,19 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(history)
 */
-func (c *Client) GetGSXConnectionHistory(sort_filter string) (*ResponseGSXConnectionHistoryList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriGSXConnection,
-		maxPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
+func (c *Client) GetGSXConnectionHistory(sort_filter string, opts ...ListOption) (*ResponseGSXConnectionHistoryList, error) {
+	c, done := c.startOperation("GetGSXConnectionHistory")
+	defer done()
+
+	resp, err := NewPaginator[ResponseGSXConnectionHistory](c, uriGSXConnection).PageSize(maxPageSize).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "gsx connection history", err)
 	}
 
 	var out ResponseGSXConnectionHistoryList
-	out.TotalCount = &resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResponseGSXConnectionHistory
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "gsx connection history", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = &resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
@@ -11,8 +11,11 @@ const uriHealthCheck = "/api/v1/health-check"
 
 // GetHealthCheck fetches the Jamf Pro API status.
 func (c *Client) GetHealthCheck() (bool, error) {
+	c, done := c.startOperation("GetHealthCheck")
+	defer done()
+
 	endpoint := uriHealthCheck
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, nil)
+	resp, err := c.doRequest("GET", endpoint, nil, nil)
 	if err != nil {
 		return false, fmt.Errorf(errMsgFailedGet, "Health Check", err)
 	}
@@ -8,16 +8,12 @@
 package jamfpro
 
 import (
-	"context"
 	"fmt"
+	"net/http"
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
 )
 
 const uriJCDS2 = "/api/v1/jcds"
@@ -58,9 +54,12 @@ type JCDS2Properties struct {
 
 // GetJCDS2Packages fetches a file list from Jamf Cloud Distribution Service
 func (c *Client) GetJCDS2Packages() ([]ResponseJCDS2List, error) {
+	c, done := c.startOperation("GetJCDS2Packages")
+	defer done()
+
 	endpoint := uriJCDS2 + "/files"
 	var out []ResponseJCDS2List
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "JCDS 2.0", err)
 	}
@@ -74,9 +73,12 @@ func (c *Client) GetJCDS2Packages() ([]ResponseJCDS2List, error) {
 
 // GetJCDS2Properties fetches properties from Jamf Cloud Distribution Service
 func (c *Client) GetJCDS2Properties() (*JCDS2Properties, error) {
+	c, done := c.startOperation("GetJCDS2Properties")
+	defer done()
+
 	endpoint := uriJCDS2 + "/properties"
 	var out JCDS2Properties
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "JCDS 2.0", err)
 	}
@@ -90,9 +92,12 @@ func (c *Client) GetJCDS2Properties() (*JCDS2Properties, error) {
 
 // GetJCDS2PackageURIByName fetches a file URI from Jamf Cloud Distribution Service
 func (c *Client) GetJCDS2PackageURIByName(id string) (*ResponseJCDS2File, error) {
+	c, done := c.startOperation("GetJCDS2PackageURIByName")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%v", uriJCDS2+"/files", id)
 	var out ResponseJCDS2File
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "JCDS 2.0", id, err)
@@ -107,9 +112,12 @@ func (c *Client) GetJCDS2PackageURIByName(id string) (*ResponseJCDS2File, error)
 
 // RenewJCDS2Credentials renews credentials for JCDS 2.0
 func (c *Client) RenewJCDS2Credentials() (*ResponseJCDS2UploadCredentials, error) {
+	c, done := c.startOperation("RenewJCDS2Credentials")
+	defer done()
+
 	endpoint := uriJCDS2 + "/renew-credentials"
 	var out ResponseJCDS2UploadCredentials
-	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &out)
+	resp, err := c.doRequest("POST", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "JCDS 2.0", err)
 	}
@@ -122,103 +130,43 @@ func (c *Client) RenewJCDS2Credentials() (*ResponseJCDS2UploadCredentials, error
 }
 
 // CreateJCDS2PackageV2 creates a new file in JCDS 2.0 using AWS SDK v2 without creating package metadata in Jamf Pro.
+// It is UploadJCDS2Package with resume disabled, so a failed upload is aborted rather than saved.
 func (c *Client) CreateJCDS2PackageV2(filePath string) (*ResponseJCDS2File, error) {
-	// Step 1: Obtain AWS credentials for the package upload endpoint
-	var uploadCredentials ResponseJCDS2UploadCredentials
-	resp, err := c.HTTP.DoRequest("POST", uriJCDS2+"/files", nil, &uploadCredentials)
-	if err != nil {
-		return nil, fmt.Errorf("failed to obtain upload credentials: %v", err)
-	}
-	if resp != nil && resp.Body != nil {
-		defer resp.Body.Close()
-	}
+	c, done := c.startOperation("CreateJCDS2PackageV2")
+	defer done()
 
-	// Validate if we received necessary details
-	if uploadCredentials.Region == "" || uploadCredentials.BucketName == "" || uploadCredentials.Path == "" {
-		return nil, fmt.Errorf("incomplete upload credentials received")
+	if c.plan != nil {
+		if err := c.planJCDS2("jamfpro.CreateJCDS2PackageV2", http.MethodPut, filePath); err != nil {
+			return nil, err
+		}
+		return &ResponseJCDS2File{}, nil
 	}
 
-	// Step 2: Use the obtained credentials to configure AWS SDK v2
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(uploadCredentials.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(uploadCredentials.AccessKeyID, uploadCredentials.SecretAccessKey, uploadCredentials.SessionToken)),
-	)
+	result, err := c.UploadJCDS2Package(filePath, &JCDS2UploadOptions{DisableResume: true})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create AWS config: %v", err)
+		return nil, err
 	}
 
-	// Create S3 service client
-	s3Client := s3.NewFromConfig(cfg)
-
-	// Step 3: Create an Uploader with the configuration and default options
-	uploader := manager.NewUploader(s3Client)
-
-	// Step 3: Use the secure file reading helper
-	fileReader, fileSize, err := helpers.ReadJCDSPackageTypes(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read package file securely: %v", err)
-	}
-
-	// Create a progress reader
-	progressReader := &ProgressReader{
-		reader:     fileReader,
-		totalBytes: fileSize,
-		progressFn: func(read, total int64, unit string) {
-			fmt.Printf("\rUploaded %d / %d %s (%.2f%%)", read, total, unit, float64(read)/float64(total)*100)
-		},
-	}
-
-	// Create the upload input
-	uploadInput := &s3.PutObjectInput{
-		Bucket: aws.String(uploadCredentials.BucketName),
-		Key:    aws.String(uploadCredentials.Path + filepath.Base(filePath)),
-		Body:   progressReader,
-	}
-
-	// Step 4. Perform the upload
-	_, err = uploader.Upload(context.TODO(), uploadInput)
-	if err != nil {
-		return nil, fmt.Errorf("failed to upload file: %v", err)
-	}
-
-	fmt.Println("\nUpload completed Successfully")
-
-	// Construct the final file upload response
-	finalResponse := &ResponseJCDS2File{
-		URI: fmt.Sprintf("s3://%s/%s%s", uploadCredentials.BucketName, uploadCredentials.Path, filepath.Base(filePath)),
-	}
-
-	return finalResponse, nil
+	return &ResponseJCDS2File{URI: result.URI}, nil
 }
 
 // DeleteJCDS2PackageV2 deletes an existing file from JCDS 2.0 using AWS SDK v2.
 func (c *Client) DeleteJCDS2PackageV2(filePath string) error {
-	// Step 1: Obtain AWS credentials for the package deletion endpoint
-	var uploadCredentials ResponseJCDS2UploadCredentials
-	resp, err := c.HTTP.DoRequest("POST", uriJCDS2+"/files", nil, &uploadCredentials)
-	if err != nil {
-		return fmt.Errorf("failed to obtain deletion credentials: %v", err)
-	}
-	if resp != nil && resp.Body != nil {
-		defer resp.Body.Close()
-	}
+	c, done := c.startOperation("DeleteJCDS2PackageV2")
+	defer done()
 
-	// Validate if we received necessary details
-	if uploadCredentials.Region == "" || uploadCredentials.BucketName == "" || uploadCredentials.Path == "" {
-		return fmt.Errorf("incomplete deletion credentials received")
+	if c.plan != nil {
+		return c.planJCDS2("jamfpro.DeleteJCDS2PackageV2", http.MethodDelete, filePath)
 	}
 
-	// Step 2: Use the obtained credentials to configure AWS SDK v2
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(uploadCredentials.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(uploadCredentials.AccessKeyID, uploadCredentials.SecretAccessKey, uploadCredentials.SessionToken)),
-	)
+	// Step 1: Obtain AWS credentials for the package deletion endpoint
+	uploadCredentials, err := c.jcds2Credentials()
 	if err != nil {
-		return fmt.Errorf("failed to create AWS config: %v", err)
+		return fmt.Errorf("failed to obtain deletion credentials: %w", err)
 	}
 
-	// Create S3 service client
-	s3Client := s3.NewFromConfig(cfg)
+	// Step 2: Create an S3 client with the obtained credentials
+	s3Client := c.jcds2S3Client(uploadCredentials)
 
 	// Step 3: Define the object to delete
 	objectToDelete := &s3.DeleteObjectInput{
@@ -227,11 +175,11 @@ func (c *Client) DeleteJCDS2PackageV2(filePath string) error {
 	}
 
 	// Step 4: Perform the deletion
-	_, err = s3Client.DeleteObject(context.TODO(), objectToDelete)
+	_, err = s3Client.DeleteObject(c.Context(), objectToDelete)
 	if err != nil {
-		return fmt.Errorf("failed to delete file: %v", err)
+		return fmt.Errorf("failed to delete file: %w", err)
 	}
 
-	fmt.Printf("File '%s' successfully deleted from JCDS 2.0.\n", filepath.Base(filePath))
+	c.Logger().Info("Deleted file from JCDS 2.0", "file", filepath.Base(filePath), "bucket", uploadCredentials.BucketName)
 	return nil
 }
@@ -27,12 +27,15 @@ type ResponseJamfProInformation struct {
 // CRUD
 
 func (c *Client) GetJamfProInformation() (*ResponseJamfProInformation, error) {
+	c, done := c.startOperation("GetJamfProInformation")
+	defer done()
+
 	endpoint := uriJamfProInformation
 
 	var info ResponseJamfProInformation
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &info)
+	resp, err := c.doRequest("GET", endpoint, nil, &info)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch Jamf Pro information: %v", err)
+		return nil, fmt.Errorf("failed to fetch Jamf Pro information: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
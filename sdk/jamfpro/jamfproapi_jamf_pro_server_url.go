@@ -20,10 +20,13 @@ type ResourceJamfProServerURL struct {
 
 // Returns ResourceJamfProServerURL
 func (c *Client) GetJamfProServerUrlSettings() (*ResourceJamfProServerURL, error) {
+	c, done := c.startOperation("GetJamfProServerUrlSettings")
+	defer done()
+
 	endpoint := uriJamfProServerUrl
 	var out ResourceJamfProServerURL
 
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "jamf pro server url settings", err)
 	}
@@ -37,10 +40,13 @@ func (c *Client) GetJamfProServerUrlSettings() (*ResourceJamfProServerURL, error
 
 // Updates Jamf Pro Server URL settings
 func (c *Client) UpdateJamfProServerUrlSettings(updatedSettings ResourceJamfProServerURL) (*ResourceJamfProServerURL, error) {
+	c, done := c.startOperation("UpdateJamfProServerUrlSettings")
+	defer done()
+
 	endpoint := uriJamfProServerUrl
 	var out ResourceJamfProServerURL
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
+	resp, err := c.doRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "jamf pro server url settings", err)
 	}
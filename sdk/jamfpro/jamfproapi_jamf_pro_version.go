@@ -20,12 +20,15 @@ type ResponseJamfProVersion struct {
 // CRUD
 
 func (c *Client) GetJamfProVersion() (*ResponseJamfProVersion, error) {
+	c, done := c.startOperation("GetJamfProVersion")
+	defer done()
+
 	endpoint := uriJamfProVersion
 
 	var version ResponseJamfProVersion
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &version)
+	resp, err := c.doRequest("GET", endpoint, nil, &version)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch Jamf Pro version: %v", err)
+		return nil, fmt.Errorf("failed to fetch Jamf Pro version: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
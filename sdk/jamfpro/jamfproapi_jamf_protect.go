@@ -2,8 +2,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriJamfProtect = "/api/v1/jamf-protect"
@@ -78,9 +76,12 @@ type ResourceJamfProtectRegisterResponse struct {
 // CRUD
 
 func (c *Client) GetJamfProtectIntegrationSettings() (*ResourceJamfProtectIntegrationSettings, error) {
+	c, done := c.startOperation("GetJamfProtectIntegrationSettings")
+	defer done()
+
 	endpoint := uriJamfProtect
 	var out ResourceJamfProtectIntegrationSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "jamf protect integration settings", err)
@@ -94,9 +95,12 @@ func (c *Client) GetJamfProtectIntegrationSettings() (*ResourceJamfProtectIntegr
 }
 
 func (c *Client) UpdateJamfProtectIntegrationSettings(updatedSettings ResourceJamfProtectIntegrationSettings) (*ResourceJamfProtectIntegrationSettings, error) {
+	c, done := c.startOperation("UpdateJamfProtectIntegrationSettings")
+	defer done()
+
 	endpoint := uriJamfProtect
 	var out ResourceJamfProtectIntegrationSettings
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
+	resp, err := c.doRequest("PUT", endpoint, updatedSettings, &out)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "jamf protect integration settings", err)
@@ -110,12 +114,15 @@ func (c *Client) UpdateJamfProtectIntegrationSettings(updatedSettings ResourceJa
 }
 
 func (c *Client) CreateJamfProtectIntegration(autoInstall bool) (*ResourceJamfProtectIntegrationSettings, error) {
+	c, done := c.startOperation("CreateJamfProtectIntegration")
+	defer done()
+
 	endpoint := uriJamfProtect
 	requestBody := ResourceJamfProtectIntegrationRequest{
 		AutoInstall: autoInstall,
 	}
 	var out ResourceJamfProtectIntegrationSettings
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &out)
+	resp, err := c.doRequest("PUT", endpoint, requestBody, &out)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "jamf protect integration", err)
@@ -129,9 +136,12 @@ func (c *Client) CreateJamfProtectIntegration(autoInstall bool) (*ResourceJamfPr
 }
 
 func (c *Client) DeleteJamfProtectIntegration() error {
+	c, done := c.startOperation("DeleteJamfProtectIntegration")
+	defer done()
+
 	endpoint := uriJamfProtect
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDelete, "jamf protect integration", err)
 	}
@@ -145,13 +155,16 @@ func (c *Client) DeleteJamfProtectIntegration() error {
 
 // New function to request a retry of Protect install tasks
 func (c *Client) RetryJamfProtectInstallTasks(deploymentID string, taskIDs []string) error {
+	c, done := c.startOperation("RetryJamfProtectInstallTasks")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/deployments/%s/tasks/retry", uriJamfProtect, deploymentID)
 
 	requestBody := ResourceJamfProtectRetryRequest{
 		IDs: taskIDs,
 	}
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, nil)
+	resp, err := c.doRequest("POST", endpoint, requestBody, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdate, "retry Jamf Protect install tasks", err)
 	}
@@ -164,59 +177,56 @@ func (c *Client) RetryJamfProtectInstallTasks(deploymentID string, taskIDs []str
 }
 
 // GetJamfProtectHistory retrieves the history of Jamf Protect actions
-func (c *Client) GetJamfProtectHistory(sortFilter string) (*ResponseJamfProtectHistoryList, error) {
-	endpoint := fmt.Sprintf("%s/history", uriJamfProtect)
+//
+// opts filter and sort the history entries by date, username or note, for example
+// jamfpro.OrderBy(rsql.Desc("date")).
+func (c *Client) GetJamfProtectHistory(sortFilter string, opts ...ListOption) (*ResponseJamfProtectHistoryList, error) {
+	c, done := c.startOperation("GetJamfProtectHistory")
+	defer done()
 
-	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sortFilter)
+	endpoint := fmt.Sprintf("%s/history", uriJamfProtect)
+	resp, err := NewPaginator[ResourceJamfProtectHistory](c, endpoint).RawQuery(sortFilter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "Jamf Protect history", err)
 	}
 
 	var out ResponseJamfProtectHistoryList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceJamfProtectHistory
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "Jamf Protect history", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetJamfProtectPlans retrieves all previously synced Jamf Protect Plans with their associated configuration profile information
-func (c *Client) GetJamfProtectPlans(sortFilter string) (*ResponseJamfProtectPlansList, error) {
-	endpoint := fmt.Sprintf("%s/plans", uriJamfProtect)
+//
+// opts filter and sort the plans by fields such as name or id, for example
+// jamfpro.Where(rsql.Like(rsql.FieldName, "Default*")).
+func (c *Client) GetJamfProtectPlans(sortFilter string, opts ...ListOption) (*ResponseJamfProtectPlansList, error) {
+	c, done := c.startOperation("GetJamfProtectPlans")
+	defer done()
 
-	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sortFilter)
+	endpoint := fmt.Sprintf("%s/plans", uriJamfProtect)
+	resp, err := NewPaginator[ResourceJamfProtectPlan](c, endpoint).RawQuery(sortFilter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "Jamf Protect plans", err)
 	}
 
 	var out ResponseJamfProtectPlansList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceJamfProtectPlan
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "Jamf Protect plan", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // SyncJamfProtectPlans syncs plans with Jamf Protect
 func (c *Client) SyncJamfProtectPlans() error {
+	c, done := c.startOperation("SyncJamfProtectPlans")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/sync", uriJamfProtect)
 
 	var errorResponse SharedResourcResponseError
-	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &errorResponse)
+	resp, err := c.doRequest("POST", endpoint, nil, &errorResponse)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdate, "sync Jamf Protect plans", err)
 	}
@@ -235,10 +245,13 @@ func (c *Client) SyncJamfProtectPlans() error {
 
 // CreateJamfProtectAPIConfiguration registers a Jamf Protect API configuration with Jamf Pro
 func (c *Client) CreateJamfProtectAPIConfiguration(config ResourceJamfProtectRegisterRequest) (*ResourceJamfProtectRegisterResponse, error) {
+	c, done := c.startOperation("CreateJamfProtectAPIConfiguration")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/register", uriJamfProtect)
 
 	var response ResourceJamfProtectRegisterResponse
-	resp, err := c.HTTP.DoRequest("POST", endpoint, config, &response)
+	resp, err := c.doRequest("POST", endpoint, config, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "register Jamf Protect API configuration", err)
 	}
@@ -20,17 +20,19 @@ type ResponseSSOFailover struct {
 
 // GetSSOFailoverSettings fetches SSO failover settings from Jamf Pro
 func (c *Client) GetSSOFailoverSettings() (*ResponseSSOFailover, error) {
+	c, done := c.startOperation("GetSSOFailoverSettings")
+	defer done()
+
 	var out ResponseSSOFailover
 
-	resp, err := c.HTTP.DoRequest("GET", uriSSOFailover, nil, &out)
+	resp, err := c.doRequest("GET", uriSSOFailover, nil, &out)
 
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
 	if err != nil {
-		fmt.Printf(errMsgFailedGet, "sso failover settings", err)
-		return nil, err
+		return nil, fmt.Errorf(errMsgFailedGet, "sso failover settings", err)
 	}
 
 	return &out, nil
@@ -38,19 +40,21 @@ func (c *Client) GetSSOFailoverSettings() (*ResponseSSOFailover, error) {
 
 // UpdateFailoverUrl regenerates the failover URL by changing the failover key to a new one and returns the new failover settings.
 func (c *Client) UpdateFailoverUrl() (*ResponseSSOFailover, error) {
+	c, done := c.startOperation("UpdateFailoverUrl")
+	defer done()
+
 	var out ResponseSSOFailover
 
 	endpoint := uriSSOFailover + "/generate"
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &out)
+	resp, err := c.doRequest("POST", endpoint, nil, &out)
 
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
 	if err != nil {
-		fmt.Printf(errMsgFailedUpdate, "sso failover url", err)
-		return nil, err
+		return nil, fmt.Errorf(errMsgFailedUpdate, "sso failover url", err)
 	}
 
 	return &out, nil
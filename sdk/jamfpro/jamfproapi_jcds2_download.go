@@ -0,0 +1,605 @@
+// jamfproapi_jcds2_download.go
+// Jamf Pro Api - Jamf Cloud Distribution Service (JCDS) downloads and local mirrors
+// Files are streamed from the download URI Jamf Pro issues for them into a partial file, which is resumed
+// with a range request after an interruption, and verified against the length and MD5 Jamf Pro lists.
+// A partial file is only resumed while the JCDS file is still the one it was started from.
+
+package jamfpro
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// JCDS2DefaultDownloadRetries is the number of times a download which fails part way is resumed.
+	JCDS2DefaultDownloadRetries = 3
+	// JCDS2DefaultMirrorConcurrency is the number of files a mirror downloads at once.
+	JCDS2DefaultMirrorConcurrency = 2
+
+	// jcds2PartialSuffix is appended to the path of a file while it is downloaded.
+	jcds2PartialSuffix = ".jcdspart"
+	// jcds2PartialStateSuffix is appended to the path of a file for the record of the JCDS file its
+	// partial file was started from.
+	jcds2PartialStateSuffix = ".jcdspart.json"
+	// jcds2MirrorManifest is the name of the file in which a mirror records the files it holds.
+	jcds2MirrorManifest = ".jcds2-mirror.json"
+)
+
+// JCDS2DownloadOptions configures DownloadJCDS2Package.
+type JCDS2DownloadOptions struct {
+	// Progress is called as bytes are downloaded, including the bytes of a resumed partial file.
+	Progress JCDS2ProgressFunc
+	// Retries is the number of times a download which fails part way is resumed within the call.
+	// Defaults to JCDS2DefaultDownloadRetries, and a negative value disables retries.
+	Retries int
+}
+
+// JCDS2DownloadResult describes a verified JCDS 2.0 download.
+type JCDS2DownloadResult struct {
+	FileName string `json:"fileName"`
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	MD5      string `json:"md5"`
+	SHA512   string `json:"sha512"`
+	// ResumedBytes is the size of the partial file left by an earlier, interrupted, download.
+	ResumedBytes int64 `json:"resumedBytes"`
+}
+
+// DownloadJCDS2Package downloads the JCDS 2.0 file fileName to destPath. The file is written to
+// destPath with a ".jcdspart" suffix while it downloads, and a partial file left by an interrupted
+// download is resumed with a range request. The length, MD5 and ETag of the JCDS file are kept beside
+// the partial file, so a partial file of a file which has since changed is discarded rather than
+// resumed. Once complete, the length and MD5 of the file are checked against those listed by Jamf Pro
+// before it is moved to destPath.
+//
+// Example usage:
+//
+//	result, err := client.DownloadJCDS2Package("Firefox.pkg", "/srv/packages/Firefox.pkg", nil)
+func (c *Client) DownloadJCDS2Package(fileName, destPath string, options *JCDS2DownloadOptions) (*JCDS2DownloadResult, error) {
+	c, done := c.startOperation("DownloadJCDS2Package")
+	defer done()
+
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if file.FileName == fileName {
+			return c.downloadJCDS2File(file, destPath, options)
+		}
+	}
+	return nil, fmt.Errorf(errMsgFailedGetByName, "JCDS 2.0 file", fileName, ErrNotFound)
+}
+
+// downloadJCDS2File downloads the listed file to destPath.
+func (c *Client) downloadJCDS2File(file ResponseJCDS2List, destPath string, options *JCDS2DownloadOptions) (*JCDS2DownloadResult, error) {
+	var opts JCDS2DownloadOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.Retries == 0 {
+		opts.Retries = JCDS2DefaultDownloadRetries
+	}
+
+	ctx := c.Context()
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create download directory: %w", err)
+	}
+
+	partialPath := destPath + jcds2PartialSuffix
+	partial, err := os.OpenFile(partialPath, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open partial download: %w", err)
+	}
+	defer partial.Close()
+
+	// A download may take far longer than the timeout of an API request, so only ctx limits it.
+	httpClient := buildHTTPClient(&c.config)
+	httpClient.Timeout = 0
+
+	download := &jcds2Download{
+		client:     c,
+		httpClient: httpClient,
+		file:       file,
+		partial:    partial,
+		statePath:  destPath + jcds2PartialStateSuffix,
+		progress:   opts.Progress,
+	}
+	if err := download.resume(ctx); err != nil {
+		return nil, err
+	}
+	result := &JCDS2DownloadResult{FileName: file.FileName, Path: destPath, ResumedBytes: download.offset}
+
+	for attempt := 0; ; attempt++ {
+		err = download.fetch(ctx)
+		if err == nil || ctx.Err() != nil || attempt >= opts.Retries {
+			break
+		}
+		c.Logger().Warn("JCDS 2.0 download interrupted, resuming", "file", file.FileName,
+			"downloaded", download.offset, "size_bytes", file.Length, "attempt", attempt+1, "error", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", file.FileName, err)
+	}
+
+	result.Size = download.offset
+	result.MD5 = hex.EncodeToString(download.md5.Sum(nil))
+	result.SHA512 = hex.EncodeToString(download.sha512.Sum(nil))
+
+	var mismatches []string
+	if result.Size != file.Length {
+		mismatches = append(mismatches, fmt.Sprintf("size is %d bytes, expected %d", result.Size, file.Length))
+	}
+	if file.MD5 != "" && !strings.EqualFold(result.MD5, file.MD5) {
+		mismatches = append(mismatches, fmt.Sprintf("MD5 is %s, expected %s", result.MD5, file.MD5))
+	}
+	if len(mismatches) > 0 {
+		partial.Close()
+		os.Remove(partialPath)
+		os.Remove(download.statePath)
+		return nil, fmt.Errorf("downloaded file %s does not match JCDS 2.0: %s", file.FileName, strings.Join(mismatches, "; "))
+	}
+
+	if err := partial.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", partialPath, err)
+	}
+	if err := os.Rename(partialPath, destPath); err != nil {
+		return nil, fmt.Errorf("failed to move download into place: %w", err)
+	}
+	os.Remove(download.statePath)
+
+	c.Logger().Info("Downloaded file from JCDS 2.0", "file", file.FileName, "path", destPath,
+		"size_bytes", result.Size, "resumed_bytes", result.ResumedBytes)
+	return result, nil
+}
+
+// jcds2Download is a download of a file into a partial file in progress.
+type jcds2Download struct {
+	client     *Client
+	httpClient *http.Client
+	file       ResponseJCDS2List
+	partial    *os.File
+	progress   JCDS2ProgressFunc
+
+	// statePath is where state is kept, beside the partial file.
+	statePath string
+	state     jcds2PartialState
+
+	// offset is the number of bytes written to the partial file, all of which have been hashed.
+	offset int64
+	md5    hash.Hash
+	sha512 hash.Hash
+}
+
+// jcds2PartialState identifies the JCDS file a partial file was started from.
+type jcds2PartialState struct {
+	Length int64  `json:"length"`
+	MD5    string `json:"md5"`
+	// ETag is the ETag of the object the partial file was downloaded from, sent as If-Range when
+	// resuming so that a changed object is downloaded whole.
+	ETag string `json:"etag,omitempty"`
+}
+
+// resume hashes the contents of an existing partial file. The partial file is discarded when it is
+// larger than the file, or when it has no state or its state shows it was started from a JCDS file
+// with a different length or MD5.
+func (d *jcds2Download) resume(ctx context.Context) error {
+	d.md5, d.sha512 = md5.New(), sha512.New()
+
+	info, err := d.partial.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to read partial download: %w", err)
+	}
+
+	var saved jcds2PartialState
+	data, err := os.ReadFile(d.statePath)
+	if err == nil {
+		err = json.Unmarshal(data, &saved)
+	}
+	if err != nil || saved.Length != d.file.Length || !strings.EqualFold(saved.MD5, d.file.MD5) || info.Size() > d.file.Length {
+		if info.Size() > 0 {
+			d.client.Logger().Info("Discarding partial JCDS 2.0 download of a different file", "file", d.file.FileName, "size_bytes", info.Size())
+		}
+		d.state = jcds2PartialState{Length: d.file.Length, MD5: d.file.MD5}
+		if err := d.restart(); err != nil {
+			return err
+		}
+		return d.saveState()
+	}
+	d.state = saved
+
+	if err := copyWithContext(ctx, io.MultiWriter(d.md5, d.sha512), io.NewSectionReader(d.partial, 0, info.Size())); err != nil {
+		return fmt.Errorf("failed to hash partial download: %w", err)
+	}
+	d.offset = info.Size()
+	if _, err := d.partial.Seek(d.offset, io.SeekStart); err != nil {
+		return err
+	}
+	d.report()
+	return nil
+}
+
+// saveState records the JCDS file the partial file is downloaded from.
+func (d *jcds2Download) saveState() error {
+	data, err := json.Marshal(d.state)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(d.statePath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to record partial download: %w", err)
+	}
+	return nil
+}
+
+// restart empties the partial file.
+func (d *jcds2Download) restart() error {
+	if err := d.partial.Truncate(0); err != nil {
+		return fmt.Errorf("failed to reset partial download: %w", err)
+	}
+	if _, err := d.partial.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	d.offset = 0
+	d.md5.Reset()
+	d.sha512.Reset()
+	return nil
+}
+
+// fetch requests a new download URI and streams the rest of the file from it into the partial file.
+func (d *jcds2Download) fetch(ctx context.Context) error {
+	if d.offset == d.file.Length {
+		return nil
+	}
+
+	uri, err := d.client.WithContext(ctx).GetJCDS2PackageURIByName(d.file.FileName)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.URI, nil)
+	if err != nil {
+		return fmt.Errorf("invalid download URI: %w", err)
+	}
+	if d.offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", d.offset))
+		if d.state.ETag != "" {
+			req.Header.Set("If-Range", d.state.ETag)
+		}
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && d.offset > 0:
+		if err := d.checkContentRange(resp.Header.Get("Content-Range")); err != nil {
+			// The response does not continue the partial file, so the next attempt starts over.
+			if restartErr := d.restart(); restartErr != nil {
+				return restartErr
+			}
+			return err
+		}
+	case resp.StatusCode == http.StatusOK:
+		// The range was ignored, or the object changed since the partial file was started, so the
+		// whole file is downloaded again.
+		if err := d.restart(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" && etag != d.state.ETag {
+		d.state.ETag = etag
+		if err := d.saveState(); err != nil {
+			return err
+		}
+	}
+
+	writer := io.MultiWriter(d.partial, d.md5, d.sha512)
+	buf := make([]byte, 1024*1024)
+	for {
+		n, readErr := resp.Body.Read(buf)
+		if n > 0 {
+			if _, err := writer.Write(buf[:n]); err != nil {
+				return fmt.Errorf("failed to write partial download: %w", err)
+			}
+			d.offset += int64(n)
+			d.report()
+		}
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
+
+// checkContentRange checks that a partial response starts where the partial file ends and belongs to
+// a file of the expected length.
+func (d *jcds2Download) checkContentRange(contentRange string) error {
+	var start, end int64
+	var total string
+	_, err := fmt.Sscanf(contentRange, "bytes %d-%d/%s", &start, &end, &total)
+	if err != nil || start != d.offset || (total != "*" && total != strconv.FormatInt(d.file.Length, 10)) {
+		return fmt.Errorf("unexpected Content-Range %q for a download resumed at byte %d of %d", contentRange, d.offset, d.file.Length)
+	}
+	return nil
+}
+
+// report passes the number of bytes downloaded to the progress callback.
+func (d *jcds2Download) report() {
+	if d.progress != nil {
+		d.progress(d.offset, d.file.Length)
+	}
+}
+
+// JCDS2MirrorOptions configures MirrorJCDS2Packages.
+type JCDS2MirrorOptions struct {
+	// Concurrency is the number of files downloaded at once. Defaults to JCDS2DefaultMirrorConcurrency.
+	Concurrency int
+	// Filter selects the files to mirror. All files are mirrored when it is nil.
+	Filter func(file ResponseJCDS2List) bool
+	// Delete removes mirrored files which are no longer in JCDS 2.0 or no longer selected.
+	Delete bool
+	// Verify hashes every mirrored file again instead of trusting the checksums stored with the mirror.
+	Verify bool
+	// Progress is called as bytes of each file are downloaded.
+	Progress func(fileName string, transferred, total int64)
+}
+
+// JCDS2MirrorResult lists the files a mirror downloaded, found up to date, deleted and failed to download.
+type JCDS2MirrorResult struct {
+	Downloaded []string         `json:"downloaded"`
+	UpToDate   []string         `json:"upToDate"`
+	Deleted    []string         `json:"deleted"`
+	Failed     map[string]error `json:"-"`
+}
+
+// jcds2MirrorEntry is the record of a mirrored file, which is up to date while the JCDS file has the
+// same length and MD5 and the local file the same size and modification time.
+type jcds2MirrorEntry struct {
+	Length   int64     `json:"length"`
+	MD5      string    `json:"md5"`
+	SHA512   string    `json:"sha512"`
+	ModTime  time.Time `json:"modTime"`
+	Mirrored time.Time `json:"mirrored"`
+}
+
+// MirrorJCDS2Packages synchronises the JCDS 2.0 files to dir. Files are downloaded only when they are
+// new or have changed since the last mirror, which is decided from the checksums the mirror stores in
+// dir/.jcds2-mirror.json rather than by hashing every local file. Interrupted downloads are resumed
+// by the next mirror. A failed file does not stop the others; failures are listed in the result and
+// returned as a joined error.
+//
+// Example usage:
+//
+//	result, err := client.MirrorJCDS2Packages("/srv/jcds-backup", &jamfpro.JCDS2MirrorOptions{Delete: true})
+func (c *Client) MirrorJCDS2Packages(dir string, options *JCDS2MirrorOptions) (*JCDS2MirrorResult, error) {
+	c, done := c.startOperation("MirrorJCDS2Packages")
+	defer done()
+
+	var opts JCDS2MirrorOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = JCDS2DefaultMirrorConcurrency
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mirror directory: %w", err)
+	}
+	manifestPath := filepath.Join(dir, jcds2MirrorManifest)
+	manifest, err := loadJCDS2MirrorManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := c.Context()
+	client := c.WithContext(ctx)
+	result := &JCDS2MirrorResult{Failed: map[string]error{}}
+	var mu sync.Mutex
+	selected := map[string]bool{}
+
+	var pending []ResponseJCDS2List
+	for _, file := range files {
+		if opts.Filter != nil && !opts.Filter(file) {
+			continue
+		}
+		if !isJCDS2FileName(file.FileName) {
+			result.Failed[file.FileName] = fmt.Errorf("file name %q is not a valid local file name", file.FileName)
+			continue
+		}
+		selected[file.FileName] = true
+
+		upToDate, err := jcds2MirrorUpToDate(ctx, filepath.Join(dir, file.FileName), file, manifest[file.FileName], opts.Verify)
+		if err != nil {
+			result.Failed[file.FileName] = err
+			continue
+		}
+		if upToDate {
+			result.UpToDate = append(result.UpToDate, file.FileName)
+			continue
+		}
+		pending = append(pending, file)
+	}
+
+	// saveManifest records a mirrored file, so that an interrupted mirror keeps the files it completed.
+	saveManifest := func(name string, entry *jcds2MirrorEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+		if entry == nil {
+			delete(manifest, name)
+		} else {
+			manifest[name] = *entry
+		}
+		if err := saveJCDS2MirrorManifest(manifestPath, manifest); err != nil {
+			c.Logger().Warn("Failed to save JCDS 2.0 mirror manifest", "path", manifestPath, "error", err)
+		}
+	}
+
+	queue := make(chan ResponseJCDS2List)
+	var wg sync.WaitGroup
+	for range min(opts.Concurrency, max(len(pending), 1)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range queue {
+				downloadOptions := &JCDS2DownloadOptions{}
+				if opts.Progress != nil {
+					downloadOptions.Progress = func(transferred, total int64) {
+						mu.Lock()
+						defer mu.Unlock()
+						opts.Progress(file.FileName, transferred, total)
+					}
+				}
+
+				path := filepath.Join(dir, file.FileName)
+				downloaded, err := client.downloadJCDS2File(file, path, downloadOptions)
+				if err == nil {
+					var info os.FileInfo
+					if info, err = os.Stat(path); err == nil {
+						saveManifest(file.FileName, &jcds2MirrorEntry{
+							Length:   downloaded.Size,
+							MD5:      downloaded.MD5,
+							SHA512:   downloaded.SHA512,
+							ModTime:  info.ModTime(),
+							Mirrored: time.Now().UTC(),
+						})
+					}
+				}
+
+				mu.Lock()
+				if err != nil {
+					result.Failed[file.FileName] = err
+				} else {
+					result.Downloaded = append(result.Downloaded, file.FileName)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+queueFiles:
+	for _, file := range pending {
+		select {
+		case queue <- file:
+		case <-ctx.Done():
+			break queueFiles
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if opts.Delete && ctx.Err() == nil {
+		for _, name := range sortedKeys(manifest) {
+			if selected[name] {
+				continue
+			}
+			if err := os.Remove(filepath.Join(dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				result.Failed[name] = fmt.Errorf("failed to delete mirrored file: %w", err)
+				continue
+			}
+			saveManifest(name, nil)
+			result.Deleted = append(result.Deleted, name)
+		}
+	}
+
+	sort.Strings(result.Downloaded)
+	c.Logger().Info("Mirrored JCDS 2.0 files", "dir", dir, "downloaded", len(result.Downloaded),
+		"up_to_date", len(result.UpToDate), "deleted", len(result.Deleted), "failed", len(result.Failed))
+
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	var errs []error
+	for _, name := range sortedKeys(result.Failed) {
+		errs = append(errs, fmt.Errorf("%s: %w", name, result.Failed[name]))
+	}
+	return result, errors.Join(errs...)
+}
+
+// jcds2MirrorUpToDate reports whether the mirrored file at path is a copy of the JCDS file.
+func jcds2MirrorUpToDate(ctx context.Context, path string, file ResponseJCDS2List, entry jcds2MirrorEntry, verify bool) (bool, error) {
+	if entry.Length != file.Length || (file.MD5 != "" && !strings.EqualFold(entry.MD5, file.MD5)) {
+		return false, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Size() != entry.Length {
+		return false, nil
+	}
+	if !verify {
+		return info.ModTime().Equal(entry.ModTime), nil
+	}
+
+	local, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer local.Close()
+	md5Hex, sha512Hex, err := hashJCDS2File(ctx, local)
+	if err != nil {
+		return false, fmt.Errorf("failed to hash mirrored file: %w", err)
+	}
+	return strings.EqualFold(md5Hex, entry.MD5) && sha512Hex == entry.SHA512, nil
+}
+
+// isJCDS2FileName reports whether name can be used as a file name within the mirror directory.
+func isJCDS2FileName(name string) bool {
+	return name != "" && name != "." && name != ".." && name != jcds2MirrorManifest &&
+		!strings.HasSuffix(name, jcds2PartialSuffix) && !strings.HasSuffix(name, jcds2PartialStateSuffix) && filepath.Base(name) == name && !strings.ContainsAny(name, `/\`)
+}
+
+func loadJCDS2MirrorManifest(path string) (map[string]jcds2MirrorEntry, error) {
+	manifest := map[string]jcds2MirrorEntry{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mirror manifest: %w", err)
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal mirror manifest %s: %w", path, err)
+	}
+	return manifest, nil
+}
+
+func saveJCDS2MirrorManifest(path string, manifest map[string]jcds2MirrorEntry) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,237 @@
+// jamfproapi_jcds2_download_test.go
+package jamfpro_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+// downloadTransport counts the downloads from S3, and cuts the first download short while interrupt is set.
+type downloadTransport struct {
+	base      http.RoundTripper
+	interrupt atomic.Bool
+	downloads atomic.Int32
+}
+
+func (t *downloadTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || !strings.HasSuffix(req.URL.Hostname(), ".amazonaws.com") {
+		return t.base.RoundTrip(req)
+	}
+
+	t.downloads.Add(1)
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && t.interrupt.CompareAndSwap(true, false) {
+		resp.Body = io.NopCloser(io.MultiReader(io.LimitReader(resp.Body, 1024), &failingReader{}))
+	}
+	return resp, err
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, io.ErrUnexpectedEOF }
+
+func TestDownloadJCDS2Package(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	data := bytes.Repeat([]byte("jcds"), 1024*1024)
+	srv.AddJCDSFile("Firefox.pkg", data)
+
+	transport := &downloadTransport{base: srv.Transport()}
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "packages", "Firefox.pkg")
+
+	// An interrupted download without retries leaves a partial file, which the next download resumes.
+	transport.interrupt.Store(true)
+	if _, err := client.DownloadJCDS2Package("Firefox.pkg", dest, &jamfpro.JCDS2DownloadOptions{Retries: -1}); err == nil {
+		t.Fatal("DownloadJCDS2Package() of an interrupted download without retries returned no error")
+	}
+
+	var last int64
+	result, err := client.DownloadJCDS2Package("Firefox.pkg", dest, &jamfpro.JCDS2DownloadOptions{
+		Progress: func(transferred, total int64) { last = transferred },
+	})
+	if err != nil {
+		t.Fatalf("DownloadJCDS2Package() returned error: %v", err)
+	}
+
+	if result.ResumedBytes != 1024 || result.Size != int64(len(data)) || last != int64(len(data)) {
+		t.Errorf("DownloadJCDS2Package() = %+v, last progress %d", result, last)
+	}
+	if n := transport.downloads.Load(); n != 2 {
+		t.Errorf("sent %d download requests, want the interrupted request and the resumed one", n)
+	}
+	if downloaded, err := os.ReadFile(dest); err != nil || !bytes.Equal(downloaded, data) {
+		t.Errorf("downloaded file does not match, error %v", err)
+	}
+	for _, suffix := range []string{".jcdspart", ".jcdspart.json"} {
+		if _, err := os.Stat(dest + suffix); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("%s file was not removed", suffix)
+		}
+	}
+
+	if _, err := client.DownloadJCDS2Package("Missing.pkg", dest, nil); !errors.Is(err, jamfpro.ErrNotFound) {
+		t.Errorf("DownloadJCDS2Package() of a missing file returned %v, want ErrNotFound", err)
+	}
+}
+
+func TestDownloadJCDS2PackageDiscardsStalePartialFile(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	srv.AddJCDSFile("Firefox.pkg", bytes.Repeat([]byte("old!"), 64*1024))
+	transport := &downloadTransport{base: srv.Transport()}
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "Firefox.pkg")
+	transport.interrupt.Store(true)
+	if _, err := client.DownloadJCDS2Package("Firefox.pkg", dest, &jamfpro.JCDS2DownloadOptions{Retries: -1}); err == nil {
+		t.Fatal("DownloadJCDS2Package() of an interrupted download without retries returned no error")
+	}
+
+	// The file is replaced by one of the same length, so only its MD5 tells the partial file is stale.
+	data := bytes.Repeat([]byte("new!"), 64*1024)
+	srv.AddJCDSFile("Firefox.pkg", data)
+
+	result, err := client.DownloadJCDS2Package("Firefox.pkg", dest, nil)
+	if err != nil {
+		t.Fatalf("DownloadJCDS2Package() returned error: %v", err)
+	}
+	if result.ResumedBytes != 0 {
+		t.Errorf("DownloadJCDS2Package() resumed %d bytes of a partial file of the old file", result.ResumedBytes)
+	}
+	if downloaded, err := os.ReadFile(dest); err != nil || !bytes.Equal(downloaded, data) {
+		t.Errorf("downloaded file does not match, error %v", err)
+	}
+}
+
+// shiftedRangeTransport answers range requests to S3 with a Content-Range which does not start at the
+// requested offset.
+type shiftedRangeTransport struct {
+	base http.RoundTripper
+}
+
+func (t *shiftedRangeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Range") == "" || !strings.HasSuffix(req.URL.Hostname(), ".amazonaws.com") {
+		return t.base.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Range", "bytes=0-")
+	req.Header.Del("If-Range")
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.StatusCode, resp.Status = http.StatusPartialContent, "206 Partial Content"
+	resp.Header.Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", resp.ContentLength-1, resp.ContentLength))
+	return resp, nil
+}
+
+func TestDownloadJCDS2PackageChecksContentRange(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	data := bytes.Repeat([]byte("jcds"), 64*1024)
+	srv.AddJCDSFile("Firefox.pkg", data)
+	transport := &downloadTransport{base: &shiftedRangeTransport{base: srv.Transport()}}
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "Firefox.pkg")
+	transport.interrupt.Store(true)
+	result, err := client.DownloadJCDS2Package("Firefox.pkg", dest, nil)
+	if err != nil {
+		t.Fatalf("DownloadJCDS2Package() returned error: %v", err)
+	}
+	if downloaded, err := os.ReadFile(dest); err != nil || !bytes.Equal(downloaded, data) {
+		t.Errorf("downloaded file does not match, error %v", err)
+	}
+	if n := transport.downloads.Load(); n != 3 {
+		t.Errorf("sent %d download requests, want the interrupted one, the misaligned range and a full download", n)
+	}
+	if result.Size != int64(len(data)) {
+		t.Errorf("DownloadJCDS2Package() size = %d, want %d", result.Size, len(data))
+	}
+}
+
+func TestMirrorJCDS2Packages(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	srv.AddJCDSFile("Firefox.pkg", []byte("firefox 1"))
+	srv.AddJCDSFile("Chrome.pkg", []byte("chrome 1"))
+
+	transport := &downloadTransport{base: srv.Transport()}
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	dir := t.TempDir()
+	options := &jamfpro.JCDS2MirrorOptions{Delete: true}
+
+	tests := []struct {
+		name       string
+		change     func()
+		downloaded []string
+		upToDate   []string
+		deleted    []string
+	}{
+		{"initial mirror", func() {}, []string{"Chrome.pkg", "Firefox.pkg"}, nil, nil},
+		{"unchanged", func() {}, nil, []string{"Chrome.pkg", "Firefox.pkg"}, nil},
+		{"changed file", func() { srv.AddJCDSFile("Firefox.pkg", []byte("firefox 2")) }, []string{"Firefox.pkg"}, []string{"Chrome.pkg"}, nil},
+		{"deleted file", func() {
+			if err := client.DeleteJCDS2PackageV2("Chrome.pkg"); err != nil {
+				t.Fatalf("DeleteJCDS2PackageV2() returned error: %v", err)
+			}
+		}, nil, []string{"Firefox.pkg"}, []string{"Chrome.pkg"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.change()
+			transport.downloads.Store(0)
+
+			result, err := client.MirrorJCDS2Packages(dir, options)
+			if err != nil {
+				t.Fatalf("MirrorJCDS2Packages() returned error: %v", err)
+			}
+			if !slices.Equal(result.Downloaded, tt.downloaded) || !slices.Equal(result.UpToDate, tt.upToDate) || !slices.Equal(result.Deleted, tt.deleted) {
+				t.Errorf("MirrorJCDS2Packages() = %+v, want downloaded %v, up to date %v, deleted %v",
+					result, tt.downloaded, tt.upToDate, tt.deleted)
+			}
+			if n := int(transport.downloads.Load()); n != len(tt.downloaded) {
+				t.Errorf("sent %d download requests, want %d", n, len(tt.downloaded))
+			}
+		})
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "Firefox.pkg")); err != nil || string(data) != "firefox 2" {
+		t.Errorf("mirrored Firefox.pkg = %q, error %v", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "Chrome.pkg")); !errors.Is(err, os.ErrNotExist) {
+		t.Error("deleted file is still mirrored")
+	}
+}
@@ -0,0 +1,699 @@
+// jamfproapi_jcds2_upload.go
+// Jamf Pro Api - Jamf Cloud Distribution Service (JCDS) resumable uploads
+// Packages are uploaded to the JCDS bucket as S3 multipart uploads whose progress is saved, so that an
+// interrupted upload resumes with the parts which have not yet been uploaded, and verified once complete.
+// Ref: https://docs.aws.amazon.com/AmazonS3/latest/userguide/mpuoverview.html
+
+package jamfpro
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
+)
+
+const (
+	// JCDS2DefaultPartSize is the part size of uploads which do not set one.
+	JCDS2DefaultPartSize = 64 * 1024 * 1024
+	// JCDS2MinPartSize is the smallest part size S3 accepts for every part but the last.
+	JCDS2MinPartSize = 5 * 1024 * 1024
+	// JCDS2DefaultConcurrency is the number of parts uploaded at once by uploads which do not set it.
+	JCDS2DefaultConcurrency = 4
+
+	// jcds2MaxParts is the largest number of parts in an S3 multipart upload.
+	jcds2MaxParts = 10000
+	// jcds2CredentialLifetime is how long upload credentials are used before they are renewed, well
+	// within the lifetime of the session token Jamf Pro issues.
+	jcds2CredentialLifetime = 30 * time.Minute
+)
+
+// JCDS2ProgressFunc receives the number of bytes transferred so far and the size of the file. Calls
+// are made from the transferring goroutines but never concurrently.
+type JCDS2ProgressFunc func(transferred, total int64)
+
+// JCDS2UploadOptions configures UploadJCDS2Package. The zero value uploads 64 MiB parts, four at a
+// time, and saves progress for resuming under the user's cache directory.
+type JCDS2UploadOptions struct {
+	// PartSize is the size of each part. It is raised to JCDS2MinPartSize, and as far as needed to keep
+	// the file within the S3 limit of 10,000 parts.
+	PartSize int64
+	// Concurrency is the number of parts uploaded at once.
+	Concurrency int
+	// Progress is called as bytes are uploaded, including the parts of a resumed upload which were
+	// uploaded before.
+	Progress JCDS2ProgressFunc
+	// StateDir is the directory in which the progress of uploads is saved. An upload of the same file
+	// to the same bucket, with the same part size, resumes from the saved state.
+	StateDir string
+	// DisableResume aborts a failed upload instead of saving it to be resumed.
+	DisableResume bool
+}
+
+// JCDS2UploadResult describes a verified JCDS 2.0 upload.
+type JCDS2UploadResult struct {
+	URI      string `json:"uri"`
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+	MD5      string `json:"md5"`
+	SHA512   string `json:"sha512"`
+	// ResumedBytes is the size of the parts uploaded by an earlier, interrupted, attempt.
+	ResumedBytes int64 `json:"resumedBytes"`
+}
+
+// jcds2UploadState is the saved progress of a multipart upload.
+type jcds2UploadState struct {
+	Bucket   string `json:"bucket"`
+	Key      string `json:"key"`
+	UploadID string `json:"uploadId"`
+	Size     int64  `json:"size"`
+	PartSize int64  `json:"partSize"`
+	SHA512   string `json:"sha512"`
+
+	// KMS is set when the bucket encrypts the upload with a KMS key, so part ETags are not MD5s.
+	KMS bool `json:"kms,omitempty"`
+	// PartMD5s are the hex MD5s of the parts of a KMS encrypted upload which S3 accepted, keyed by
+	// part number, against which a resumed upload checks the local file.
+	PartMD5s map[int32]string `json:"partMd5s,omitempty"`
+}
+
+// UploadJCDS2Package uploads a package file to JCDS 2.0 without creating package metadata in Jamf Pro.
+// The file is hashed, then uploaded in parts with the MD5 of each part checked by S3. If the upload is
+// interrupted its state is saved, and calling UploadJCDS2Package again with the same file resumes it.
+// Once complete, the size, MD5 and SHA-512 of the object are verified against the local file.
+//
+// Example usage:
+//
+//	result, err := client.UploadJCDS2Package("Firefox.pkg", &jamfpro.JCDS2UploadOptions{
+//		Concurrency: 8,
+//		Progress: func(transferred, total int64) {
+//			fmt.Printf("\r%d%%", transferred*100/total)
+//		},
+//	})
+func (c *Client) UploadJCDS2Package(filePath string, options *JCDS2UploadOptions) (*JCDS2UploadResult, error) {
+	c, done := c.startOperation("UploadJCDS2Package")
+	defer done()
+
+	if c.plan != nil {
+		if err := c.planJCDS2("jamfpro.UploadJCDS2Package", http.MethodPut, filePath); err != nil {
+			return nil, err
+		}
+		return &JCDS2UploadResult{FileName: filepath.Base(filePath)}, nil
+	}
+
+	var opts JCDS2UploadOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = JCDS2DefaultConcurrency
+	}
+
+	ctx := c.Context()
+	file, size, err := helpers.OpenJCDSPackageFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package file securely: %w", err)
+	}
+	defer file.Close()
+
+	fileName := filepath.Base(filePath)
+	result := &JCDS2UploadResult{FileName: fileName, Size: size}
+	result.MD5, result.SHA512, err = hashJCDS2File(ctx, io.NewSectionReader(file, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash package file: %w", err)
+	}
+
+	uploadCredentials, err := c.jcds2Credentials()
+	if err != nil {
+		return nil, err
+	}
+	s3Client := c.jcds2S3Client(uploadCredentials)
+
+	upload := &jcds2Upload{
+		client:   c,
+		s3:       s3Client,
+		file:     file,
+		size:     size,
+		partSize: jcds2PartSize(size, opts.PartSize),
+		progress: opts.Progress,
+		state: jcds2UploadState{
+			Bucket: uploadCredentials.BucketName,
+			Key:    uploadCredentials.Path + fileName,
+			Size:   size,
+			SHA512: result.SHA512,
+		},
+	}
+	upload.state.PartSize = upload.partSize
+
+	statePath := ""
+	if !opts.DisableResume {
+		statePath, err = jcds2StatePath(opts.StateDir, fileName, result.SHA512)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	completed, err := upload.start(ctx, statePath, result)
+	if err != nil {
+		return nil, err
+	}
+	result.ResumedBytes = upload.transferred.Load()
+
+	if err := upload.uploadParts(ctx, completed, opts.Concurrency); err != nil {
+		if opts.DisableResume {
+			upload.abort()
+		} else {
+			c.Logger().Warn("JCDS 2.0 upload interrupted, it will resume when uploaded again",
+				"file", fileName, "upload_id", upload.state.UploadID, "state", statePath)
+		}
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	etag, err := upload.complete(ctx, completed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete upload: %w", err)
+	}
+	if statePath != "" {
+		os.Remove(statePath)
+	}
+
+	if err := c.verifyJCDS2Upload(ctx, s3Client, upload.state, etag, result); err != nil {
+		return nil, err
+	}
+
+	result.URI = fmt.Sprintf("s3://%s/%s", upload.state.Bucket, upload.state.Key)
+	c.Logger().Info("Uploaded file to JCDS 2.0", "file", fileName, "bucket", upload.state.Bucket,
+		"size_bytes", size, "resumed_bytes", result.ResumedBytes, "sha512", result.SHA512)
+	return result, nil
+}
+
+// jcds2Upload is a multipart upload of a file in progress.
+type jcds2Upload struct {
+	client   *Client
+	s3       *s3.Client
+	file     *os.File
+	size     int64
+	partSize int64
+	state    jcds2UploadState
+
+	// statePath is where state is saved, or empty when the upload cannot be resumed.
+	statePath string
+	stateMu   sync.Mutex
+
+	progress    JCDS2ProgressFunc
+	progressMu  sync.Mutex
+	transferred atomic.Int64
+}
+
+// jcds2Part is a part of the file and its MD5.
+type jcds2Part struct {
+	number int32
+	offset int64
+	length int64
+	md5    []byte
+	etag   string
+}
+
+// parts returns the parts the file is split into.
+func (u *jcds2Upload) parts() []*jcds2Part {
+	count := (u.size + u.partSize - 1) / u.partSize
+	if count == 0 {
+		count = 1
+	}
+
+	parts := make([]*jcds2Part, count)
+	for i := range parts {
+		offset := int64(i) * u.partSize
+		parts[i] = &jcds2Part{number: int32(i + 1), offset: offset, length: min(u.partSize, u.size-offset)}
+	}
+	return parts
+}
+
+// start resumes the upload saved at statePath when it matches the file, or creates a new one, and
+// returns the parts with those which are already uploaded filled in.
+func (u *jcds2Upload) start(ctx context.Context, statePath string, result *JCDS2UploadResult) ([]*jcds2Part, error) {
+	parts := u.parts()
+	u.statePath = statePath
+
+	if saved, ok := loadJCDS2UploadState(statePath); ok && saved.Bucket == u.state.Bucket && saved.Key == u.state.Key &&
+		saved.Size == u.state.Size && saved.PartSize == u.state.PartSize && saved.SHA512 == u.state.SHA512 {
+		uploaded, err := u.listParts(ctx, saved.UploadID)
+		if err == nil {
+			u.state.UploadID, u.state.KMS, u.state.PartMD5s = saved.UploadID, saved.KMS, saved.PartMD5s
+			if err := u.resumeParts(ctx, parts, uploaded); err != nil {
+				return nil, err
+			}
+			u.client.Logger().Info("Resuming JCDS 2.0 upload", "file", result.FileName, "upload_id", saved.UploadID,
+				"resumed_bytes", u.transferred.Load(), "size_bytes", u.size)
+			return parts, nil
+		}
+		u.client.Logger().Warn("Saved JCDS 2.0 upload cannot be resumed, starting again",
+			"file", result.FileName, "upload_id", saved.UploadID, "error", err)
+	}
+
+	created, err := u.s3.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:   aws.String(u.state.Bucket),
+		Key:      aws.String(u.state.Key),
+		Metadata: map[string]string{"md5": result.MD5, "sha512": result.SHA512},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+	u.state.UploadID = aws.ToString(created.UploadId)
+	u.state.KMS = created.ServerSideEncryption == types.ServerSideEncryptionAwsKms
+
+	if statePath != "" {
+		if err := saveJCDS2UploadState(statePath, u.state); err != nil {
+			u.client.Logger().Warn("Failed to save JCDS 2.0 upload state, the upload cannot be resumed",
+				"file", result.FileName, "state", statePath, "error", err)
+		}
+	}
+	return parts, nil
+}
+
+// listParts returns the ETag of every uploaded part, keyed by part number, and their sizes.
+func (u *jcds2Upload) listParts(ctx context.Context, uploadID string) (map[int32]types.Part, error) {
+	uploaded := map[int32]types.Part{}
+	paginator := s3.NewListPartsPaginator(u.s3, &s3.ListPartsInput{
+		Bucket:   aws.String(u.state.Bucket),
+		Key:      aws.String(u.state.Key),
+		UploadId: aws.String(uploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range page.Parts {
+			uploaded[aws.ToInt32(part.PartNumber)] = part
+		}
+	}
+	return uploaded, nil
+}
+
+// resumeParts marks the parts whose uploaded size and MD5 match the file as complete. The MD5 of a
+// part is its ETag, except in uploads encrypted with KMS keys, whose part MD5s are taken from the
+// saved state instead.
+func (u *jcds2Upload) resumeParts(ctx context.Context, parts []*jcds2Part, uploaded map[int32]types.Part) error {
+	for _, part := range parts {
+		existing, ok := uploaded[part.number]
+		if !ok || aws.ToInt64(existing.Size) != part.length {
+			continue
+		}
+
+		uploadedMD5 := strings.Trim(aws.ToString(existing.ETag), `"`)
+		if u.state.KMS {
+			uploadedMD5 = u.state.PartMD5s[part.number]
+		}
+		if uploadedMD5 == "" {
+			continue
+		}
+
+		sum, err := hashJCDS2Part(ctx, u.file, part)
+		if err != nil {
+			return err
+		}
+		if strings.EqualFold(uploadedMD5, hex.EncodeToString(sum)) {
+			part.md5, part.etag = sum, aws.ToString(existing.ETag)
+			u.report(part.length)
+		}
+	}
+	return nil
+}
+
+// uploadParts uploads every part which is not yet complete, concurrency at a time. The first failure
+// cancels the parts in flight.
+func (u *jcds2Upload) uploadParts(ctx context.Context, parts []*jcds2Part, concurrency int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	queue := make(chan *jcds2Part)
+	errs := make(chan error, concurrency)
+
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for part := range queue {
+				if err := u.uploadPart(ctx, part); err != nil {
+					errs <- fmt.Errorf("part %d: %w", part.number, err)
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+queueParts:
+	for _, part := range parts {
+		if part.etag != "" {
+			continue
+		}
+		select {
+		case queue <- part:
+		case <-ctx.Done():
+			break queueParts
+		}
+	}
+	close(queue)
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// uploadPart uploads a single part with its MD5, so that S3 rejects a corrupted part.
+func (u *jcds2Upload) uploadPart(ctx context.Context, part *jcds2Part) error {
+	sum, err := hashJCDS2Part(ctx, u.file, part)
+	if err != nil {
+		return err
+	}
+
+	body := &jcds2ProgressReader{ReadSeeker: io.NewSectionReader(u.file, part.offset, part.length), report: u.report}
+	out, err := u.s3.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(u.state.Bucket),
+		Key:           aws.String(u.state.Key),
+		UploadId:      aws.String(u.state.UploadID),
+		PartNumber:    aws.Int32(part.number),
+		Body:          body,
+		ContentLength: aws.Int64(part.length),
+		ContentMD5:    aws.String(base64.StdEncoding.EncodeToString(sum)),
+	})
+	if err != nil {
+		u.report(-body.read)
+		return err
+	}
+
+	part.md5, part.etag = sum, aws.ToString(out.ETag)
+	u.recordPart(part)
+	return nil
+}
+
+// recordPart saves the MD5 of an uploaded part of a KMS encrypted upload with its state, as S3 does
+// not report it. S3 checked the part against the MD5 when it was uploaded.
+func (u *jcds2Upload) recordPart(part *jcds2Part) {
+	if !u.state.KMS || u.statePath == "" {
+		return
+	}
+
+	u.stateMu.Lock()
+	defer u.stateMu.Unlock()
+	if u.state.PartMD5s == nil {
+		u.state.PartMD5s = map[int32]string{}
+	}
+	u.state.PartMD5s[part.number] = hex.EncodeToString(part.md5)
+	if err := saveJCDS2UploadState(u.statePath, u.state); err != nil {
+		u.client.Logger().Warn("Failed to save JCDS 2.0 upload state, the part will be uploaded again on resume",
+			"key", u.state.Key, "part", part.number, "error", err)
+	}
+}
+
+// complete completes the upload and returns the ETag S3 should report for the object.
+func (u *jcds2Upload) complete(ctx context.Context, parts []*jcds2Part) (string, error) {
+	completed := make([]types.CompletedPart, len(parts))
+	sums := make([]byte, 0, len(parts)*md5.Size)
+	for i, part := range parts {
+		completed[i] = types.CompletedPart{ETag: aws.String(part.etag), PartNumber: aws.Int32(part.number)}
+		sums = append(sums, part.md5...)
+	}
+	sort.Slice(completed, func(i, j int) bool { return *completed[i].PartNumber < *completed[j].PartNumber })
+
+	_, err := u.s3.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.state.Bucket),
+		Key:             aws.String(u.state.Key),
+		UploadId:        aws.String(u.state.UploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The ETag of a multipart object is the MD5 of the MD5s of its parts, followed by the part count.
+	sum := md5.Sum(sums)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), len(parts)), nil
+}
+
+// abort aborts the upload, discarding the uploaded parts. It runs even if the client's context is done.
+func (u *jcds2Upload) abort() {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(u.client.Context()), time.Minute)
+	defer cancel()
+
+	_, err := u.s3.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(u.state.Bucket),
+		Key:      aws.String(u.state.Key),
+		UploadId: aws.String(u.state.UploadID),
+	})
+	if err != nil {
+		u.client.Logger().Warn("Failed to abort JCDS 2.0 upload", "key", u.state.Key, "upload_id", u.state.UploadID, "error", err)
+	}
+}
+
+// report adds n bytes to the transferred total and passes it to the progress callback.
+func (u *jcds2Upload) report(n int64) {
+	transferred := u.transferred.Add(n)
+	if u.progress == nil || n == 0 {
+		return
+	}
+
+	u.progressMu.Lock()
+	defer u.progressMu.Unlock()
+	u.progress(transferred, u.size)
+}
+
+// verifyJCDS2Upload checks the uploaded object against the local file: its size, its ETag, which is
+// derived from the MD5 of each part, and the hashes stored in its metadata. When Jamf Pro already lists
+// the file, its length and MD5 are checked as well.
+func (c *Client) verifyJCDS2Upload(ctx context.Context, s3Client *s3.Client, state jcds2UploadState, etag string, result *JCDS2UploadResult) error {
+	head, err := s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(state.Bucket),
+		Key:    aws.String(state.Key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify upload: %w", err)
+	}
+
+	var mismatches []string
+	if size := aws.ToInt64(head.ContentLength); size != result.Size {
+		mismatches = append(mismatches, fmt.Sprintf("size is %d bytes, expected %d", size, result.Size))
+	}
+	// Objects encrypted with KMS keys do not have MD5 based ETags.
+	if got := strings.Trim(aws.ToString(head.ETag), `"`); head.ServerSideEncryption != types.ServerSideEncryptionAwsKms && got != etag {
+		mismatches = append(mismatches, fmt.Sprintf("ETag is %s, expected %s", got, etag))
+	}
+	if got := head.Metadata["sha512"]; got != result.SHA512 {
+		mismatches = append(mismatches, fmt.Sprintf("SHA-512 is %q, expected %s", got, result.SHA512))
+	}
+
+	files, err := c.WithContext(ctx).GetJCDS2Packages()
+	if err != nil {
+		return fmt.Errorf("failed to verify upload: %w", err)
+	}
+	for _, file := range files {
+		if file.FileName != result.FileName {
+			continue
+		}
+		if file.Length != result.Size {
+			mismatches = append(mismatches, fmt.Sprintf("Jamf Pro reports %d bytes, expected %d", file.Length, result.Size))
+		}
+		if file.MD5 != "" && !strings.EqualFold(file.MD5, result.MD5) {
+			mismatches = append(mismatches, fmt.Sprintf("Jamf Pro reports MD5 %s, expected %s", file.MD5, result.MD5))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("uploaded file %s does not match the local file: %s", result.FileName, strings.Join(mismatches, "; "))
+	}
+	return nil
+}
+
+// jcds2Credentials obtains upload credentials for the JCDS bucket.
+func (c *Client) jcds2Credentials() (*ResponseJCDS2UploadCredentials, error) {
+	var uploadCredentials ResponseJCDS2UploadCredentials
+	resp, err := c.doRequest("POST", uriJCDS2+"/files", nil, &uploadCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain upload credentials: %w", err)
+	}
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if uploadCredentials.Region == "" || uploadCredentials.BucketName == "" || uploadCredentials.Path == "" {
+		return nil, fmt.Errorf("incomplete upload credentials received")
+	}
+	return &uploadCredentials, nil
+}
+
+// jcds2S3Client returns an S3 client for the JCDS bucket which sends requests with the client's http
+// client and transport, and renews its credentials through Jamf Pro before they expire.
+func (c *Client) jcds2S3Client(uploadCredentials *ResponseJCDS2UploadCredentials) *s3.Client {
+	return s3.New(s3.Options{
+		Region:      uploadCredentials.Region,
+		Credentials: aws.NewCredentialsCache(&jcds2CredentialsProvider{client: c, initial: uploadCredentials}),
+		HTTPClient:  buildHTTPClient(&c.config),
+	})
+}
+
+// jcds2CredentialsProvider provides the credentials issued with an upload, then renewed credentials.
+type jcds2CredentialsProvider struct {
+	client  *Client
+	mu      sync.Mutex
+	initial *ResponseJCDS2UploadCredentials
+}
+
+func (p *jcds2CredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	p.mu.Lock()
+	uploadCredentials := p.initial
+	p.initial = nil
+	p.mu.Unlock()
+
+	if uploadCredentials == nil {
+		renewed, err := p.client.WithContext(ctx).RenewJCDS2Credentials()
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+		uploadCredentials = renewed
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     uploadCredentials.AccessKeyID,
+		SecretAccessKey: uploadCredentials.SecretAccessKey,
+		SessionToken:    uploadCredentials.SessionToken,
+		Source:          "JamfProJCDS2",
+		CanExpire:       true,
+		Expires:         time.Now().Add(jcds2CredentialLifetime),
+	}, nil
+}
+
+// jcds2ProgressReader reports the bytes read from a part body, and takes them back when the AWS SDK
+// rewinds the body to retry.
+type jcds2ProgressReader struct {
+	io.ReadSeeker
+	read   int64
+	report func(n int64)
+}
+
+func (r *jcds2ProgressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadSeeker.Read(p)
+	r.read += int64(n)
+	r.report(int64(n))
+	return n, err
+}
+
+func (r *jcds2ProgressReader) Seek(offset int64, whence int) (int64, error) {
+	position, err := r.ReadSeeker.Seek(offset, whence)
+	if err == nil && position != r.read {
+		r.report(position - r.read)
+		r.read = position
+	}
+	return position, err
+}
+
+// jcds2PartSize returns the part size for a file of size bytes.
+func jcds2PartSize(size, partSize int64) int64 {
+	if partSize <= 0 {
+		partSize = JCDS2DefaultPartSize
+	}
+	partSize = max(partSize, JCDS2MinPartSize)
+	if size > partSize*jcds2MaxParts {
+		partSize = (size + jcds2MaxParts - 1) / jcds2MaxParts
+	}
+	return partSize
+}
+
+// hashJCDS2File returns the hex encoded MD5 and SHA-512 of r.
+func hashJCDS2File(ctx context.Context, r io.Reader) (string, string, error) {
+	md5Hash, sha512Hash := md5.New(), sha512.New()
+	if err := copyWithContext(ctx, io.MultiWriter(md5Hash, sha512Hash), r); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(md5Hash.Sum(nil)), hex.EncodeToString(sha512Hash.Sum(nil)), nil
+}
+
+// hashJCDS2Part returns the MD5 of a part of file.
+func hashJCDS2Part(ctx context.Context, file *os.File, part *jcds2Part) ([]byte, error) {
+	h := md5.New()
+	if err := copyWithContext(ctx, h, io.NewSectionReader(file, part.offset, part.length)); err != nil {
+		return nil, fmt.Errorf("failed to hash part %d: %w", part.number, err)
+	}
+	return h.Sum(nil), nil
+}
+
+// copyWithContext copies src to dst, stopping when ctx is done.
+func copyWithContext(ctx context.Context, dst io.Writer, src io.Reader) error {
+	buf := make([]byte, 1024*1024)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, err := src.Read(buf)
+		if n > 0 {
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// jcds2StatePath returns the path of the saved state of an upload of a file with the given name and
+// SHA-512, under dir or the user's cache directory.
+func jcds2StatePath(dir, fileName, sha512Hex string) (string, error) {
+	if dir == "" {
+		cache, err := os.UserCacheDir()
+		if err != nil {
+			cache = os.TempDir()
+		}
+		dir = filepath.Join(cache, "go-api-sdk-jamfpro", "jcds2-uploads")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create upload state directory: %w", err)
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.json", fileName, sha512Hex[:16])), nil
+}
+
+func loadJCDS2UploadState(path string) (jcds2UploadState, bool) {
+	var state jcds2UploadState
+	if path == "" {
+		return state, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || json.Unmarshal(data, &state) != nil || state.UploadID == "" {
+		return state, false
+	}
+	return state, true
+}
+
+func saveJCDS2UploadState(path string, state jcds2UploadState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
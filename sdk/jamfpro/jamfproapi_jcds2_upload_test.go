@@ -0,0 +1,208 @@
+// jamfproapi_jcds2_upload_test.go
+package jamfpro_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+// partTransport counts the parts uploaded to S3, and rejects part failPart while fail is set.
+type partTransport struct {
+	base     http.RoundTripper
+	failPart string
+	fail     atomic.Bool
+	parts    atomic.Int32
+}
+
+func (t *partTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	part := req.URL.Query().Get("partNumber")
+	if part == "" || !strings.HasSuffix(req.URL.Hostname(), ".amazonaws.com") {
+		return t.base.RoundTrip(req)
+	}
+
+	if t.fail.Load() && part == t.failPart {
+		return &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{"Content-Type": {"application/xml"}},
+			Body:       io.NopCloser(strings.NewReader("<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>")),
+			Request:    req,
+		}, nil
+	}
+	t.parts.Add(1)
+	return t.base.RoundTrip(req)
+}
+
+// writePackage writes size random bytes to a package file and returns its path.
+func writePackage(t *testing.T, name string, size int) (string, []byte) {
+	t.Helper()
+
+	data := make([]byte, size)
+	rand.Read(data)
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path, data
+}
+
+func TestUploadJCDS2Package(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+
+	path, data := writePackage(t, "Firefox.pkg", 12*1024*1024)
+
+	var mu sync.Mutex
+	var last int64
+	result, err := client.UploadJCDS2Package(path, &jamfpro.JCDS2UploadOptions{
+		PartSize:    jamfpro.JCDS2MinPartSize,
+		Concurrency: 2,
+		StateDir:    t.TempDir(),
+		Progress: func(transferred, total int64) {
+			mu.Lock()
+			defer mu.Unlock()
+			if total != int64(len(data)) || transferred > total {
+				t.Errorf("progress %d of %d", transferred, total)
+			}
+			last = transferred
+		},
+	})
+	if err != nil {
+		t.Fatalf("UploadJCDS2Package() returned error: %v", err)
+	}
+
+	sum := sha512.Sum512(data)
+	if result.SHA512 != hex.EncodeToString(sum[:]) || result.Size != int64(len(data)) || result.ResumedBytes != 0 {
+		t.Errorf("UploadJCDS2Package() = %+v", result)
+	}
+	if result.URI != "s3://"+jamfprotest.JCDSBucket+"/"+jamfprotest.JCDSPath+"Firefox.pkg" {
+		t.Errorf("URI = %s", result.URI)
+	}
+	if last != int64(len(data)) {
+		t.Errorf("last progress = %d, want %d", last, len(data))
+	}
+	if uploaded, ok := srv.JCDSFile("Firefox.pkg"); !ok || !bytes.Equal(uploaded, data) {
+		t.Error("uploaded file does not match the local file")
+	}
+
+	// CreateJCDS2PackageV2 uploads without saving state to resume from.
+	small, _ := writePackage(t, "Chrome.pkg", 1024)
+	if _, err := client.CreateJCDS2PackageV2(small); err != nil {
+		t.Fatalf("CreateJCDS2PackageV2() returned error: %v", err)
+	}
+	if _, ok := srv.JCDSFile("Chrome.pkg"); !ok {
+		t.Error("CreateJCDS2PackageV2() did not upload the file")
+	}
+}
+
+func TestUploadJCDS2PackageResume(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	transport := &partTransport{base: srv.Transport(), failPart: "3"}
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	path, data := writePackage(t, "Xcode.pkg", 12*1024*1024)
+	stateDir := t.TempDir()
+	options := &jamfpro.JCDS2UploadOptions{PartSize: jamfpro.JCDS2MinPartSize, Concurrency: 1, StateDir: stateDir}
+
+	transport.fail.Store(true)
+	if _, err := client.UploadJCDS2Package(path, options); err == nil {
+		t.Fatal("UploadJCDS2Package() returned no error when a part was rejected")
+	}
+	if n := srv.JCDSUploads(); n != 1 {
+		t.Fatalf("server holds %d multipart uploads, want the interrupted upload", n)
+	}
+	if states, _ := os.ReadDir(stateDir); len(states) != 1 {
+		t.Fatalf("state directory holds %d files, want 1", len(states))
+	}
+
+	transport.fail.Store(false)
+	transport.parts.Store(0)
+	result, err := client.UploadJCDS2Package(path, options)
+	if err != nil {
+		t.Fatalf("resumed UploadJCDS2Package() returned error: %v", err)
+	}
+	if n := transport.parts.Load(); n != 1 {
+		t.Errorf("resumed upload sent %d parts, want only the rejected part", n)
+	}
+	if result.ResumedBytes != 2*jamfpro.JCDS2MinPartSize {
+		t.Errorf("ResumedBytes = %d, want %d", result.ResumedBytes, 2*jamfpro.JCDS2MinPartSize)
+	}
+	if uploaded, ok := srv.JCDSFile("Xcode.pkg"); !ok || !bytes.Equal(uploaded, data) {
+		t.Error("resumed file does not match the local file")
+	}
+	if n := srv.JCDSUploads(); n != 0 {
+		t.Errorf("server holds %d multipart uploads after completion", n)
+	}
+	if states, _ := os.ReadDir(stateDir); len(states) != 0 {
+		t.Errorf("state directory holds %d files after completion", len(states))
+	}
+
+	// Without resume, a failed upload is aborted.
+	transport.fail.Store(true)
+	options.DisableResume = true
+	if _, err := client.UploadJCDS2Package(path, options); err == nil {
+		t.Fatal("UploadJCDS2Package() returned no error when a part was rejected")
+	}
+	if n := srv.JCDSUploads(); n != 0 {
+		t.Errorf("server holds %d multipart uploads, want the failed upload aborted", n)
+	}
+}
+
+func TestUploadJCDS2PackageResumeKMS(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+	srv.SetJCDSKMSEncryption(true)
+
+	transport := &partTransport{base: srv.Transport(), failPart: "3"}
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	path, data := writePackage(t, "Xcode.pkg", 12*1024*1024)
+	options := &jamfpro.JCDS2UploadOptions{PartSize: jamfpro.JCDS2MinPartSize, Concurrency: 1, StateDir: t.TempDir()}
+
+	transport.fail.Store(true)
+	if _, err := client.UploadJCDS2Package(path, options); err == nil {
+		t.Fatal("UploadJCDS2Package() returned no error when a part was rejected")
+	}
+
+	// The part ETags are not MD5s, so the parts are checked against the MD5s in the saved state.
+	transport.fail.Store(false)
+	transport.parts.Store(0)
+	result, err := client.UploadJCDS2Package(path, options)
+	if err != nil {
+		t.Fatalf("resumed UploadJCDS2Package() returned error: %v", err)
+	}
+	if n := transport.parts.Load(); n != 1 {
+		t.Errorf("resumed upload sent %d parts, want only the rejected part", n)
+	}
+	if result.ResumedBytes != 2*jamfpro.JCDS2MinPartSize {
+		t.Errorf("ResumedBytes = %d, want %d", result.ResumedBytes, 2*jamfpro.JCDS2MinPartSize)
+	}
+	if uploaded, ok := srv.JCDSFile("Xcode.pkg"); !ok || !bytes.Equal(uploaded, data) {
+		t.Error("resumed file does not match the local file")
+	}
+}
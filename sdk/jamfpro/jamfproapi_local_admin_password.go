@@ -19,9 +19,12 @@ type ResourceLocalAdminPasswordSettings struct {
 
 // GetLocalAdminPasswordSettings retrieves current Jamf Pro LAPS settings
 func (c *Client) GetLocalAdminPasswordSettings() (*ResourceLocalAdminPasswordSettings, error) {
+	c, done := c.startOperation("GetLocalAdminPasswordSettings")
+	defer done()
+
 	endpoint := uriLocalAdminPassword + "/settings"
 	var out ResourceLocalAdminPasswordSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "LAPS settings", err)
 	}
@@ -35,11 +38,14 @@ func (c *Client) GetLocalAdminPasswordSettings() (*ResourceLocalAdminPasswordSet
 
 // UpdateLocalAdminPasswordSettings updates the current Jamf Pro LAPS settings
 func (c *Client) UpdateLocalAdminPasswordSettings(settings *ResourceLocalAdminPasswordSettings) error {
+	c, done := c.startOperation("UpdateLocalAdminPasswordSettings")
+	defer done()
+
 	endpoint := uriLocalAdminPassword + "/settings"
 
 	var handleResponse struct{}
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &settings, &handleResponse)
+	resp, err := c.doRequest("PUT", endpoint, &settings, &handleResponse)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedUpdate, "LAPS settings", err)
 	}
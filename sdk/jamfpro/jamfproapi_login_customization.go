@@ -37,15 +37,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(customization)
 
 Errors: Returns an error if the request fails.
 */
 func (c *Client) GetLoginCustomization() (*ResourceLoginCustomization, error) {
+	c, done := c.startOperation("GetLoginCustomization")
+	defer done()
+
 	endpoint := uriLoginCustomization
 
 	var loginCustomization ResourceLoginCustomization
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &loginCustomization)
+	resp, err := c.doRequest("GET", endpoint, nil, &loginCustomization)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "login customization", err)
 	}
@@ -79,15 +81,17 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(updated)
 
 Errors: Returns an error if the request fails or if the resource cannot be updated.
 */
 func (c *Client) UpdateLoginCustomization(loginCustomizationUpdate *ResourceLoginCustomization) (*ResourceLoginCustomization, error) {
+	c, done := c.startOperation("UpdateLoginCustomization")
+	defer done()
+
 	endpoint := uriLoginCustomization
 
 	var updatedLoginCustomization ResourceLoginCustomization
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, loginCustomizationUpdate, &updatedLoginCustomization)
+	resp, err := c.doRequest("PUT", endpoint, loginCustomizationUpdate, &updatedLoginCustomization)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "login customization", err)
 	}
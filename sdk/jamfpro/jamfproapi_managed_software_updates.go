@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriManagedSoftwareUpdates = "/api/v1/managed-software-updates"
@@ -160,10 +158,13 @@ type ResourceManagedSoftwareUpdatePlan struct {
 
 // GetManagedSoftwareUpdates retrieves a list of all available managed software updates
 func (c *Client) GetManagedSoftwareUpdates() (*ResponseManagedSoftwareUpdateList, error) {
+	c, done := c.startOperation("GetManagedSoftwareUpdates")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/available-updates", uriManagedSoftwareUpdates)
 
 	var updateList ResponseManagedSoftwareUpdateList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &updateList)
+	resp, err := c.doRequest("GET", endpoint, nil, &updateList)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "self service settings", err)
 	}
@@ -176,29 +177,21 @@ func (c *Client) GetManagedSoftwareUpdates() (*ResponseManagedSoftwareUpdateList
 }
 
 // GetManagedSoftwareUpdatePlans retrieves a list of all available managed software updates
-func (c *Client) GetManagedSoftwareUpdatePlans(sort_filter string) (*ResponseManagedSoftwareUpdatePlanList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriManagedSoftwareUpdates+"/plans",
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
-
+//
+// opts filter and sort the plans by fields such as planUuid, device.deviceId or
+// device.objectType, for example jamfpro.Where(rsql.Eq("device.objectType", "COMPUTER")).
+func (c *Client) GetManagedSoftwareUpdatePlans(sort_filter string, opts ...ListOption) (*ResponseManagedSoftwareUpdatePlanList, error) {
+	c, done := c.startOperation("GetManagedSoftwareUpdatePlans")
+	defer done()
+
+	resp, err := NewPaginator[ResourceManagedSoftwareUpdatePlanList](c, uriManagedSoftwareUpdates+"/plans").RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "managed software update plans", err)
 	}
 
 	var out ResponseManagedSoftwareUpdatePlanList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceManagedSoftwareUpdatePlanList
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "script", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 
@@ -206,12 +199,15 @@ func (c *Client) GetManagedSoftwareUpdatePlans(sort_filter string) (*ResponseMan
 
 // CreateManagedSoftwareUpdatePlanByDeviceID Creates Managed Software Update Plan by Device ID
 func (c *Client) CreateManagedSoftwareUpdatePlanByDeviceID(plan *ResourceCreateManagedSoftwareUpdatePlan) (*ResponseManagedSoftwareUpdatePlanCreate, error) {
+	c, done := c.startOperation("CreateManagedSoftwareUpdatePlanByDeviceID")
+	defer done()
+
 	endpoint := uriManagedSoftwareUpdates + "/plans"
 	var responseManagedSoftwareUpdatePlanCreate ResponseManagedSoftwareUpdatePlanCreate
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, plan, &responseManagedSoftwareUpdatePlanCreate)
+	resp, err := c.doRequest("POST", endpoint, plan, &responseManagedSoftwareUpdatePlanCreate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create managed software update plan: %v", err)
+		return nil, fmt.Errorf("failed to create managed software update plan: %w", err)
 	}
 
 	if resp != nil {
@@ -223,10 +219,13 @@ func (c *Client) CreateManagedSoftwareUpdatePlanByDeviceID(plan *ResourceCreateM
 
 // GetManagedSoftwareUpdateFeatureToggle retrieves the current managed software update feature toggle settings
 func (c *Client) GetManagedSoftwareUpdateFeatureToggle() (*ResourceManagedSoftwareUpdateFeatureToggle, error) {
+	c, done := c.startOperation("GetManagedSoftwareUpdateFeatureToggle")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/feature-toggle", uriManagedSoftwareUpdates)
 
 	var featureToggle ResourceManagedSoftwareUpdateFeatureToggle
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &featureToggle)
+	resp, err := c.doRequest("GET", endpoint, nil, &featureToggle)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "managed software update feature toggle", err)
 	}
@@ -240,12 +239,15 @@ func (c *Client) GetManagedSoftwareUpdateFeatureToggle() (*ResourceManagedSoftwa
 
 // UpdateManagedSoftwareUpdateFeatureToggle updates the feature toggle for managed software updates.
 func (c *Client) UpdateManagedSoftwareUpdateFeatureToggle(payload *ResourceManagedSoftwareUpdateFeatureToggle) (*ResponseManagedSoftwareUpdateFeatureToggle, error) {
+	c, done := c.startOperation("UpdateManagedSoftwareUpdateFeatureToggle")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/feature-toggle", uriManagedSoftwareUpdates)
 	var response ResponseManagedSoftwareUpdateFeatureToggle
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, payload, &response)
+	resp, err := c.doRequest("PUT", endpoint, payload, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update managed software update feature toggle: %v", err)
+		return nil, fmt.Errorf("failed to update managed software update feature toggle: %w", err)
 	}
 
 	if resp != nil {
@@ -258,12 +260,15 @@ func (c *Client) UpdateManagedSoftwareUpdateFeatureToggle(payload *ResourceManag
 // ForceStopManagedSoftwareUpdateFeatureToggleProcess forcefully stops any ongoing or stalled feature-toggle processes.
 // This "Break Glass" endpoint should not be used under nominal conditions.
 func (c *Client) ForceStopManagedSoftwareUpdateFeatureToggleProcess() (*SharedResourcResponseError, error) {
+	c, done := c.startOperation("ForceStopManagedSoftwareUpdateFeatureToggleProcess")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/feature-toggle/abandon", uriManagedSoftwareUpdates)
 	var responseError SharedResourcResponseError
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &responseError)
+	resp, err := c.doRequest("POST", endpoint, nil, &responseError)
 	if err != nil {
-		return nil, fmt.Errorf("failed to forcefully abandon feature-toggle process: %v", err)
+		return nil, fmt.Errorf("failed to forcefully abandon feature-toggle process: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -275,12 +280,15 @@ func (c *Client) ForceStopManagedSoftwareUpdateFeatureToggleProcess() (*SharedRe
 
 // GetManagedSoftwareUpdatePlansFeatureToggleStatus retrieves the background status of the Feature Toggle.
 func (c *Client) GetManagedSoftwareUpdatePlansFeatureToggleStatus() (*ResponseManagedSoftwareUpdatePlansFeatureToggleStatus, error) {
+	c, done := c.startOperation("GetManagedSoftwareUpdatePlansFeatureToggleStatus")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/feature-toggle/status", uriManagedSoftwareUpdates)
 
 	var status ResponseManagedSoftwareUpdatePlansFeatureToggleStatus
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &status)
+	resp, err := c.doRequest("GET", endpoint, nil, &status)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve feature toggle status: %v", err)
+		return nil, fmt.Errorf("failed to retrieve feature toggle status: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -292,12 +300,15 @@ func (c *Client) GetManagedSoftwareUpdatePlansFeatureToggleStatus() (*ResponseMa
 
 // CreateManagedSoftwareUpdatePlanByDeviceGroupID creates a managed software update plan by group ID
 func (c *Client) CreateManagedSoftwareUpdatePlanByGroupID(plan *ResourceCreateManagedSoftwareUpdatePlan) (*ResponseManagedSoftwareUpdatePlanCreate, error) {
+	c, done := c.startOperation("CreateManagedSoftwareUpdatePlanByGroupID")
+	defer done()
+
 	endpoint := uriManagedSoftwareUpdates + "/plans/group"
 	var responseManagedSoftwareUpdatePlanCreate ResponseManagedSoftwareUpdatePlanCreate
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, plan, &responseManagedSoftwareUpdatePlanCreate)
+	resp, err := c.doRequest("POST", endpoint, plan, &responseManagedSoftwareUpdatePlanCreate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create managed software update plan: %v", err)
+		return nil, fmt.Errorf("failed to create managed software update plan: %w", err)
 	}
 
 	if resp != nil {
@@ -309,12 +320,15 @@ func (c *Client) CreateManagedSoftwareUpdatePlanByGroupID(plan *ResourceCreateMa
 
 // GetManagedSoftwareUpdatePlansByGroupID retrieves managed software update plans for a specific group ID.
 func (c *Client) GetManagedSoftwareUpdatePlansByGroupID(groupId string, groupType string) (*ResponseManagedSoftwareUpdatePlanList, error) {
+	c, done := c.startOperation("GetManagedSoftwareUpdatePlansByGroupID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/group/%s?group-type=%s", uriManagedSoftwareUpdates, groupId, groupType)
 
 	var responseManagedSoftwareUpdatePlanList ResponseManagedSoftwareUpdatePlanList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseManagedSoftwareUpdatePlanList)
+	resp, err := c.doRequest("GET", endpoint, nil, &responseManagedSoftwareUpdatePlanList)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get managed software update plans: %v", err)
+		return nil, fmt.Errorf("failed to get managed software update plans: %w", err)
 	}
 
 	if resp != nil {
@@ -326,12 +340,15 @@ func (c *Client) GetManagedSoftwareUpdatePlansByGroupID(groupId string, groupTyp
 
 // GetManagedSoftwareUpdatePlanByUUID retrieves a Managed Software Update Plan by its UUID.
 func (c *Client) GetManagedSoftwareUpdatePlanByUUID(UUID string) (*ResourceManagedSoftwareUpdatePlan, error) {
+	c, done := c.startOperation("GetManagedSoftwareUpdatePlanByUUID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/%s", uriManagedSoftwareUpdates, UUID)
 
 	var planDetail ResourceManagedSoftwareUpdatePlan
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &planDetail)
+	resp, err := c.doRequest("GET", endpoint, nil, &planDetail)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve managed software update plan with ID %s: %v", UUID, err)
+		return nil, fmt.Errorf("failed to retrieve managed software update plan with ID %s: %w", UUID, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -343,12 +360,15 @@ func (c *Client) GetManagedSoftwareUpdatePlanByUUID(UUID string) (*ResourceManag
 
 // GetDeclarationsByManagedSoftwareUpdatePlanUUID retrieves all Declarations associated with a Managed Software Update Plan by its UUID.
 func (c *Client) GetDeclarationsByManagedSoftwareUpdatePlanUUID(UUID string) (*ResponseDeclarationsList, error) {
+	c, done := c.startOperation("GetDeclarationsByManagedSoftwareUpdatePlanUUID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/plans/%s/declarations", uriManagedSoftwareUpdates, UUID)
 
 	var declarationsList ResponseDeclarationsList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &declarationsList)
+	resp, err := c.doRequest("GET", endpoint, nil, &declarationsList)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve declarations for managed software update plan with ID %s: %v", UUID, err)
+		return nil, fmt.Errorf("failed to retrieve declarations for managed software update plan with ID %s: %w", UUID, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -157,10 +157,13 @@ type ResponseMDMProfileRenewal struct {
 
 // SendMDMCommandForCreationAndQueuing sends an MDM command for creation and queuing
 func (c *Client) SendMDMCommandForCreationAndQueuing(MDMCommand *ResourceMDMCommandRequest) (*ResponseMDMCommand, error) {
+	c, done := c.startOperation("SendMDMCommandForCreationAndQueuing")
+	defer done()
+
 	endpoint := uriMDMCommands
 	var responseMDMCommand ResponseMDMCommand
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, MDMCommand, &responseMDMCommand)
+	resp, err := c.doRequest("POST", endpoint, MDMCommand, &responseMDMCommand)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "send MDM Command", err)
 	}
@@ -174,12 +177,15 @@ func (c *Client) SendMDMCommandForCreationAndQueuing(MDMCommand *ResourceMDMComm
 
 // SendMDMCommandForPackageDeployment deploys a package using an MDM command
 func (c *Client) SendMDMCommandForPackageDeployment(deployPackageRequest *ResourceDeployPackage) (*ResponseDeployPackage, error) {
+	c, done := c.startOperation("SendMDMCommandForPackageDeployment")
+	defer done()
+
 	endpoint := uriMDMDeployPackage + "?verbose=true"
 	var responseDeployPackage ResponseDeployPackage
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, deployPackageRequest, &responseDeployPackage)
+	resp, err := c.doRequest("POST", endpoint, deployPackageRequest, &responseDeployPackage)
 	if err != nil {
-		return nil, fmt.Errorf("failed to deploy package: %v", err)
+		return nil, fmt.Errorf("failed to deploy package: %w", err)
 	}
 
 	if resp != nil {
@@ -191,12 +197,15 @@ func (c *Client) SendMDMCommandForPackageDeployment(deployPackageRequest *Resour
 
 // SendMDMCommandForMDMProfileRenewal renews MDM profiles for specified UDIDs
 func (c *Client) SendMDMCommandForMDMProfileRenewal(renewProfileRequest *ResourceMDMProfileRenewal) (*ResponseMDMProfileRenewal, error) {
+	c, done := c.startOperation("SendMDMCommandForMDMProfileRenewal")
+	defer done()
+
 	endpoint := uriMDMProfileRenewal
 	var responseMDMProfileRenewal ResponseMDMProfileRenewal
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, renewProfileRequest, &responseMDMProfileRenewal)
+	resp, err := c.doRequest("POST", endpoint, renewProfileRequest, &responseMDMProfileRenewal)
 	if err != nil {
-		return nil, fmt.Errorf("failed to renew MDM profile: %v", err)
+		return nil, fmt.Errorf("failed to renew MDM profile: %w", err)
 	}
 
 	if resp != nil {
@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriMobileDevicePrestages = "/api/v2/mobile-device-prestages"
@@ -130,34 +128,34 @@ type MobileDevicePrestageSubsetNamesName struct {
 // CRUD
 
 // GetMobileDevicePrestages retrieves a list of all mobile prestages
-func (c *Client) GetMobileDevicePrestages(sort_filter string) (*ResponseMobileDevicePrestagesList, error) {
-	endpoint := uriMobileDevicePrestages
-	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sort_filter)
+//
+// opts filter and sort the prestages by fields such as displayName or id, for example
+// jamfpro.OrderBy(rsql.Asc(rsql.FieldDisplayName)).
+func (c *Client) GetMobileDevicePrestages(sort_filter string, opts ...ListOption) (*ResponseMobileDevicePrestagesList, error) {
+	c, done := c.startOperation("GetMobileDevicePrestages")
+	defer done()
+
+	resp, err := NewPaginator[ResourceMobileDevicePrestage](c, uriMobileDevicePrestages).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "mobile device prestages", err)
 	}
 
 	var out ResponseMobileDevicePrestagesList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceMobileDevicePrestage
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "mobile device prestage", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetMobileDevicePrestageByID retrieves a single mobile prestage from the supplied ID
 func (c *Client) GetMobileDevicePrestageByID(id string) (*ResourceMobileDevicePrestage, error) {
+	c, done := c.startOperation("GetMobileDevicePrestageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriMobileDevicePrestages, id)
 	var out ResourceMobileDevicePrestage
 
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device prestage", id, err)
 	}
@@ -171,9 +169,12 @@ func (c *Client) GetMobileDevicePrestageByID(id string) (*ResourceMobileDevicePr
 
 // CreateMobileDevicePrestage creates a new mobile prestage and returns the id
 func (c *Client) CreateMobileDevicePrestage(newPrestage ResourceMobileDevicePrestage) (*ResponseMobileDevicePrestageCreate, error) {
+	c, done := c.startOperation("CreateMobileDevicePrestage")
+	defer done()
+
 	endpoint := uriMobileDevicePrestages
 	var out ResponseMobileDevicePrestageCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, newPrestage, &out)
+	resp, err := c.doRequest("POST", endpoint, newPrestage, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device prestage", err)
 	}
@@ -187,8 +188,11 @@ func (c *Client) CreateMobileDevicePrestage(newPrestage ResourceMobileDevicePres
 
 // DeleteMobileDevicePrestageByID a mobile prestage at the given id
 func (c *Client) DeleteMobileDevicePrestageByID(id string) error {
+	c, done := c.startOperation("DeleteMobileDevicePrestageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriMobileDevicePrestages, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device prestage", id, err)
 	}
@@ -8,7 +8,6 @@ package jamfpro
 
 import (
 	"fmt"
-	"net/url"
 )
 
 const uriOnboardingSettings = "/api/v1/onboarding"
@@ -96,11 +95,14 @@ type SubsetOnboardingItemRequest struct {
 
 // GetOnboardingSettings retrieves the current onboarding settings configuration
 func (c *Client) GetOnboardingSettings() (*ResponseOnboardingSettings, error) {
+	c, done := c.startOperation("GetOnboardingSettings")
+	defer done()
+
 	var onboardingSettings ResponseOnboardingSettings
 
-	resp, err := c.HTTP.DoRequest("GET", uriOnboardingSettings, nil, &onboardingSettings)
+	resp, err := c.doRequest("GET", uriOnboardingSettings, nil, &onboardingSettings)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch onboarding settings: %v", err)
+		return nil, fmt.Errorf("failed to fetch onboarding settings: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -112,13 +114,16 @@ func (c *Client) GetOnboardingSettings() (*ResponseOnboardingSettings, error) {
 
 // UpdateOnboardingSettings updates the onboarding configuration
 func (c *Client) UpdateOnboardingSettings(request ResourceUpdateOnboardingSettings) (*ResponseUpdateOnboardingSettings, error) {
+	c, done := c.startOperation("UpdateOnboardingSettings")
+	defer done()
+
 	endpoint := uriOnboardingSettings
 
 	var response ResponseUpdateOnboardingSettings
 
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, request, &response)
+	resp, err := c.doRequest("PUT", endpoint, request, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update onboarding settings: %v", err)
+		return nil, fmt.Errorf("failed to update onboarding settings: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -129,157 +134,60 @@ func (c *Client) UpdateOnboardingSettings(request ResourceUpdateOnboardingSettin
 }
 
 // GetEligibleAppsForOnboarding retrieves a list of applications that are eligible to be used in an onboarding configuration
-func (c *Client) GetEligibleAppsForOnboarding(sort, filter string) (*ResponseEligiblilityForOnboardingList, error) {
-	const maxPageSize = 200
-
-	var allResults []ResourceEligiblilityForOnboardingList
-	var totalCount int
-	page := 0
-
-	for {
-		endpoint := fmt.Sprintf("%s/eligible-apps", uriOnboardingSettings)
-
-		u, err := url.Parse(endpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse URL: %v", err)
-		}
-
-		query := u.Query()
-		query.Set("page", fmt.Sprintf("%d", page))
-		query.Set("page-size", fmt.Sprintf("%d", maxPageSize))
-		if sort != "" {
-			query.Set("sort", sort)
-		}
-		if filter != "" {
-			query.Set("filter", filter)
-		}
-		u.RawQuery = query.Encode()
-
-		var paginatedResponse ResponseEligiblilityForOnboardingList
-		resp, err := c.HTTP.DoRequest("GET", u.String(), nil, &paginatedResponse)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch eligible apps for onboarding: %v", err)
-		}
-
-		if resp != nil && resp.Body != nil {
-			defer resp.Body.Close()
-		}
-
-		totalCount = paginatedResponse.TotalCount
-		allResults = append(allResults, paginatedResponse.Results...)
-
-		if len(paginatedResponse.Results) < maxPageSize {
-			break
-		}
-		page++
+//
+// Typed opts, such as jamfpro.Where(rsql.Like(rsql.FieldName, "Slack*")), replace sort and
+// filter.
+func (c *Client) GetEligibleAppsForOnboarding(sort, filter string, opts ...ListOption) (*ResponseEligiblilityForOnboardingList, error) {
+	c, done := c.startOperation("GetEligibleAppsForOnboarding")
+	defer done()
+
+	endpoint := fmt.Sprintf("%s/eligible-apps", uriOnboardingSettings)
+	resp, err := NewPaginator[ResourceEligiblilityForOnboardingList](c, endpoint).Sort(sort).Filter(filter).withOptions(opts).All()
+	if err != nil {
+		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "eligible apps for onboarding", err)
 	}
 
 	return &ResponseEligiblilityForOnboardingList{
-		TotalCount: totalCount,
-		Results:    allResults,
+		TotalCount: resp.TotalCount,
+		Results:    resp.Results,
 	}, nil
 }
 
 // GetEligibleConfigurationProfilesForOnboarding retrieves a list of configuration profiles that are eligible to be used in an onboarding configuration
-func (c *Client) GetEligibleConfigurationProfilesForOnboarding(sort, filter string) (*ResponseEligiblilityForOnboardingList, error) {
-	const maxPageSize = 200
-
-	var allResults []ResourceEligiblilityForOnboardingList
-	var totalCount int
-	page := 0
-
-	for {
-		endpoint := fmt.Sprintf("%s/eligible-configuration-profiles", uriOnboardingSettings)
-
-		u, err := url.Parse(endpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse URL: %v", err)
-		}
-
-		query := u.Query()
-		query.Set("page", fmt.Sprintf("%d", page))
-		query.Set("page-size", fmt.Sprintf("%d", maxPageSize))
-		if sort != "" {
-			query.Set("sort", sort)
-		}
-		if filter != "" {
-			query.Set("filter", filter)
-		}
-		u.RawQuery = query.Encode()
-
-		var paginatedResponse ResponseEligiblilityForOnboardingList
-		resp, err := c.HTTP.DoRequest("GET", u.String(), nil, &paginatedResponse)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch eligible configuration profiles for onboarding: %v", err)
-		}
-
-		if resp != nil && resp.Body != nil {
-			defer resp.Body.Close()
-		}
-
-		totalCount = paginatedResponse.TotalCount
-		allResults = append(allResults, paginatedResponse.Results...)
-
-		if len(paginatedResponse.Results) < maxPageSize {
-			break
-		}
-		page++
+//
+// Typed opts, such as jamfpro.OrderBy(rsql.Asc(rsql.FieldName)), replace sort and filter.
+func (c *Client) GetEligibleConfigurationProfilesForOnboarding(sort, filter string, opts ...ListOption) (*ResponseEligiblilityForOnboardingList, error) {
+	c, done := c.startOperation("GetEligibleConfigurationProfilesForOnboarding")
+	defer done()
+
+	endpoint := fmt.Sprintf("%s/eligible-configuration-profiles", uriOnboardingSettings)
+	resp, err := NewPaginator[ResourceEligiblilityForOnboardingList](c, endpoint).Sort(sort).Filter(filter).withOptions(opts).All()
+	if err != nil {
+		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "eligible configuration profiles for onboarding", err)
 	}
 
 	return &ResponseEligiblilityForOnboardingList{
-		TotalCount: totalCount,
-		Results:    allResults,
+		TotalCount: resp.TotalCount,
+		Results:    resp.Results,
 	}, nil
 }
 
 // GetEligiblePoliciesForOnboarding retrieves a list of configuration profiles that are eligible to be used in an onboarding configuration
-func (c *Client) GetEligiblePoliciesForOnboarding(sort, filter string) (*ResponseEligiblilityForOnboardingList, error) {
-	const maxPageSize = 200
-
-	var allResults []ResourceEligiblilityForOnboardingList
-	var totalCount int
-	page := 0
-
-	for {
-		endpoint := fmt.Sprintf("%s/eligible-policies", uriOnboardingSettings)
-
-		u, err := url.Parse(endpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse URL: %v", err)
-		}
-
-		query := u.Query()
-		query.Set("page", fmt.Sprintf("%d", page))
-		query.Set("page-size", fmt.Sprintf("%d", maxPageSize))
-		if sort != "" {
-			query.Set("sort", sort)
-		}
-		if filter != "" {
-			query.Set("filter", filter)
-		}
-		u.RawQuery = query.Encode()
-
-		var paginatedResponse ResponseEligiblilityForOnboardingList
-		resp, err := c.HTTP.DoRequest("GET", u.String(), nil, &paginatedResponse)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch eligible configuration profiles for onboarding: %v", err)
-		}
-
-		if resp != nil && resp.Body != nil {
-			defer resp.Body.Close()
-		}
-
-		totalCount = paginatedResponse.TotalCount
-		allResults = append(allResults, paginatedResponse.Results...)
-
-		if len(paginatedResponse.Results) < maxPageSize {
-			break
-		}
-		page++
+//
+// Typed opts, such as jamfpro.Where(rsql.Like(rsql.FieldName, "Install*")), replace sort
+// and filter.
+func (c *Client) GetEligiblePoliciesForOnboarding(sort, filter string, opts ...ListOption) (*ResponseEligiblilityForOnboardingList, error) {
+	c, done := c.startOperation("GetEligiblePoliciesForOnboarding")
+	defer done()
+
+	endpoint := fmt.Sprintf("%s/eligible-policies", uriOnboardingSettings)
+	resp, err := NewPaginator[ResourceEligiblilityForOnboardingList](c, endpoint).Sort(sort).Filter(filter).withOptions(opts).All()
+	if err != nil {
+		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "eligible policies for onboarding", err)
 	}
 
 	return &ResponseEligiblilityForOnboardingList{
-		TotalCount: totalCount,
-		Results:    allResults,
+		TotalCount: resp.TotalCount,
+		Results:    resp.Results,
 	}, nil
 }
@@ -9,7 +9,6 @@ package jamfpro
 import (
 	"fmt"
 	"net/http"
-	"net/url"
 )
 
 // URI for Packages in the Jamf Pro Classic API
@@ -84,61 +83,33 @@ type ResourcePackageHistory struct {
 // CRUD
 
 // GetPackages retrieves a list of packages with pagination, sorting, and filtering.
-func (c *Client) GetPackages(sort, filter string) (*ResponsePackagesList, error) {
-	const maxPageSize = 200 // TODO move this.
-
-	var allResults []ResourcePackage
-	var totalCount int
-	page := 0
-
-	for {
-		u, err := url.Parse(uriPackages)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse URL: %v", err)
-		}
-
-		query := u.Query()
-		query.Set("page", fmt.Sprintf("%d", page))
-		query.Set("page-size", fmt.Sprintf("%d", maxPageSize))
-		if sort != "" {
-			query.Set("sort", sort)
-		}
-		if filter != "" {
-			query.Set("filter", filter)
-		}
-		u.RawQuery = query.Encode()
-
-		var paginatedResponse ResponsePackagesList
-		resp, err := c.HTTP.DoRequest("GET", u.String(), nil, &paginatedResponse)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch packages: %v", err)
-		}
-
-		if resp != nil && resp.Body != nil {
-			defer resp.Body.Close()
-		}
-
-		totalCount = paginatedResponse.TotalCount
-		allResults = append(allResults, paginatedResponse.Results...)
-
-		if len(paginatedResponse.Results) < maxPageSize {
-			break
-		}
-		page++
+//
+// Instead of sort and filter, opts can filter and sort with typed rsql expressions on fields such as
+// packageName, fileName or categoryId, for example jamfpro.Where(rsql.Eq("fileName", "Office.pkg")).
+func (c *Client) GetPackages(sort, filter string, opts ...ListOption) (*ResponsePackagesList, error) {
+	c, done := c.startOperation("GetPackages")
+	defer done()
+
+	resp, err := NewPaginator[ResourcePackage](c, uriPackages).Sort(sort).Filter(filter).withOptions(opts).All()
+	if err != nil {
+		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "packages", err)
 	}
 
 	return &ResponsePackagesList{
-		TotalCount: totalCount,
-		Results:    allResults,
+		TotalCount: resp.TotalCount,
+		Results:    resp.Results,
 	}, nil
 }
 
 // GetPackageByID retrieves details of a specific package by its ID.
 func (c *Client) GetPackageByID(id string) (*ResourcePackage, error) {
+	c, done := c.startOperation("GetPackageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriPackages, id)
 
 	var response ResourcePackage
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "package", id, err)
 	}
@@ -151,52 +122,22 @@ func (c *Client) GetPackageByID(id string) (*ResourcePackage, error) {
 }
 
 // GetPackageHistoryByPackageID retrieves the history of a specific package by its ID with pagination, sorting, and filtering.
-func (c *Client) GetPackageHistoryByPackageID(id string, sort, filter string) (*ResponsePackageHistoryList, error) {
-	const maxPageSize = 200
-
-	var allResults []ResourcePackageHistory
-	var totalCount int
-	page := 0
-
-	for {
-		u, err := url.Parse(fmt.Sprintf("%s/%s/history", uriPackages, id))
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse URL: %v", err)
-		}
-
-		query := u.Query()
-		query.Set("page", fmt.Sprintf("%d", page))
-		query.Set("page-size", fmt.Sprintf("%d", maxPageSize))
-		if sort != "" {
-			query.Set("sort", sort)
-		}
-		if filter != "" {
-			query.Set("filter", filter)
-		}
-		u.RawQuery = query.Encode()
-
-		var paginatedResponse ResponsePackageHistoryList
-		resp, err := c.HTTP.DoRequest("GET", u.String(), nil, &paginatedResponse)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch package history: %v", err)
-		}
-
-		if resp != nil && resp.Body != nil {
-			defer resp.Body.Close()
-		}
-
-		totalCount = paginatedResponse.TotalCount
-		allResults = append(allResults, paginatedResponse.Results...)
-
-		if len(paginatedResponse.Results) < maxPageSize {
-			break
-		}
-		page++
+//
+// Instead of sort and filter, opts can filter and sort the history entries by date, username
+// or note, for example jamfpro.OrderBy(rsql.Desc("date")).
+func (c *Client) GetPackageHistoryByPackageID(id string, sort, filter string, opts ...ListOption) (*ResponsePackageHistoryList, error) {
+	c, done := c.startOperation("GetPackageHistoryByPackageID")
+	defer done()
+
+	endpoint := fmt.Sprintf("%s/%s/history", uriPackages, id)
+	resp, err := NewPaginator[ResourcePackageHistory](c, endpoint).Sort(sort).Filter(filter).withOptions(opts).All()
+	if err != nil {
+		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "package history", err)
 	}
 
 	return &ResponsePackageHistoryList{
-		TotalCount: totalCount,
-		Results:    allResults,
+		TotalCount: resp.TotalCount,
+		Results:    resp.Results,
 	}, nil
 }
 
@@ -235,13 +176,15 @@ Example:
 	if err != nil {
 	    log.Fatal(err)
 	}
-	fmt.Println(response)
 */
 func (c *Client) CreatePackage(packageMetadata ResourcePackage) (*ResponsePackageCreatedAndUpdated, error) {
+	c, done := c.startOperation("CreatePackage")
+	defer done()
+
 	endpoint := uriPackages
 
 	var response ResponsePackageCreatedAndUpdated
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &packageMetadata, &response)
+	resp, err := c.doRequest("POST", endpoint, &packageMetadata, &response)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "package", err)
 	}
@@ -256,6 +199,9 @@ func (c *Client) CreatePackage(packageMetadata ResourcePackage) (*ResponsePackag
 // UploadPackage uploads a package to the Jamf Pro server. It requires the ID of an existing package
 // manifest within JamfPro and the file paths.
 func (c *Client) UploadPackage(id string, filePaths []string) (*ResponsePackageCreatedAndUpdated, error) {
+	c, done := c.startOperation("UploadPackage")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/upload", uriPackages, id)
 
 	// Create a map for the files to be uploaded
@@ -273,9 +219,9 @@ func (c *Client) UploadPackage(id string, filePaths []string) (*ResponsePackageC
 	headersMap := map[string]http.Header{}
 
 	var response ResponsePackageCreatedAndUpdated
-	resp, err := c.HTTP.DoMultiPartRequest("POST", endpoint, files, formFields, contentTypes, headersMap, &response)
+	resp, err := c.doMultiPartRequest("POST", endpoint, files, formFields, contentTypes, headersMap, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload package: %v", err)
+		return nil, fmt.Errorf("failed to upload package: %w", err)
 	}
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
@@ -286,12 +232,15 @@ func (c *Client) UploadPackage(id string, filePaths []string) (*ResponsePackageC
 
 // UpdatePackageByID updates a package manifest by its ID on the Jamf Pro server.
 func (c *Client) UpdatePackageByID(id string, packageMetadata ResourcePackage) (*ResourcePackage, error) {
+	c, done := c.startOperation("UpdatePackageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriPackages, id)
 
 	var response ResourcePackage
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, &packageMetadata, &response)
+	resp, err := c.doRequest("PUT", endpoint, &packageMetadata, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update package: %v", err)
+		return nil, fmt.Errorf("failed to update package: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -303,9 +252,12 @@ func (c *Client) UpdatePackageByID(id string, packageMetadata ResourcePackage) (
 
 // DeletePackageByID deletes a package by its ID from the Jamf Pro server.
 func (c *Client) DeletePackageByID(id string) error {
+	c, done := c.startOperation("DeletePackageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriPackages, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "package", id, err)
 	}
@@ -320,6 +272,9 @@ func (c *Client) DeletePackageByID(id string) error {
 // DeleteMultiplePackagesByID deletes multiple packages by their IDs from the Jamf Pro server.
 // The function takes a slice of strings as input representing the IDs of the packages to be deleted.
 func (c *Client) DeleteMultiplePackagesByID(ids []string) error {
+	c, done := c.startOperation("DeleteMultiplePackagesByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/delete-multiple", uriPackages)
 
 	// Define the request body
@@ -329,17 +284,18 @@ func (c *Client) DeleteMultiplePackagesByID(ids []string) error {
 		IDs: ids,
 	}
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, &body, nil)
-	if err != nil {
-		return fmt.Errorf("failed to delete multiple packages: %v", err)
-	}
-
+	resp, err := c.doRequest("POST", endpoint, &body, nil)
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
+	// A 204 has no body, so the http client's failure to decode one does not mean the deletion failed.
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNoContent) {
+		return fmt.Errorf("failed to delete multiple packages: %w", err)
+	}
+
 	// Check if the response status code is 204 (No Content)
-	if resp.StatusCode == http.StatusNoContent {
+	if resp.StatusCode == http.StatusNoContent || isPlannedResponse(resp) {
 		return nil
 	}
 
@@ -348,9 +304,12 @@ func (c *Client) DeleteMultiplePackagesByID(ids []string) error {
 
 // DeletePackageManifestByID deletes a package by its ID from the Jamf Pro server.
 func (c *Client) DeletePackageManifestByID(id string) error {
+	c, done := c.startOperation("DeletePackageManifestByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/manifest", uriPackages, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "package manifest", id, err)
 	}
@@ -365,6 +324,9 @@ func (c *Client) DeletePackageManifestByID(id string) error {
 // AssignManifestToPackageByID assigns a manifest to a package by its ID on the Jamf Pro server.
 // It requires the ID of an existing package and the file paths of the manifest.
 func (c *Client) AssignManifestToPackageByID(id string, manifestFilePath string) (*ResponsePackageCreatedAndUpdated, error) {
+	c, done := c.startOperation("AssignManifestToPackageByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/manifest", uriPackages, id)
 
 	// Create a map for the files to be uploaded
@@ -382,9 +344,9 @@ func (c *Client) AssignManifestToPackageByID(id string, manifestFilePath string)
 	headersMap := map[string]http.Header{}
 
 	var response ResponsePackageCreatedAndUpdated
-	resp, err := c.HTTP.DoMultiPartRequest("POST", endpoint, files, formFields, contentTypes, headersMap, &response)
+	resp, err := c.doMultiPartRequest("POST", endpoint, files, formFields, contentTypes, headersMap, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to assign manifest to package: %v", err)
+		return nil, fmt.Errorf("failed to assign manifest to package: %w", err)
 	}
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
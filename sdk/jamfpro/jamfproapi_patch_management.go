@@ -13,9 +13,12 @@ const uriPatchManagementDisclaimer = "/api/v2/patch-management-accept-disclaimer
 
 // AcceptPatchManagementDisclaimer accepts the Patch Management disclaimer
 func (c *Client) AcceptPatchManagementDisclaimer() error {
-	resp, err := c.HTTP.DoRequest("POST", uriPatchManagementDisclaimer, nil, nil)
+	c, done := c.startOperation("AcceptPatchManagementDisclaimer")
+	defer done()
+
+	resp, err := c.doRequest("POST", uriPatchManagementDisclaimer, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to accept Patch Management disclaimer: %v", err)
+		return fmt.Errorf("failed to accept Patch Management disclaimer: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
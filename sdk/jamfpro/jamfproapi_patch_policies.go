@@ -8,8 +8,6 @@ package jamfpro
 import (
 	"fmt"
 	"net/http"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriPatchPoliciesJamfProAPI = "/api/v2/patch-policies"
@@ -61,41 +59,36 @@ type ResponsePatchPolicyCreate struct {
 }
 
 // GetPatchPolicies gets the full list of patch policies & handles pagination
-func (c *Client) GetPatchPolicies(sortFilter string) (*ResponsePatchPoliciesList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriPatchPoliciesJamfProAPI+"/policy-details",
-		standardPageSize,
-		startingPageNumber,
-		sortFilter,
-	)
-
+//
+// opts filter and sort the patch policies by fields such as policyName or policyEnabled,
+// for example jamfpro.Where(rsql.Eq("policyEnabled", true)).
+func (c *Client) GetPatchPolicies(sortFilter string, opts ...ListOption) (*ResponsePatchPoliciesList, error) {
+	c, done := c.startOperation("GetPatchPolicies")
+	defer done()
+
+	resp, err := NewPaginator[ResourcePatchPolicy](c, uriPatchPoliciesJamfProAPI+"/policy-details").RawQuery(sortFilter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "patch policies", err)
 	}
 
 	var out ResponsePatchPoliciesList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourcePatchPolicy
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "patch policy", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetPatchPolicyDashboardStatus checks if a patch policy is on the dashboard
 func (c *Client) GetPatchPolicyDashboardStatus(id string) (*ResponsePatchPolicyDashboardStatus, error) {
+	c, done := c.startOperation("GetPatchPolicyDashboardStatus")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/dashboard", uriPatchPoliciesJamfProAPI, id)
 
 	var response ResponsePatchPolicyDashboardStatus
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
+	resp, err := c.doRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check patch policy dashboard status: %v", err)
+		return nil, fmt.Errorf("failed to check patch policy dashboard status: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -107,11 +100,14 @@ func (c *Client) GetPatchPolicyDashboardStatus(id string) (*ResponsePatchPolicyD
 
 // AddPatchPolicyToDashboard adds a patch policy to the dashboard
 func (c *Client) AddPatchPolicyToDashboard(id string) error {
+	c, done := c.startOperation("AddPatchPolicyToDashboard")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/dashboard", uriPatchPoliciesJamfProAPI, id)
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, nil)
+	resp, err := c.doRequest("POST", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to add patch policy to dashboard: %v", err)
+		return fmt.Errorf("failed to add patch policy to dashboard: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -128,11 +124,14 @@ func (c *Client) AddPatchPolicyToDashboard(id string) error {
 
 // RemovePatchPolicyFromDashboard removes a patch policy from the dashboard
 func (c *Client) DeletePatchPolicyFromDashboard(id string) error {
+	c, done := c.startOperation("DeletePatchPolicyFromDashboard")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s/dashboard", uriPatchPoliciesJamfProAPI, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to remove patch policy from dashboard: %v", err)
+		return fmt.Errorf("failed to remove patch policy from dashboard: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
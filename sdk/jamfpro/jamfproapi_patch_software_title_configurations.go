@@ -56,9 +56,12 @@ type PatchSoftwareTitleConfigurationSubsetPackage struct {
 
 // GetPatchSoftwareTitleConfigurations retrieves list of PatchSoftwareTitleConfigurations
 func (c *Client) GetPatchSoftwareTitleConfigurations() (*ResponsePatchSoftwareTitleConfigurationList, error) {
+	c, done := c.startOperation("GetPatchSoftwareTitleConfigurations")
+	defer done()
+
 	endpoint := uriPatchSoftwareTitleConfigurations
 	var out ResponsePatchSoftwareTitleConfigurationList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "patch software title configurations", err)
 	}
@@ -73,9 +76,12 @@ func (c *Client) GetPatchSoftwareTitleConfigurations() (*ResponsePatchSoftwareTi
 
 // GetPatchSoftwareTitleConfigurationById retrieves a singular PatchSoftwareTitleConfiguration from a given ID
 func (c *Client) GetPatchSoftwareTitleConfigurationById(id string) (*ResourcePatchSoftwareTitleConfiguration, error) {
+	c, done := c.startOperation("GetPatchSoftwareTitleConfigurationById")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id)
 	var out ResourcePatchSoftwareTitleConfiguration
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "patch software title configuration", id, err)
 	}
@@ -87,27 +93,28 @@ func (c *Client) GetPatchSoftwareTitleConfigurationById(id string) (*ResourcePat
 	return &out, nil
 }
 
-// GetPatchSoftwareTitleConfigurationByName retrieves a department by Name.
+// GetPatchSoftwareTitleConfigurationByName retrieves a patch software title configuration by Name. The endpoint is
+// not paginated and does not support filtering, so the configurations are listed and matched locally.
 func (c *Client) GetPatchSoftwareTitleConfigurationByName(name string) (*ResourcePatchSoftwareTitleConfiguration, error) {
+	c, done := c.startOperation("GetPatchSoftwareTitleConfigurationByName")
+	defer done()
+
 	patchSoftwareTitle, err := c.GetPatchSoftwareTitleConfigurations()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "patch software title configuration", err)
 	}
 
-	for _, value := range patchSoftwareTitle.Results {
-		if value.DisplayName == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "patch software title configuration", name, errMsgNoName)
+	return findOneByName(patchSoftwareTitle.Results, "patch software title configuration", name, func(value ResourcePatchSoftwareTitleConfiguration) string { return value.DisplayName })
 }
 
 // CreatePatchSoftwareTitleConfiguration Creates a new PatchSoftwareTitleConfiguration
 func (c *Client) CreatePatchSoftwareTitleConfiguration(configuration ResourcePatchSoftwareTitleConfiguration) (*ResponsePatchSoftwareTitleConfigurationCreate, error) {
+	c, done := c.startOperation("CreatePatchSoftwareTitleConfiguration")
+	defer done()
+
 	endpoint := uriPatchSoftwareTitleConfigurations
 	var out ResponsePatchSoftwareTitleConfigurationCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, configuration, &out)
+	resp, err := c.doRequest("POST", endpoint, configuration, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "patch software title configuration", err)
 	}
@@ -121,9 +128,12 @@ func (c *Client) CreatePatchSoftwareTitleConfiguration(configuration ResourcePat
 
 // UpdatePatchSoftwareTitleConfigurationById Updates a single PatchSoftwareTitleConfiguration with given ID
 func (c *Client) UpdatePatchSoftwareTitleConfigurationById(id string, updatedConfiguration ResourcePatchSoftwareTitleConfiguration) (*ResponsePatchSoftwareTitleConfigurationCreate, error) {
+	c, done := c.startOperation("UpdatePatchSoftwareTitleConfigurationById")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id)
 	var out ResponsePatchSoftwareTitleConfigurationCreate
-	resp, err := c.HTTP.DoRequest("PATCH", endpoint, updatedConfiguration, &out)
+	resp, err := c.doRequest("PATCH", endpoint, updatedConfiguration, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "patch software title configuration", err)
 	}
@@ -137,8 +147,11 @@ func (c *Client) UpdatePatchSoftwareTitleConfigurationById(id string, updatedCon
 
 // DeletePatchSoftwareTitleConfigurationById deletes a PatchSoftwareTitleConfiguration with given ID
 func (c *Client) DeletePatchSoftwareTitleConfigurationById(id string) error {
+	c, done := c.startOperation("DeletePatchSoftwareTitleConfigurationById")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "patch software title configuration", id, err)
 	}
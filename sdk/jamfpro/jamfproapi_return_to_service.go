@@ -62,9 +62,12 @@ type ResourceReturnToServiceConfiguration struct {
 
 // GetReturnToServiceConfigurations fetches a list of devices that are in the Return to Service state.
 func (c *Client) GetReturnToServiceConfigurations() ([]ResponseReturnToServiceList, error) {
+	c, done := c.startOperation("GetReturnToServiceConfigurations")
+	defer done()
+
 	endpoint := uriReturnToService
 	var out []ResponseReturnToServiceList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "Return To Service Configurations", err)
 	}
@@ -78,9 +81,12 @@ func (c *Client) GetReturnToServiceConfigurations() ([]ResponseReturnToServiceLi
 
 // GetReturnToServiceConfigurationByID fetches a specific Return to Service configuration by ID.
 func (c *Client) GetReturnToServiceConfigurationByID(id string) (*ResponseReturnToServiceConfiguration, error) {
+	c, done := c.startOperation("GetReturnToServiceConfigurationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriReturnToService, id)
 	var out ResponseReturnToServiceConfiguration
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "Return To Service Configuration", id, err)
 	}
@@ -94,9 +100,12 @@ func (c *Client) GetReturnToServiceConfigurationByID(id string) (*ResponseReturn
 
 // CreateReturnToServiceConfiguration creates a new Return to Service configuration.
 func (c *Client) CreateReturnToServiceConfiguration(config ResourceReturnToServiceConfiguration) (*ResponseReturnToServiceCreate, error) {
+	c, done := c.startOperation("CreateReturnToServiceConfiguration")
+	defer done()
+
 	endpoint := uriReturnToService
 	var out ResponseReturnToServiceCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, config, &out)
+	resp, err := c.doRequest("POST", endpoint, config, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "Return To Service Configuration", err)
 	}
@@ -110,9 +119,12 @@ func (c *Client) CreateReturnToServiceConfiguration(config ResourceReturnToServi
 
 // UpdateReturnToServiceConfigurationByID updates an existing Return to Service configuration by ID.
 func (c *Client) UpdateReturnToServiceConfigurationByID(id string, config ResourceReturnToServiceConfiguration) (*ResponseReturnToServiceConfiguration, error) {
+	c, done := c.startOperation("UpdateReturnToServiceConfigurationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriReturnToService, id)
 	var out ResponseReturnToServiceConfiguration
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, config, &out)
+	resp, err := c.doRequest("PUT", endpoint, config, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "Return To Service Configuration", id, err)
 	}
@@ -126,8 +138,11 @@ func (c *Client) UpdateReturnToServiceConfigurationByID(id string, config Resour
 
 // DeleteReturnToServiceConfigurationByID deletes a Return to Service configuration by ID.
 func (c *Client) DeleteReturnToServiceConfigurationByID(id string) error {
+	c, done := c.startOperation("DeleteReturnToServiceConfigurationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriReturnToService, id)
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "Return To Service Configuration", id, err)
 	}
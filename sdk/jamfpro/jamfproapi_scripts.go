@@ -8,7 +8,7 @@ package jamfpro
 import (
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 const uriScripts = "/api/v1/scripts"
@@ -55,29 +55,21 @@ type ResourceScript struct {
 // CRUD
 
 // Gets full list of scripts & handles pagination
-func (c *Client) GetScripts(sort_filter string) (*ResponseScriptsList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriScripts,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
-
+//
+// opts filter and sort the scripts by fields such as name or categoryName, for example
+// jamfpro.Where(rsql.Eq("categoryName", "Maintenance")).
+func (c *Client) GetScripts(sort_filter string, opts ...ListOption) (*ResponseScriptsList, error) {
+	c, done := c.startOperation("GetScripts")
+	defer done()
+
+	resp, err := NewPaginator[ResourceScript](c, uriScripts).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "scripts", err)
 	}
 
 	var out ResponseScriptsList
-	out.Size = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceScript
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "script", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.Size = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 
@@ -85,9 +77,12 @@ func (c *Client) GetScripts(sort_filter string) (*ResponseScriptsList, error) {
 
 // Retrieves script from provided ID & returns ResourceScript
 func (c *Client) GetScriptByID(id string) (*ResourceScript, error) {
+	c, done := c.startOperation("GetScriptByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriScripts, id)
 	var script ResourceScript
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &script)
+	resp, err := c.doRequest("GET", endpoint, nil, &script)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "script", id, err)
@@ -100,28 +95,22 @@ func (c *Client) GetScriptByID(id string) (*ResourceScript, error) {
 	return &script, nil
 }
 
-// Retrieves script by Name by leveraging GetScripts(), returns ResourceScript
+// Retrieves script by Name using a server side filter, returns ResourceScript
 func (c *Client) GetScriptByName(name string) (*ResourceScript, error) {
-	scripts, err := c.GetScripts("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "scripts", err)
-	}
-
-	for _, value := range scripts.Results {
-		if value.Name == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "script", name, errMsgNoName)
+	c, done := c.startOperation("GetScriptByName")
+	defer done()
+	return getOneByName[ResourceScript](c, uriScripts, "script", rsql.FieldName, name, func(value ResourceScript) string { return value.Name })
 }
 
 // Creates script from ResourceScript struct
 func (c *Client) CreateScript(script *ResourceScript) (*ResponseScriptCreate, error) {
+	c, done := c.startOperation("CreateScript")
+	defer done()
+
 	endpoint := uriScripts
 	var ResponseScriptCreate ResponseScriptCreate
 
-	resp, err := c.HTTP.DoRequest("POST", endpoint, script, &ResponseScriptCreate)
+	resp, err := c.doRequest("POST", endpoint, script, &ResponseScriptCreate)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "script", err)
 	}
@@ -135,9 +124,12 @@ func (c *Client) CreateScript(script *ResourceScript) (*ResponseScriptCreate, er
 
 // Updates script from provided ResourceScript - only updates provided keys
 func (c *Client) UpdateScriptByID(id string, scriptUpdate *ResourceScript) (*ResourceScript, error) {
+	c, done := c.startOperation("UpdateScriptByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriScripts, id)
 	var updatedScript ResourceScript
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, scriptUpdate, &updatedScript)
+	resp, err := c.doRequest("PUT", endpoint, scriptUpdate, &updatedScript)
 
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdateByID, "script", id, err)
@@ -153,6 +145,9 @@ func (c *Client) UpdateScriptByID(id string, scriptUpdate *ResourceScript) (*Res
 
 // Leverages UpdateScriptByID and GetScripts to update script from provided ResourceScript
 func (c *Client) UpdateScriptByName(name string, scriptUpdate *ResourceScript) (*ResourceScript, error) {
+	c, done := c.startOperation("UpdateScriptByName")
+	defer done()
+
 	target, err := c.GetScriptByName(name)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "script", name, err)
@@ -170,9 +165,12 @@ func (c *Client) UpdateScriptByName(name string, scriptUpdate *ResourceScript) (
 
 // Deletes script with provided ID
 func (c *Client) DeleteScriptByID(id string) error {
+	c, done := c.startOperation("DeleteScriptByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriScripts, id)
 	var response interface{}
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, &response)
+	resp, err := c.doRequest("DELETE", endpoint, nil, &response)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "script", id, err)
 	}
@@ -186,6 +184,9 @@ func (c *Client) DeleteScriptByID(id string) error {
 
 // Leverages DeleteScriptByID and GetScripts to delete script by Name
 func (c *Client) DeleteScriptByName(name string) error {
+	c, done := c.startOperation("DeleteScriptByName")
+	defer done()
+
 	target, err := c.GetScriptByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "script", name, err)
@@ -7,8 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriSelfServiceBrandingMacOS = "/api/v1/self-service/branding/macos"
@@ -36,75 +34,71 @@ type ResourceSelfServiceBrandingDetail struct {
 // CRUD
 
 // GetSelfServiceBrandingMacOS retrieves the list of self-service branding configurations for macOS.
-func (c *Client) GetSelfServiceBrandingMacOS(sort_filter string) (*ResponseSelfServiceBrandingList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriSelfServiceBrandingMacOS,
-		standardPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
+//
+// opts filter and sort the brandings by fields such as applicationName or brandingName, for
+// example jamfpro.Where(rsql.Eq("brandingName", "Self Service")).
+func (c *Client) GetSelfServiceBrandingMacOS(sort_filter string, opts ...ListOption) (*ResponseSelfServiceBrandingList, error) {
+	c, done := c.startOperation("GetSelfServiceBrandingMacOS")
+	defer done()
+
+	resp, err := NewPaginator[ResourceSelfServiceBrandingDetail](c, uriSelfServiceBrandingMacOS).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "self service branding", err)
 	}
 
 	var out ResponseSelfServiceBrandingList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceSelfServiceBrandingDetail
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "self service branding", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetSelfServiceBrandingMacOSByID retrieves a specific self-service branding configuration for macOS by ID.
 func (c *Client) GetSelfServiceBrandingMacOSByID(id string) (*ResourceSelfServiceBrandingDetail, error) {
+	c, done := c.startOperation("GetSelfServiceBrandingMacOSByID")
+	defer done()
+
 	var out ResourceSelfServiceBrandingDetail
 	endpoint := fmt.Sprintf("%s/%s", uriSelfServiceBrandingMacOS, id)
 
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
 	if err != nil {
-		fmt.Printf(errMsgFailedGetByID, "self service branding", id, err)
-		return nil, err
+		return nil, fmt.Errorf(errMsgFailedGetByID, "self service branding", id, err)
 	}
 
 	return &out, nil
 }
 
-// GetSelfServiceBrandingMacOSByNameByID retrieves a specific self-service branding configuration for macOS by its name.
+// GetSelfServiceBrandingMacOSByName retrieves a specific self-service branding configuration for macOS by its name.
+// The endpoint does not support filtering, so the brandings are listed and matched locally.
 func (c *Client) GetSelfServiceBrandingMacOSByName(name string) (*ResourceSelfServiceBrandingDetail, error) {
+	c, done := c.startOperation("GetSelfServiceBrandingMacOSByName")
+	defer done()
+
 	all_ssbrandings, err := c.GetSelfServiceBrandingMacOS("")
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "self service brandings", err)
 	}
 
-	for _, value := range all_ssbrandings.Results {
-		if value.BrandingName == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "self service branding", name, errMsgNoName)
+	return findOneByName(all_ssbrandings.Results, "self service branding", name, func(value ResourceSelfServiceBrandingDetail) string { return value.BrandingName })
 }
 
 // CreateSelfServiceBrandingMacOS creates a new self-service branding configuration for macOS.
 func (c *Client) CreateSelfServiceBrandingMacOS(branding *ResourceSelfServiceBrandingDetail) (*ResourceSelfServiceBrandingDetail, error) {
+	c, done := c.startOperation("CreateSelfServiceBrandingMacOS")
+	defer done()
+
 	endpoint := uriSelfServiceBrandingMacOS
 
 	var response ResourceSelfServiceBrandingDetail
-	resp, err := c.HTTP.DoRequest("POST", endpoint, branding, &response)
+	resp, err := c.doRequest("POST", endpoint, branding, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create self-service branding: %v", err)
+		return nil, fmt.Errorf("failed to create self-service branding: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -116,12 +110,15 @@ func (c *Client) CreateSelfServiceBrandingMacOS(branding *ResourceSelfServiceBra
 
 // UpdateSelfServiceBrandingMacOSByID updates an existing self-service branding configuration for macOS.
 func (c *Client) UpdateSelfServiceBrandingMacOSByID(id string, brandingUpdate *ResourceSelfServiceBrandingDetail) (*ResourceSelfServiceBrandingDetail, error) {
+	c, done := c.startOperation("UpdateSelfServiceBrandingMacOSByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriSelfServiceBrandingMacOS, id)
 
 	var response ResourceSelfServiceBrandingDetail
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, brandingUpdate, &response)
+	resp, err := c.doRequest("PUT", endpoint, brandingUpdate, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update self-service branding: %v", err)
+		return nil, fmt.Errorf("failed to update self-service branding: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -133,6 +130,9 @@ func (c *Client) UpdateSelfServiceBrandingMacOSByID(id string, brandingUpdate *R
 
 // UpdateSelfServiceBrandingMacOSByName updates a self-service branding configuration for macOS by name.
 func (c *Client) UpdateSelfServiceBrandingMacOSByName(name string, brandingUpdate *ResourceSelfServiceBrandingDetail) (*ResourceSelfServiceBrandingDetail, error) {
+	c, done := c.startOperation("UpdateSelfServiceBrandingMacOSByName")
+	defer done()
+
 	target, err := c.GetSelfServiceBrandingMacOSByName(name)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "self service branding", name, err)
@@ -150,9 +150,12 @@ func (c *Client) UpdateSelfServiceBrandingMacOSByName(name string, brandingUpdat
 
 // DeleteSelfServiceBrandingMacOSByID deletes a self-service branding configuration for macOS by ID.
 func (c *Client) DeleteSelfServiceBrandingMacOSByID(id string) error {
+	c, done := c.startOperation("DeleteSelfServiceBrandingMacOSByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriSelfServiceBrandingMacOS, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "self service branding", id, err)
 	}
@@ -166,6 +169,9 @@ func (c *Client) DeleteSelfServiceBrandingMacOSByID(id string) error {
 
 // DeleteSelfServiceBrandingMacOSByName deletes a self-service branding configuration for macOS by name.
 func (c *Client) DeleteSelfServiceBrandingMacOSByName(name string) error {
+	c, done := c.startOperation("DeleteSelfServiceBrandingMacOSByName")
+	defer done()
+
 	target, err := c.GetSelfServiceBrandingMacOSByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "self service branding", name, err)
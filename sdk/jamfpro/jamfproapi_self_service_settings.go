@@ -42,10 +42,13 @@ type ConfigurationSettings struct {
 
 // GetSelfServiceSettings retrives a self service list.
 func (c *Client) GetSelfServiceSettings() (*ResourceSelfServiceSettings, error) {
+	c, done := c.startOperation("GetSelfServiceSettings")
+	defer done()
+
 	endpoint := uriSelfServiceSettings
 
 	var settings ResourceSelfServiceSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &settings)
+	resp, err := c.doRequest("GET", endpoint, nil, &settings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "self service settings", err)
 	}
@@ -59,10 +62,13 @@ func (c *Client) GetSelfServiceSettings() (*ResourceSelfServiceSettings, error)
 
 // UpdateSelfServiceSettings updates the self service settings.
 func (c *Client) UpdateSelfServiceSettings(settingsUpdate *ResourceSelfServiceSettings) (*ResourceSelfServiceSettings, error) {
+	c, done := c.startOperation("UpdateSelfServiceSettings")
+	defer done()
+
 	endpoint := uriSelfServiceSettings
 
 	var updatedSettings ResourceSelfServiceSettings
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, settingsUpdate, &updatedSettings)
+	resp, err := c.doRequest("PUT", endpoint, settingsUpdate, &updatedSettings)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "self service settings", err)
 	}
@@ -26,12 +26,15 @@ type ResourceSMTPServer struct {
 
 // GetSMTPServerInformation gets the SMTP server settings
 func (c *Client) GetSMTPServerInformation() (*ResourceSMTPServer, error) {
+	c, done := c.startOperation("GetSMTPServerInformation")
+	defer done()
+
 	endpoint := uriSMTPServer
 
 	var smtpSettings ResourceSMTPServer
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &smtpSettings)
+	resp, err := c.doRequest("GET", endpoint, nil, &smtpSettings)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get smtp server information: %v", err)
+		return nil, fmt.Errorf("failed to get smtp server information: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -43,12 +46,15 @@ func (c *Client) GetSMTPServerInformation() (*ResourceSMTPServer, error) {
 
 // UpdateSMTPServerInformation updates the SMTP server settings
 func (c *Client) UpdateSMTPServerInformation(settings *ResourceSMTPServer) error {
+	c, done := c.startOperation("UpdateSMTPServerInformation")
+	defer done()
+
 	endpoint := uriSMTPServer
 
 	// No need to wrap settings for JSON
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, settings, nil)
+	resp, err := c.doRequest("PUT", endpoint, settings, nil)
 	if err != nil {
-		return fmt.Errorf("failed to update smtp server information: %v", err)
+		return fmt.Errorf("failed to update smtp server information: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -66,9 +66,12 @@ type SsoSubsetSubsetEnrollmentCustomizationDependency struct {
 
 // GetSsoSettings retrieves current Jamf Sso settings
 func (c *Client) GetSsoSettings() (*ResourceSsoSettings, error) {
+	c, done := c.startOperation("GetSsoSettings")
+	defer done()
+
 	endpoint := uriSsoSettings
 	var out ResourceSsoSettings
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "sso settings", err)
 	}
@@ -82,9 +85,12 @@ func (c *Client) GetSsoSettings() (*ResourceSsoSettings, error) {
 
 // UpdateSsoSettings Updates SSO Settings with ResourceSsoSettings struct data
 func (c *Client) UpdateSsoSettings(updatedSettings ResourceSsoSettings) (*ResourceSsoSettings, error) {
+	c, done := c.startOperation("UpdateSsoSettings")
+	defer done()
+
 	endpoint := uriSsoSettings
 	var out ResourceSsoSettings
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
+	resp, err := c.doRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedUpdate, "sso settings", err)
 	}
@@ -98,9 +104,12 @@ func (c *Client) UpdateSsoSettings(updatedSettings ResourceSsoSettings) (*Resour
 
 // GetSsoEnrollmentCustomizationDependencies shows which enrollment customizations are dependent on which sso settings // NOTE I think?
 func (c *Client) GetSsoEnrollmentCustomizationDependencies() (*ResponseSsoSubsetEnrollmentCustomizationDependencyList, error) {
+	c, done := c.startOperation("GetSsoEnrollmentCustomizationDependencies")
+	defer done()
+
 	endpoint := uriSsoDependencies
 	var out ResponseSsoSubsetEnrollmentCustomizationDependencyList
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGet, "sso enrollment customization dependencies", err)
 	}
@@ -7,10 +7,6 @@ package jamfpro
 
 import (
 	"fmt"
-	"net/url"
-	"strconv"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 const uriVolumePurchasingLocations = "/api/v1/volume-purchasing-locations"
@@ -79,32 +75,33 @@ type VolumePurchasingSubsetContent struct {
 // VPP Locations
 
 // GetVolumePurchaseLocations retrieves all volume purchasing locations with optional sorting and filtering.
-func (c *Client) GetVolumePurchaseLocations(sort_filter string) (*ResponseVolumePurchasingList, error) {
-	resp, err := c.DoPaginatedGet(uriVolumePurchasingLocations, standardPageSize, startingPageNumber, sort_filter)
+//
+// opts filter and sort the locations by fields such as name, appleId or countryCode, for
+// example jamfpro.Where(rsql.Eq("countryCode", "US")).
+func (c *Client) GetVolumePurchaseLocations(sort_filter string, opts ...ListOption) (*ResponseVolumePurchasingList, error) {
+	c, done := c.startOperation("GetVolumePurchaseLocations")
+	defer done()
+
+	resp, err := NewPaginator[ResourceVolumePurchasingLocation](c, uriVolumePurchasingLocations).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "vpp locations", err)
 	}
 
 	var out ResponseVolumePurchasingList
-	out.TotalCount = resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceVolumePurchasingLocation
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "vpp location", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetVolumePurchasingLocationByID retrieves a specific volume purchasing location by its ID.
 func (c *Client) GetVolumePurchasingLocationByID(id string) (*ResourceVolumePurchasingLocation, error) {
+	c, done := c.startOperation("GetVolumePurchasingLocationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriVolumePurchasingLocations, id)
 	var responseLocation ResourceVolumePurchasingLocation
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseLocation)
+	resp, err := c.doRequest("GET", endpoint, nil, &responseLocation)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp locations", id, err)
 	}
@@ -118,12 +115,15 @@ func (c *Client) GetVolumePurchasingLocationByID(id string) (*ResourceVolumePurc
 
 // CreateVolumePurchasingLocation creates a new volume purchasing location.
 func (c *Client) CreateVolumePurchasingLocation(request *ResourceVolumePurchasingLocation) (*ResponseVolumePurchasingLocationCreate, error) {
+	c, done := c.startOperation("CreateVolumePurchasingLocation")
+	defer done()
+
 	endpoint := uriVolumePurchasingLocations
 
 	var response ResponseVolumePurchasingLocationCreate
-	resp, err := c.HTTP.DoRequest("POST", endpoint, request, &response)
+	resp, err := c.doRequest("POST", endpoint, request, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create volume purchasing location: %v", err)
+		return nil, fmt.Errorf("failed to create volume purchasing location: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -135,9 +135,12 @@ func (c *Client) CreateVolumePurchasingLocation(request *ResourceVolumePurchasin
 
 // UpdateVolumePurchasingLocationByID updates a specific volume purchasing location by its ID.
 func (c *Client) UpdateVolumePurchasingLocationByID(id string) (*ResourceVolumePurchasingLocation, error) {
+	c, done := c.startOperation("UpdateVolumePurchasingLocationByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriVolumePurchasingLocations, id)
 	var responseLocation ResourceVolumePurchasingLocation
-	resp, err := c.HTTP.DoRequest("PATCH", endpoint, nil, &responseLocation)
+	resp, err := c.doRequest("PATCH", endpoint, nil, &responseLocation)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp locations", id, err)
 	}
@@ -151,8 +154,11 @@ func (c *Client) UpdateVolumePurchasingLocationByID(id string) (*ResourceVolumeP
 
 // DeleteVolumePurchasingLocationByID deletes a specific volume purchasing location by its ID.
 func (c *Client) DeleteVolumePurchasingLocationByID(id string) error {
+	c, done := c.startOperation("DeleteVolumePurchasingLocationByID")
+	defer done()
+
 	endpoint := uriVolumePurchasingLocations
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedDeleteByID, "vpp location", id, err)
 	}
@@ -167,47 +173,21 @@ func (c *Client) DeleteVolumePurchasingLocationByID(id string) error {
 // QUERY do we need the stuff below here?
 
 // GetVolumePurchasingContentForLocationByID retrieves the content for a specific volume purchasing location by its ID.
-func (c *Client) GetVolumePurchasingContentForLocationByID(id string, sort []string, filter string) (*ResponseVolumePurchasingContentList, error) {
-	var allContent []VolumePurchasingSubsetContent
-
-	page := 1
-	for {
-		params := url.Values{
-			"page":      []string{strconv.Itoa(page)},
-			"page-size": []string{"100"},
-		}
-
-		for _, s := range sort {
-			params.Add("sort", s)
-		}
-
-		if filter != "" {
-			params.Add("filter", filter)
-		}
-
-		endpointWithParams := fmt.Sprintf("%s/%s/content?%s", uriVolumePurchasingLocations, id, params.Encode())
-
-		var responseContent ResponseVolumePurchasingContentList
-		resp, err := c.HTTP.DoRequest("GET", endpointWithParams, nil, &responseContent)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch volume purchasing content for location ID %s: %v", id, err)
-		}
-
-		if resp != nil && resp.Body != nil {
-			defer resp.Body.Close()
-		}
-
-		allContent = append(allContent, responseContent.Results...)
-
-		if len(allContent) >= responseContent.TotalCount {
-			break
-		}
-
-		page++
+//
+// Instead of sort and filter, opts can filter and sort the content by fields such as name
+// or contentType, for example jamfpro.Where(rsql.Eq("contentType", "iOS App")).
+func (c *Client) GetVolumePurchasingContentForLocationByID(id string, sort []string, filter string, opts ...ListOption) (*ResponseVolumePurchasingContentList, error) {
+	c, done := c.startOperation("GetVolumePurchasingContentForLocationByID")
+	defer done()
+
+	endpoint := fmt.Sprintf("%s/%s/content", uriVolumePurchasingLocations, id)
+	resp, err := NewPaginator[VolumePurchasingSubsetContent](c, endpoint).PageSize(100).Sort(sort...).Filter(filter).withOptions(opts).All()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch volume purchasing content for location ID %s: %w", id, err)
 	}
 
 	return &ResponseVolumePurchasingContentList{
-		TotalCount: len(allContent),
-		Results:    allContent,
+		TotalCount: len(resp.Results),
+		Results:    resp.Results,
 	}, nil
 }
@@ -8,7 +8,7 @@ package jamfpro
 import (
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
 const uriVolumePurchasingSubscriptions = "/api/v1/volume-purchasing-subscriptions"
@@ -48,38 +48,34 @@ type VolumePurchasingSubscriptionSubsetExternalRecipients struct {
 // CRUD
 
 // GetVolumePurchasingSubscriptions retrieves all volume purchasing subscriptions
-func (c *Client) GetVolumePurchasingSubscriptions(sort_filter string) (*ResponseVolumePurchasingSubscriptionsList, error) {
-	resp, err := c.DoPaginatedGet(
-		uriVolumePurchasingSubscriptions,
-		maxPageSize,
-		startingPageNumber,
-		sort_filter,
-	)
+//
+// opts filter and sort the subscriptions by fields such as name or enabled, for example
+// jamfpro.Where(rsql.Eq("enabled", true)).
+func (c *Client) GetVolumePurchasingSubscriptions(sort_filter string, opts ...ListOption) (*ResponseVolumePurchasingSubscriptionsList, error) {
+	c, done := c.startOperation("GetVolumePurchasingSubscriptions")
+	defer done()
+
+	resp, err := NewPaginator[ResourceVolumePurchasingSubscription](c, uriVolumePurchasingSubscriptions).PageSize(maxPageSize).RawQuery(sort_filter).withOptions(opts).All()
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "volume purchasing subscriptions", err)
 	}
 
 	var out ResponseVolumePurchasingSubscriptionsList
-	out.TotalCount = &resp.Size
-
-	for _, value := range resp.Results {
-		var newObj ResourceVolumePurchasingSubscription
-		err := mapstructure.Decode(value, &newObj)
-		if err != nil {
-			return nil, fmt.Errorf(errMsgFailedMapstruct, "volume purchasing subscription", err)
-		}
-		out.Results = append(out.Results, newObj)
-	}
+	out.TotalCount = &resp.TotalCount
+	out.Results = resp.Results
 
 	return &out, nil
 }
 
 // GetVolumePurchasingSubscriptionByID retrieves a single volume purchasing subscription by its ID
 func (c *Client) GetVolumePurchasingSubscriptionByID(id string) (*ResourceVolumePurchasingSubscription, error) {
+	c, done := c.startOperation("GetVolumePurchasingSubscriptionByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriVolumePurchasingSubscriptions, id)
 
 	var subscription ResourceVolumePurchasingSubscription
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &subscription)
+	resp, err := c.doRequest("GET", endpoint, nil, &subscription)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByID, "volume purchasing subscription", id, err)
 	}
@@ -91,25 +87,18 @@ func (c *Client) GetVolumePurchasingSubscriptionByID(id string) (*ResourceVolume
 	return &subscription, nil
 }
 
-// GetVolumePurchasingSubscriptionByNameByID fetches a volume purchasing subscription by its display name and retrieves its details using its ID.
+// GetVolumePurchasingSubscriptionByName fetches a volume purchasing subscription by its name using a server side filter.
 func (c *Client) GetVolumePurchasingSubscriptionByName(name string) (*ResourceVolumePurchasingSubscription, error) {
-	vpSubcriptions, err := c.GetVolumePurchasingSubscriptions("")
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "volume purchasing subscriptions", err)
-	}
-
-	for _, value := range vpSubcriptions.Results {
-		if value.Name == name {
-			return &value, nil
-		}
-	}
-
-	return nil, fmt.Errorf(errMsgFailedGetByName, "volume purchasing subscription", name, errMsgNoName)
-
+	c, done := c.startOperation("GetVolumePurchasingSubscriptionByName")
+	defer done()
+	return getOneByName[ResourceVolumePurchasingSubscription](c, uriVolumePurchasingSubscriptions, "volume purchasing subscription", rsql.FieldName, name, func(value ResourceVolumePurchasingSubscription) string { return value.Name })
 }
 
 // CreateVolumePurchasingSubscription creates a new volume purchasing subscription
 func (c *Client) CreateVolumePurchasingSubscription(subscription *ResourceVolumePurchasingSubscription) (*ResourceVolumePurchasingSubscription, error) {
+	c, done := c.startOperation("CreateVolumePurchasingSubscription")
+	defer done()
+
 	endpoint := uriVolumePurchasingSubscriptions
 
 	if subscription.SiteId == "" {
@@ -117,9 +106,9 @@ func (c *Client) CreateVolumePurchasingSubscription(subscription *ResourceVolume
 	}
 
 	var createdSubscription ResourceVolumePurchasingSubscription
-	resp, err := c.HTTP.DoRequest("POST", endpoint, subscription, &createdSubscription)
+	resp, err := c.doRequest("POST", endpoint, subscription, &createdSubscription)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create volume purchasing subscription: %v", err)
+		return nil, fmt.Errorf("failed to create volume purchasing subscription: %w", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -131,12 +120,15 @@ func (c *Client) CreateVolumePurchasingSubscription(subscription *ResourceVolume
 
 // UpdateVolumePurchasingSubscriptionByID updates a volume purchasing subscription by its ID
 func (c *Client) UpdateVolumePurchasingSubscriptionByID(id string, subscription *ResourceVolumePurchasingSubscription) (*ResourceVolumePurchasingSubscription, error) {
+	c, done := c.startOperation("UpdateVolumePurchasingSubscriptionByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriVolumePurchasingSubscriptions, id)
 
 	var updatedSubscription ResourceVolumePurchasingSubscription
-	resp, err := c.HTTP.DoRequest("PUT", endpoint, subscription, &updatedSubscription)
+	resp, err := c.doRequest("PUT", endpoint, subscription, &updatedSubscription)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update volume purchasing subscription with ID %s: %v", id, err)
+		return nil, fmt.Errorf("failed to update volume purchasing subscription with ID %s: %w", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -148,6 +140,9 @@ func (c *Client) UpdateVolumePurchasingSubscriptionByID(id string, subscription
 
 // UpdateVolumePurchasingSubscriptionByNameByID updates a volume purchasing subscription by its display name
 func (c *Client) UpdateVolumePurchasingSubscriptionByName(name string, updateData *ResourceVolumePurchasingSubscription) (*ResourceVolumePurchasingSubscription, error) {
+	c, done := c.startOperation("UpdateVolumePurchasingSubscriptionByName")
+	defer done()
+
 	target, err := c.GetVolumePurchasingSubscriptionByName(name)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedGetByName, "volume purchasing subscription", name, err)
@@ -163,11 +158,14 @@ func (c *Client) UpdateVolumePurchasingSubscriptionByName(name string, updateDat
 
 // DeleteVolumePurchasingSubscriptionByID deletes a volume purchasing subscription by its ID
 func (c *Client) DeleteVolumePurchasingSubscriptionByID(id string) error {
+	c, done := c.startOperation("DeleteVolumePurchasingSubscriptionByID")
+	defer done()
+
 	endpoint := fmt.Sprintf("%s/%s", uriVolumePurchasingSubscriptions, id)
 
-	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	resp, err := c.doRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete volume purchasing subscription with ID %s: %v", id, err)
+		return fmt.Errorf("failed to delete volume purchasing subscription with ID %s: %w", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -179,6 +177,9 @@ func (c *Client) DeleteVolumePurchasingSubscriptionByID(id string) error {
 
 // DeleteVolumePurchasingSubscriptionByName finds a subscription by name and deletes it by its ID
 func (c *Client) DeleteVolumePurchasingSubscriptionByName(name string) error {
+	c, done := c.startOperation("DeleteVolumePurchasingSubscriptionByName")
+	defer done()
+
 	target, err := c.GetVolumePurchasingSubscriptionByName(name)
 	if err != nil {
 		return fmt.Errorf(errMsgFailedGetByName, "volume purchasing subscription", name, err)
@@ -0,0 +1,202 @@
+// shared_errors.go
+// Error types returned by the SDK which callers can inspect with errors.Is and errors.As.
+//
+// Every error returned by a resource method wraps the underlying cause, so a failed request can be
+// classified regardless of which method returned it:
+//
+//	_, err := client.GetBuildingByID("42")
+//	if errors.Is(err, jamfpro.ErrNotFound) {
+//		// the building does not exist
+//	}
+//
+//	var apiErr *jamfpro.APIError
+//	if errors.As(err, &apiErr) {
+//		fmt.Println(apiErr.StatusCode, apiErr.Errors)
+//	}
+package jamfpro
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+	"go.uber.org/zap"
+)
+
+// Sentinel errors matched by *APIError, and by the SDK's own lookup errors, with errors.Is.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("resource not found")
+	ErrConflict     = errors.New("resource conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// APIError is returned when Jamf Pro responds to a request with an error status code.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+
+	// Message is a summary of the error, such as the text of a Classic API HTML error page.
+	Message string
+
+	// Errors holds the 'errors' array returned by the Jamf Pro API.
+	Errors []APIErrorDetail
+
+	// Body is the raw response body, such as the HTML or XML error page returned by the Classic API.
+	Body string
+}
+
+// APIErrorDetail is a single entry of the 'errors' array returned by the Jamf Pro API.
+type APIErrorDetail struct {
+	Code        string `json:"code"`
+	Field       string `json:"field"`
+	Description string `json:"description"`
+	ID          string `json:"id"`
+}
+
+// jamfProErrorResponse is the error body returned by the Jamf Pro API. Error ids are returned as
+// either strings or numbers depending on the endpoint.
+type jamfProErrorResponse struct {
+	HTTPStatus int `json:"httpStatus"`
+	Errors     []struct {
+		Code        string      `json:"code"`
+		Field       string      `json:"field"`
+		Description string      `json:"description"`
+		ID          interface{} `json:"id"`
+	} `json:"errors"`
+}
+
+func (e *APIError) Error() string {
+	var details []string
+	for _, detail := range e.Errors {
+		switch {
+		case detail.Field != "":
+			details = append(details, fmt.Sprintf("%s %s: %s", detail.Code, detail.Field, detail.Description))
+		default:
+			details = append(details, fmt.Sprintf("%s: %s", detail.Code, detail.Description))
+		}
+	}
+
+	message := strings.Join(details, "; ")
+	if message == "" {
+		message = e.Message
+	}
+
+	status := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if message == "" {
+		return fmt.Sprintf("%s %s: %s", e.Method, e.Endpoint, status)
+	}
+	return fmt.Sprintf("%s %s: %s: %s", e.Method, e.Endpoint, status, message)
+}
+
+// Is reports whether the error's status code corresponds to target, one of the sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// HasCode reports whether the Jamf Pro API returned an error with the given code, such as "DUPLICATE_FIELD".
+func (e *APIError) HasCode(code string) bool {
+	for _, detail := range e.Errors {
+		if detail.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// newAPIError converts an error response into an *APIError. The 'errors' array of a Jamf Pro API
+// JSON body is decoded directly, while the message of HTML, XML and text bodies, such as Classic API
+// error pages, is extracted by the http client.
+func newAPIError(resp *http.Response, sugar *zap.SugaredLogger) error {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to read error response body: %w", err)
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     resp.Request.Method,
+		Endpoint:   resp.Request.URL.String(),
+		Body:       string(data),
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != "application/json" {
+		parsed := *resp
+		parsed.Body = io.NopCloser(bytes.NewReader(data))
+		apiErr.Message = response.HandleAPIErrorResponse(&parsed, sugar).Message
+		return apiErr
+	}
+
+	var body jamfProErrorResponse
+	if json.Unmarshal(data, &body) == nil {
+		for _, detail := range body.Errors {
+			id := ""
+			if detail.ID != nil {
+				id = fmt.Sprint(detail.ID)
+			}
+			apiErr.Errors = append(apiErr.Errors, APIErrorDetail{
+				Code:        detail.Code,
+				Field:       detail.Field,
+				Description: detail.Description,
+				ID:          id,
+			})
+		}
+	}
+
+	return apiErr
+}
+
+// NotFoundError is returned by ByName lookups when no resource matches the given name.
+// It matches ErrNotFound with errors.Is.
+type NotFoundError struct {
+	Resource string
+	Name     string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s with name %q does not exist", e.Resource, e.Name)
+}
+
+// Is reports whether target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// AmbiguousError is returned by ByName lookups when more than one resource matches the given name.
+type AmbiguousError struct {
+	Resource string
+	Name     string
+	Count    int
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("%d %s resources match name %q, expected exactly one", e.Count, e.Resource, e.Name)
+}
@@ -0,0 +1,80 @@
+// shared_errors_test.go
+package jamfpro
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		sentinel    error
+		wantErrors  []APIErrorDetail
+	}{
+		{
+			name:        "Jamf Pro API not found",
+			status:      http.StatusNotFound,
+			contentType: "application/json;charset=UTF-8",
+			body:        `{"httpStatus":404,"errors":[{"code":"INVALID_ID","description":"Building not found","id":"42","field":null}]}`,
+			sentinel:    ErrNotFound,
+			wantErrors:  []APIErrorDetail{{Code: "INVALID_ID", Description: "Building not found", ID: "42"}},
+		},
+		{
+			name:        "Jamf Pro API conflict with numeric id",
+			status:      http.StatusConflict,
+			contentType: "application/json",
+			body:        `{"httpStatus":409,"errors":[{"code":"DUPLICATE_FIELD","field":"name","description":"duplicate name","id":0}]}`,
+			sentinel:    ErrConflict,
+			wantErrors:  []APIErrorDetail{{Code: "DUPLICATE_FIELD", Field: "name", Description: "duplicate name", ID: "0"}},
+		},
+		{
+			name:        "Classic API unauthorized",
+			status:      http.StatusUnauthorized,
+			contentType: "text/html",
+			body:        `<html><body><p>The request requires user authentication</p></body></html>`,
+			sentinel:    ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			_, err := newTestClient(t, handler, 1).GetBuildingByID("42")
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("GetBuildingByID() error = %v, want errors.Is %v", err, tt.sentinel)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("GetBuildingByID() error = %v, want *APIError", err)
+			}
+
+			if apiErr.StatusCode != tt.status || apiErr.Method != http.MethodGet {
+				t.Errorf("APIError status and method = %d %s, want %d GET", apiErr.StatusCode, apiErr.Method, tt.status)
+			}
+
+			if apiErr.Body != tt.body {
+				t.Errorf("APIError.Body = %q, want %q", apiErr.Body, tt.body)
+			}
+
+			if len(apiErr.Errors) != len(tt.wantErrors) {
+				t.Fatalf("APIError.Errors = %+v, want %+v", apiErr.Errors, tt.wantErrors)
+			}
+			for i := range tt.wantErrors {
+				if apiErr.Errors[i] != tt.wantErrors[i] {
+					t.Errorf("APIError.Errors[%d] = %+v, want %+v", i, apiErr.Errors[i], tt.wantErrors[i])
+				}
+			}
+		})
+	}
+}
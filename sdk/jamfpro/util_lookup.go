@@ -0,0 +1,49 @@
+// util_lookup.go
+// Server side lookups of single Jamf Pro API resources by name.
+package jamfpro
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
+)
+
+// getOneByName fetches the single resource whose field, as returned by nameOf, equals name using an
+// RSQL filter. Jamf Pro compares names case insensitively, so when the filter matches several
+// resources they are all fetched and compared exactly. It returns a *NotFoundError when nothing
+// matches and an *AmbiguousError when several resources match.
+func getOneByName[T any](c *Client, endpoint, resource, field, name string, nameOf func(T) string) (*T, error) {
+	filter := rsql.Eq(field, name)
+	resp, err := NewPaginator[T](c, endpoint).PageSize(1).Where(filter).Page(startingPageNumber)
+	if err == nil && resp.TotalCount > 1 {
+		resp, err = NewPaginator[T](c, endpoint).Where(filter).All()
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errMsgFailedGetByName, resource, name, err)
+	}
+
+	return findOneByName(resp.Results, resource, name, nameOf)
+}
+
+// findOneByName scans results for the single resource whose name, as returned by nameOf, equals
+// name. It is used for endpoints which do not support RSQL filtering, and returns the same errors
+// as getOneByName.
+func findOneByName[T any](results []T, resource, name string, nameOf func(T) string) (*T, error) {
+	var match *T
+	count := 0
+	for i := range results {
+		if nameOf(results[i]) == name {
+			match = &results[i]
+			count++
+		}
+	}
+
+	switch {
+	case count == 0:
+		return nil, &NotFoundError{Resource: resource, Name: name}
+	case count > 1:
+		return nil, &AmbiguousError{Resource: resource, Name: name, Count: count}
+	}
+
+	return match, nil
+}
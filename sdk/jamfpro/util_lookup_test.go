@@ -0,0 +1,90 @@
+// util_lookup_test.go
+package jamfpro
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestGetOneByName(t *testing.T) {
+	tests := []struct {
+		name       string
+		lookup     string
+		wantFilter string
+		// matches are the names the server returns for the filter, as Jamf Pro compares them case
+		// insensitively.
+		matches []string
+		wantID  string
+		wantErr error
+	}{
+		{"Single match", "Head Office", `name=="Head Office"`, []string{"Head Office"}, "1", nil},
+		{"No match", "Head Office", `name=="Head Office"`, nil, "", &NotFoundError{}},
+		{"Match in another case", "Head Office", `name=="Head Office"`, []string{"head office"}, "", &NotFoundError{}},
+		{"Exact match among others", "Head Office", `name=="Head Office"`, []string{"HEAD OFFICE", "Head Office", "head office"}, "2", nil},
+		{"Several exact matches", "Head Office", `name=="Head Office"`, []string{"Head Office", "Head Office", "Head Office"}, "", &AmbiguousError{}},
+		{"Wildcard in name", "Head*", `name=="Head\*"`, []string{"Head Office", "Head*"}, "2", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query := r.URL.Query()
+				if got := query.Get("filter"); got != tt.wantFilter {
+					t.Errorf("filter = %s, want %s", got, tt.wantFilter)
+				}
+				page, _ := strconv.Atoi(query.Get("page"))
+				pageSize, _ := strconv.Atoi(query.Get("page-size"))
+
+				out := PaginatedResponse[ResourceBuilding]{TotalCount: len(tt.matches), Results: []ResourceBuilding{}}
+				for i := page * pageSize; i < len(tt.matches) && i < (page+1)*pageSize; i++ {
+					out.Results = append(out.Results, ResourceBuilding{ID: strconv.Itoa(i + 1), Name: tt.matches[i]})
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(out)
+			})
+
+			building, err := newTestClient(t, handler, 1).GetBuildingByName(tt.lookup)
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("GetBuildingByName() returned error: %v", err)
+				}
+				if building.ID != tt.wantID || building.Name != tt.lookup {
+					t.Errorf("GetBuildingByName() = %s %q, want %s %q", building.ID, building.Name, tt.wantID, tt.lookup)
+				}
+			case *NotFoundError:
+				if !errors.As(err, &want) {
+					t.Fatalf("GetBuildingByName() error = %v, want *NotFoundError", err)
+				}
+			case *AmbiguousError:
+				if !errors.As(err, &want) || want.Count != len(tt.matches) {
+					t.Fatalf("GetBuildingByName() error = %v, want *AmbiguousError with count %d", err, len(tt.matches))
+				}
+			}
+		})
+	}
+}
+
+func TestFindOneByName(t *testing.T) {
+	names := []string{"a", "b", "b"}
+	nameOf := func(value string) string { return value }
+
+	if got, err := findOneByName(names, "test", "a", nameOf); err != nil || *got != "a" {
+		t.Errorf("findOneByName(a) = %v, %v, want a", got, err)
+	}
+
+	var notFound *NotFoundError
+	if _, err := findOneByName(names, "test", "c", nameOf); !errors.As(err, &notFound) {
+		t.Errorf("findOneByName(c) error = %v, want *NotFoundError", err)
+	}
+
+	var ambiguous *AmbiguousError
+	if _, err := findOneByName(names, "test", "b", nameOf); !errors.As(err, &ambiguous) || ambiguous.Count != 2 {
+		t.Errorf("findOneByName(b) error = %v, want *AmbiguousError with count 2", err)
+	}
+}
@@ -0,0 +1,306 @@
+// util_package_inspect.go
+// This utility inspects local flat package files to fill in package metadata and to generate the MDM
+// InstallApplication manifests used by AssignManifestToPackageByID and
+// SendMDMCommandForPackageDeployment.
+// Manifest reference: https://developer.apple.com/documentation/devicemanagement/installapplicationcommand/command/manifesturl
+package jamfpro
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/flatpkg"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
+	"howett.net/plist"
+)
+
+// PackageManifestDefaultChunkSize is the size of the chunks hashed for InstallApplication manifests
+// when none is given.
+const PackageManifestDefaultChunkSize = 10 * 1024 * 1024
+
+// PackageInspectOptions configures InspectPackageFile.
+type PackageInspectOptions struct {
+	// ChunkSize is the size of the chunks hashed for manifests. Defaults to PackageManifestDefaultChunkSize.
+	ChunkSize int64
+}
+
+// PackageInspection describes a local package file.
+type PackageInspection struct {
+	Path     string `json:"path"`
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+	MD5      string `json:"md5"`
+	SHA256   string `json:"sha256"`
+	SHA512   string `json:"sha512"`
+	// ChunkSize, ChunkMD5s and ChunkSHA256s are the hashes of consecutive chunks of the file.
+	ChunkSize    int64    `json:"chunkSize"`
+	ChunkMD5s    []string `json:"chunkMd5s"`
+	ChunkSHA256s []string `json:"chunkSha256s"`
+	// Info is what the package's Distribution and PackageInfo files declare.
+	Info *flatpkg.Info `json:"info"`
+}
+
+// PackageManifestOptions configures PackageInspection.InstallManifest.
+type PackageManifestOptions struct {
+	// SHA256 hashes chunks with SHA-256 instead of MD5.
+	SHA256 bool
+	// Title defaults to the package title, or the file name without its extension.
+	Title    string
+	Subtitle string
+}
+
+// PackageInstallManifest is an MDM InstallApplication manifest for a package.
+type PackageInstallManifest struct {
+	Items []PackageInstallManifestItem `plist:"items"`
+}
+
+// PackageInstallManifestItem is the single item of an InstallApplication manifest.
+type PackageInstallManifestItem struct {
+	Assets   []PackageInstallManifestAsset  `plist:"assets"`
+	Metadata PackageInstallManifestMetadata `plist:"metadata"`
+}
+
+// PackageInstallManifestAsset locates the package file and holds the hashes of its chunks.
+type PackageInstallManifestAsset struct {
+	Kind       string   `plist:"kind"`
+	MD5Size    int64    `plist:"md5-size,omitempty"`
+	MD5s       []string `plist:"md5s,omitempty"`
+	SHA256Size int64    `plist:"sha256-size,omitempty"`
+	SHA256s    []string `plist:"sha256s,omitempty"`
+	URL        string   `plist:"url"`
+}
+
+// PackageInstallManifestMetadata identifies what the package installs.
+type PackageInstallManifestMetadata struct {
+	BundleIdentifier string                         `plist:"bundle-identifier"`
+	BundleVersion    string                         `plist:"bundle-version"`
+	Items            []PackageInstallManifestBundle `plist:"items,omitempty"`
+	Kind             string                         `plist:"kind"`
+	SizeInBytes      int64                          `plist:"sizeInBytes"`
+	Title            string                         `plist:"title"`
+	Subtitle         string                         `plist:"subtitle,omitempty"`
+}
+
+// PackageInstallManifestBundle is a bundle installed by the package.
+type PackageInstallManifestBundle struct {
+	BundleIdentifier string `plist:"bundle-identifier"`
+	BundleVersion    string `plist:"bundle-version"`
+}
+
+// InspectPackageFile hashes a local flat package file and reads the identifiers, versions, installed
+// size and signing certificate names it declares. The file is read once, however large it is.
+//
+// Example usage:
+//
+//	inspection, err := jamfpro.InspectPackageFile("Firefox.pkg", nil)
+//	pkg := inspection.ResourcePackage(&jamfpro.ResourcePackage{CategoryID: "5"})
+//	created, err := client.CreatePackage(pkg)
+//
+//	manifest, err := inspection.InstallManifest("https://cdn.example.com/Firefox.pkg", nil)
+//	err = manifest.WriteFile("Firefox.plist")
+//	_, err = client.AssignManifestToPackageByID(created.ID, "Firefox.plist")
+func InspectPackageFile(path string, options *PackageInspectOptions) (*PackageInspection, error) {
+	chunkSize := int64(PackageManifestDefaultChunkSize)
+	if options != nil && options.ChunkSize > 0 {
+		chunkSize = options.ChunkSize
+	}
+
+	file, size, err := helpers.OpenJCDSPackageFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	archive, err := flatpkg.NewArchive(file, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect package %s: %w", path, err)
+	}
+	info, err := flatpkg.InspectArchive(archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect package %s: %w", path, err)
+	}
+
+	inspection := &PackageInspection{
+		Path:      path,
+		FileName:  filepath.Base(path),
+		Size:      size,
+		ChunkSize: chunkSize,
+		Info:      info,
+	}
+	if err := inspection.hash(file); err != nil {
+		return nil, fmt.Errorf("failed to hash package %s: %w", path, err)
+	}
+	return inspection, nil
+}
+
+// hash records the whole-file and chunk hashes of file.
+func (p *PackageInspection) hash(file *os.File) error {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	md5Hash, sha256Hash, sha512Hash := md5.New(), sha256.New(), sha512.New()
+	chunkMD5, chunkSHA256 := md5.New(), sha256.New()
+	whole := io.MultiWriter(md5Hash, sha256Hash, sha512Hash, chunkMD5, chunkSHA256)
+	sum := func(h hash.Hash) string { return hex.EncodeToString(h.Sum(nil)) }
+
+	for {
+		n, err := io.CopyN(whole, file, p.ChunkSize)
+		if n > 0 {
+			p.ChunkMD5s = append(p.ChunkMD5s, sum(chunkMD5))
+			p.ChunkSHA256s = append(p.ChunkSHA256s, sum(chunkSHA256))
+			chunkMD5.Reset()
+			chunkSHA256.Reset()
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	p.MD5, p.SHA256, p.SHA512 = sum(md5Hash), sum(sha256Hash), sum(sha512Hash)
+	return nil
+}
+
+// ResourcePackage returns package metadata for the file, starting from template as UploadPackages
+// does. The hashes and size are always set. When the template has none, Info describes the package and
+// OSRequirements is the minimum macOS version of its Distribution.
+func (p *PackageInspection) ResourcePackage(template *ResourcePackage) ResourcePackage {
+	pkg := newPackageFromTemplate(template, p.FileName)
+	setPackageHashes(&pkg, p.MD5, p.SHA512)
+	pkg.SHA256 = p.SHA256
+	pkg.Size = strconv.FormatInt(p.Size, 10)
+	if pkg.Info == "" {
+		pkg.Info = p.summary()
+	}
+	if pkg.OSRequirements == "" {
+		pkg.OSRequirements = p.Info.MinimumOSVersion
+	}
+	return pkg
+}
+
+// summary describes the package in a few lines.
+func (p *PackageInspection) summary() string {
+	lines := []string{strings.TrimSpace(p.Info.Identifier + " " + p.Info.Version)}
+	if ids := p.Info.BundleIdentifiers(); len(ids) > 0 {
+		lines = append(lines, "Bundles: "+strings.Join(ids, ", "))
+	}
+	if p.Info.MinimumOSVersion != "" {
+		lines = append(lines, "Minimum macOS: "+p.Info.MinimumOSVersion)
+	}
+	if len(p.Info.Certificates) > 0 {
+		lines = append(lines, "Signed by: "+p.Info.Certificates[0])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// InstallManifest returns an InstallApplication manifest for the package file served at url.
+func (p *PackageInspection) InstallManifest(url string, options *PackageManifestOptions) (*PackageInstallManifest, error) {
+	if url == "" {
+		return nil, fmt.Errorf("a package URL is required for the manifest")
+	}
+	var opts PackageManifestOptions
+	if options != nil {
+		opts = *options
+	}
+
+	asset := PackageInstallManifestAsset{Kind: "software-package", URL: url}
+	if opts.SHA256 {
+		asset.SHA256Size, asset.SHA256s = p.ChunkSize, p.ChunkSHA256s
+	} else {
+		asset.MD5Size, asset.MD5s = p.ChunkSize, p.ChunkMD5s
+	}
+
+	bundleID, bundleVersion := p.primaryBundle()
+	metadata := PackageInstallManifestMetadata{
+		BundleIdentifier: bundleID,
+		BundleVersion:    bundleVersion,
+		Kind:             "software",
+		SizeInBytes:      p.Size,
+		Title:            opts.Title,
+		Subtitle:         opts.Subtitle,
+	}
+	if metadata.Title == "" {
+		metadata.Title = p.title()
+	}
+	for _, component := range p.Info.Components {
+		for _, bundle := range component.Bundles {
+			if bundle.Identifier != "" && bundle.Version != "" {
+				metadata.Items = append(metadata.Items, PackageInstallManifestBundle{BundleIdentifier: bundle.Identifier, BundleVersion: bundle.Version})
+			}
+		}
+	}
+
+	return &PackageInstallManifest{
+		Items: []PackageInstallManifestItem{{Assets: []PackageInstallManifestAsset{asset}, Metadata: metadata}},
+	}, nil
+}
+
+// DeployManifest returns the manifest for SendMDMCommandForPackageDeployment, which hashes the whole
+// file with MD5.
+func (p *PackageInspection) DeployManifest(url string) PackageManifest {
+	bundleID, bundleVersion := p.primaryBundle()
+	return PackageManifest{
+		HashType:      "MD5",
+		URL:           url,
+		Hash:          p.MD5,
+		BundleID:      bundleID,
+		BundleVersion: bundleVersion,
+		Title:         p.title(),
+		SizeInBytes:   int(p.Size),
+	}
+}
+
+// primaryBundle returns the first versioned bundle the package installs, or the package identifier
+// and version when it installs none.
+func (p *PackageInspection) primaryBundle() (string, string) {
+	for _, component := range p.Info.Components {
+		for _, bundle := range component.Bundles {
+			if bundle.Identifier != "" && bundle.Version != "" {
+				return bundle.Identifier, bundle.Version
+			}
+		}
+	}
+	return p.Info.Identifier, p.Info.Version
+}
+
+// title returns the package title, or the file name without its extension.
+func (p *PackageInspection) title() string {
+	if p.Info.Title != "" {
+		return p.Info.Title
+	}
+	return strings.TrimSuffix(p.FileName, filepath.Ext(p.FileName))
+}
+
+// Encode returns the manifest as an XML property list.
+func (m *PackageInstallManifest) Encode() ([]byte, error) {
+	data, err := plist.MarshalIndent(m, plist.XMLFormat, "\t")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode package manifest: %w", err)
+	}
+	return data, nil
+}
+
+// WriteFile writes the manifest to path as an XML property list, ready for AssignManifestToPackageByID.
+func (m *PackageInstallManifest) WriteFile(path string) error {
+	data, err := m.Encode()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write package manifest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// util_package_inspect_test.go
+package jamfpro_test
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"howett.net/plist"
+)
+
+// samplePackage is a signed product archive written by the flatpkg test data generator.
+const samplePackage = "../flatpkg/testdata/Signed.pkg"
+
+func TestInspectPackageFile(t *testing.T) {
+	data, err := os.ReadFile(samplePackage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inspection, err := jamfpro.InspectPackageFile(samplePackage, &jamfpro.PackageInspectOptions{ChunkSize: 16 * 1024})
+	if err != nil {
+		t.Fatalf("InspectPackageFile() returned error: %v", err)
+	}
+
+	var chunkMD5s, chunkSHA256s []string
+	for offset := 0; offset < len(data); offset += 16 * 1024 {
+		chunk := data[offset:min(offset+16*1024, len(data))]
+		md5Sum, sha256Sum := md5.Sum(chunk), sha256.Sum256(chunk)
+		chunkMD5s = append(chunkMD5s, hex.EncodeToString(md5Sum[:]))
+		chunkSHA256s = append(chunkSHA256s, hex.EncodeToString(sha256Sum[:]))
+	}
+	md5Sum := md5.Sum(data)
+	if inspection.Size != int64(len(data)) || inspection.MD5 != hex.EncodeToString(md5Sum[:]) {
+		t.Errorf("InspectPackageFile() size %d, md5 %s", inspection.Size, inspection.MD5)
+	}
+	if !reflect.DeepEqual(inspection.ChunkMD5s, chunkMD5s) || !reflect.DeepEqual(inspection.ChunkSHA256s, chunkSHA256s) {
+		t.Errorf("chunk hashes = %v, %v, want %v, %v", inspection.ChunkMD5s, inspection.ChunkSHA256s, chunkMD5s, chunkSHA256s)
+	}
+	if inspection.Info.Identifier != "org.mozilla.firefox.product" || inspection.Info.Version != "128.0" {
+		t.Errorf("Info = %+v", inspection.Info)
+	}
+
+	pkg := inspection.ResourcePackage(&jamfpro.ResourcePackage{CategoryID: "5"})
+	wantInfo := "org.mozilla.firefox.product 128.0\n" +
+		"Bundles: org.mozilla.firefox, org.mozilla.plugincontainer\n" +
+		"Minimum macOS: 10.15\n" +
+		"Signed by: Developer ID Installer: Example Corp (ABCDE12345)"
+	if pkg.FileName != "Signed.pkg" || pkg.CategoryID != "5" || pkg.MD5 != inspection.MD5 || pkg.SHA256 != inspection.SHA256 ||
+		pkg.HashType != "SHA_512" || pkg.HashValue != inspection.SHA512 || pkg.Size != "56026" || pkg.Info != wantInfo || pkg.OSRequirements != "10.15" {
+		t.Errorf("ResourcePackage() = %+v", pkg)
+	}
+	if pkg := inspection.ResourcePackage(&jamfpro.ResourcePackage{OSRequirements: "14.x"}); pkg.OSRequirements != "14.x" {
+		t.Errorf("ResourcePackage() OSRequirements = %q, want the template's", pkg.OSRequirements)
+	}
+
+	if deploy := inspection.DeployManifest("https://cdn.example.com/Signed.pkg"); deploy.BundleID != "org.mozilla.firefox" ||
+		deploy.BundleVersion != "128.0" || deploy.Hash != inspection.MD5 || deploy.Title != "Firefox" {
+		t.Errorf("DeployManifest() = %+v", deploy)
+	}
+
+	notFlat, _ := writePackage(t, "Bundle.pkg", 64)
+	if _, err := jamfpro.InspectPackageFile(notFlat, nil); err == nil {
+		t.Error("InspectPackageFile() of a file which is not a flat package returned no error")
+	}
+}
+
+func TestPackageInstallManifest(t *testing.T) {
+	inspection, err := jamfpro.InspectPackageFile(samplePackage, &jamfpro.PackageInspectOptions{ChunkSize: 16 * 1024})
+	if err != nil {
+		t.Fatalf("InspectPackageFile() returned error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		options *jamfpro.PackageManifestOptions
+		hashKey string
+		hashes  []string
+		title   string
+	}{
+		{"md5", nil, "md5s", inspection.ChunkMD5s, "Firefox"},
+		{"sha256", &jamfpro.PackageManifestOptions{SHA256: true, Title: "Mozilla Firefox"}, "sha256s", inspection.ChunkSHA256s, "Mozilla Firefox"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, err := inspection.InstallManifest("https://cdn.example.com/Signed.pkg", tt.options)
+			if err != nil {
+				t.Fatalf("InstallManifest() returned error: %v", err)
+			}
+			path := filepath.Join(t.TempDir(), "manifest.plist")
+			if err := manifest.WriteFile(path); err != nil {
+				t.Fatalf("WriteFile() returned error: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded struct {
+				Items []struct {
+					Assets   []map[string]interface{} `plist:"assets"`
+					Metadata map[string]interface{}   `plist:"metadata"`
+				} `plist:"items"`
+			}
+			if _, err := plist.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("manifest is not a property list: %v", err)
+			}
+
+			asset, metadata := decoded.Items[0].Assets[0], decoded.Items[0].Metadata
+			var hashes []string
+			for _, h := range asset[tt.hashKey].([]interface{}) {
+				hashes = append(hashes, h.(string))
+			}
+			if asset["kind"] != "software-package" || asset["url"] != "https://cdn.example.com/Signed.pkg" || !reflect.DeepEqual(hashes, tt.hashes) {
+				t.Errorf("asset = %v", asset)
+			}
+			if metadata["bundle-identifier"] != "org.mozilla.firefox" || metadata["bundle-version"] != "128.0" ||
+				metadata["kind"] != "software" || metadata["title"] != tt.title || metadata["sizeInBytes"] != uint64(inspection.Size) {
+				t.Errorf("metadata = %v", metadata)
+			}
+			if items := metadata["items"].([]interface{}); len(items) != 2 {
+				t.Errorf("metadata items = %v, want both bundles", items)
+			}
+		})
+	}
+
+	if _, err := inspection.InstallManifest("", nil); err == nil {
+		t.Error("InstallManifest() without a URL returned no error")
+	}
+}
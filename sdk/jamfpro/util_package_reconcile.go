@@ -0,0 +1,221 @@
+// util_package_reconcile.go
+// This utility reconciles package metadata with the files held in JCDS 2.0, reporting files with no
+// metadata, metadata with no file, renamed files and hash mismatches, and optionally deleting the strays.
+// Requires jamf pro v11.5 or later
+package jamfpro
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PackageReconcileOptions configures ReconcilePackages. Nothing is deleted unless asked for.
+type PackageReconcileOptions struct {
+	// DeleteOrphanFiles deletes JCDS files which no package metadata references, with DeleteJCDS2PackageV2.
+	// A file uploaded moments before its metadata is created is also an orphan, so avoid running this
+	// alongside uploads.
+	DeleteOrphanFiles bool
+	// DeleteMissingPackages deletes package metadata whose file is not in JCDS, with
+	// DeleteMultiplePackagesByID. Packages whose file Jamf Pro is still transferring to the cloud are
+	// kept. Only JCDS files are compared, so packages whose files are served from another
+	// distribution point, such as a file share, are all missing; do not set this on such tenants.
+	DeleteMissingPackages bool
+}
+
+// PackageHashMismatch is package metadata whose recorded MD5 differs from that of its JCDS file.
+type PackageHashMismatch struct {
+	PackageID   string `json:"packageId"`
+	PackageName string `json:"packageName"`
+	FileName    string `json:"fileName"`
+	MetadataMD5 string `json:"metadataMd5"`
+	JCDSMD5     string `json:"jcdsMd5"`
+}
+
+// PackageRenamedFile is package metadata whose file name is not in JCDS, but whose MD5 matches a JCDS
+// file of another name, as happens when a file is renamed after upload.
+type PackageRenamedFile struct {
+	PackageID    string `json:"packageId"`
+	PackageName  string `json:"packageName"`
+	FileName     string `json:"fileName"`
+	JCDSFileName string `json:"jcdsFileName"`
+	MD5          string `json:"md5"`
+}
+
+// PackageReconciliation reports how package metadata and JCDS files differ.
+type PackageReconciliation struct {
+	// OrphanFiles are JCDS files which no package metadata references by file name or MD5.
+	OrphanFiles []ResponseJCDS2List `json:"orphanFiles"`
+	// MissingFiles are packages whose file is not in JCDS under its name or MD5.
+	MissingFiles []ResourcePackage `json:"missingFiles"`
+	// RenamedFiles pair packages and JCDS files which match by MD5 but not by file name. They are never
+	// deleted; update the package's file name to fix them.
+	RenamedFiles []PackageRenamedFile `json:"renamedFiles"`
+	// HashMismatches are packages whose file is in JCDS with a different MD5. They are never deleted,
+	// since either side may be the one to keep; upload the file again to fix them.
+	HashMismatches []PackageHashMismatch `json:"hashMismatches"`
+	// Matched counts packages whose file is in JCDS with the same MD5, or whose metadata records no MD5.
+	Matched int `json:"matched"`
+	// DeletedFiles and DeletedPackageIDs list what cleanup removed.
+	DeletedFiles      []string `json:"deletedFiles,omitempty"`
+	DeletedPackageIDs []string `json:"deletedPackageIds,omitempty"`
+	// TransferringPackageIDs are packages with missing files which DeleteMissingPackages kept, because
+	// their CloudTransferStatus shows a transfer in progress.
+	TransferringPackageIDs []string `json:"transferringPackageIds,omitempty"`
+}
+
+// Clean reports whether metadata and files agree.
+func (r *PackageReconciliation) Clean() bool {
+	return len(r.OrphanFiles) == 0 && len(r.MissingFiles) == 0 && len(r.HashMismatches) == 0 && len(r.RenamedFiles) == 0
+}
+
+// ReconcilePackages joins the JCDS 2.0 file list with the package metadata by file name and compares
+// their MD5 hashes. Packages and files left unmatched by name are then matched by MD5, so a renamed
+// file is reported as such rather than as an orphan and a missing file. With options it then deletes
+// orphan files and packages with missing files; a failed deletion does not stop the others, and
+// failures are returned as a joined error alongside the report. DeleteMissingPackages is refused when
+// JCDS lists no files at all, since every package would otherwise be deleted. In dry-run mode the
+// deletions are recorded in the plan instead.
+//
+// Example usage:
+//
+//	report, err := client.ReconcilePackages(&jamfpro.PackageReconcileOptions{DeleteOrphanFiles: true})
+//	for _, name := range report.DeletedFiles {
+//		fmt.Println("deleted orphan", name)
+//	}
+func (c *Client) ReconcilePackages(options *PackageReconcileOptions) (*PackageReconciliation, error) {
+	c, done := c.startOperation("ReconcilePackages")
+	defer done()
+
+	var opts PackageReconcileOptions
+	if options != nil {
+		opts = *options
+	}
+
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list JCDS 2.0 files: %w", err)
+	}
+	packages, err := c.GetPackages("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	report := reconcilePackages(files, packages.Results)
+	c.Logger().Info("Reconciled packages with JCDS 2.0", "matched", report.Matched, "orphan_files", len(report.OrphanFiles),
+		"missing_files", len(report.MissingFiles), "renamed_files", len(report.RenamedFiles), "hash_mismatches", len(report.HashMismatches))
+
+	var errs []error
+	if opts.DeleteOrphanFiles {
+		for _, file := range report.OrphanFiles {
+			if err := c.DeleteJCDS2PackageV2(file.FileName); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", file.FileName, err))
+				continue
+			}
+			report.DeletedFiles = append(report.DeletedFiles, file.FileName)
+		}
+	}
+	if opts.DeleteMissingPackages && len(report.MissingFiles) > 0 && len(files) == 0 {
+		errs = append(errs, fmt.Errorf("refusing to delete %d packages because JCDS 2.0 lists no files", len(report.MissingFiles)))
+	} else if opts.DeleteMissingPackages && len(report.MissingFiles) > 0 {
+		ids := make([]string, 0, len(report.MissingFiles))
+		for _, pkg := range report.MissingFiles {
+			if cloudTransferInProgress(pkg) {
+				report.TransferringPackageIDs = append(report.TransferringPackageIDs, pkg.ID)
+				continue
+			}
+			ids = append(ids, pkg.ID)
+		}
+		if len(ids) > 0 {
+			if err := c.DeleteMultiplePackagesByID(ids); err != nil {
+				errs = append(errs, err)
+			} else {
+				report.DeletedPackageIDs = ids
+			}
+		}
+	}
+
+	return report, errors.Join(errs...)
+}
+
+// reconcilePackages compares files and packages, sorting each list in the report by file name.
+func reconcilePackages(files []ResponseJCDS2List, packages []ResourcePackage) *PackageReconciliation {
+	report := &PackageReconciliation{}
+
+	jcdsFiles := make(map[string]ResponseJCDS2List, len(files))
+	filesByMD5 := make(map[string]ResponseJCDS2List, len(files))
+	for _, file := range files {
+		jcdsFiles[file.FileName] = file
+		if file.MD5 != "" {
+			filesByMD5[strings.ToLower(file.MD5)] = file
+		}
+	}
+
+	referenced := make(map[string]bool, len(packages))
+	for _, pkg := range packages {
+		metadataMD5 := packageMD5(pkg)
+		file, ok := jcdsFiles[pkg.FileName]
+		if !ok {
+			if renamed, ok := filesByMD5[strings.ToLower(metadataMD5)]; ok && metadataMD5 != "" {
+				referenced[renamed.FileName] = true
+				report.RenamedFiles = append(report.RenamedFiles, PackageRenamedFile{
+					PackageID:    pkg.ID,
+					PackageName:  pkg.PackageName,
+					FileName:     pkg.FileName,
+					JCDSFileName: renamed.FileName,
+					MD5:          renamed.MD5,
+				})
+				continue
+			}
+			report.MissingFiles = append(report.MissingFiles, pkg)
+			continue
+		}
+		referenced[pkg.FileName] = true
+
+		if metadataMD5 != "" && file.MD5 != "" && !strings.EqualFold(metadataMD5, file.MD5) {
+			report.HashMismatches = append(report.HashMismatches, PackageHashMismatch{
+				PackageID:   pkg.ID,
+				PackageName: pkg.PackageName,
+				FileName:    pkg.FileName,
+				MetadataMD5: metadataMD5,
+				JCDSMD5:     file.MD5,
+			})
+			continue
+		}
+		report.Matched++
+	}
+
+	for _, file := range files {
+		if !referenced[file.FileName] {
+			report.OrphanFiles = append(report.OrphanFiles, file)
+		}
+	}
+
+	sort.Slice(report.OrphanFiles, func(i, j int) bool { return report.OrphanFiles[i].FileName < report.OrphanFiles[j].FileName })
+	sort.Slice(report.MissingFiles, func(i, j int) bool { return report.MissingFiles[i].FileName < report.MissingFiles[j].FileName })
+	sort.Slice(report.HashMismatches, func(i, j int) bool { return report.HashMismatches[i].FileName < report.HashMismatches[j].FileName })
+	sort.Slice(report.RenamedFiles, func(i, j int) bool { return report.RenamedFiles[i].FileName < report.RenamedFiles[j].FileName })
+	return report
+}
+
+// cloudTransferInProgress reports whether Jamf Pro is still transferring the file of pkg to the cloud,
+// so that it is not yet in JCDS.
+func cloudTransferInProgress(pkg ResourcePackage) bool {
+	switch strings.ToUpper(pkg.CloudTransferStatus) {
+	case "PENDING", "IN_PROGRESS":
+		return true
+	}
+	return false
+}
+
+// packageMD5 returns the MD5 recorded in package metadata, or "".
+func packageMD5(pkg ResourcePackage) string {
+	if pkg.MD5 != "" {
+		return pkg.MD5
+	}
+	if strings.EqualFold(pkg.HashType, "MD5") {
+		return pkg.HashValue
+	}
+	return ""
+}
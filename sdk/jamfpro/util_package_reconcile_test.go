@@ -0,0 +1,191 @@
+// util_package_reconcile_test.go
+package jamfpro_test
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"slices"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+func TestReconcilePackages(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+
+	md5Hex := func(content string) string {
+		sum := md5.Sum([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+
+	srv.AddJCDSFile("Chrome.pkg", []byte("chrome"))
+	srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Chrome", FileName: "Chrome.pkg", MD5: md5Hex("chrome")})
+	// Zoom records only a SHA-512, which JCDS does not list, so it matches on file name alone.
+	srv.AddJCDSFile("Zoom.pkg", []byte("zoom"))
+	srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Zoom", FileName: "Zoom.pkg", HashType: "SHA_512", HashValue: "abc"})
+	srv.AddJCDSFile("Slack.pkg", []byte("slack 2"))
+	slackID, _ := srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Slack", FileName: "Slack.pkg", HashType: "MD5", HashValue: md5Hex("slack 1")})
+	missingID, _ := srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Teams", FileName: "Teams.pkg"})
+	// Office is not in JCDS yet because Jamf Pro is still transferring it, so it is never deleted.
+	officeID, _ := srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Office", FileName: "Office.pkg", CloudTransferStatus: "IN_PROGRESS"})
+	srv.AddJCDSFile("Old Firefox.pkg", []byte("firefox"))
+	// Firefox 128 was renamed in JCDS after upload, and still matches its metadata by MD5.
+	srv.AddJCDSFile("Firefox 128.pkg", []byte("firefox 128"))
+	firefoxID, _ := srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Firefox", FileName: "Firefox.pkg", MD5: md5Hex("firefox 128")})
+
+	tests := []struct {
+		name           string
+		options        *jamfpro.PackageReconcileOptions
+		orphans        []string
+		missing        []string
+		mismatches     []string
+		deletedFiles   []string
+		deletedPackage []string
+		transferring   []string
+	}{
+		{
+			name:       "report only",
+			orphans:    []string{"Old Firefox.pkg"},
+			missing:    []string{"Office.pkg", "Teams.pkg"},
+			mismatches: []string{"Slack.pkg"},
+		},
+		{
+			name:           "cleanup",
+			options:        &jamfpro.PackageReconcileOptions{DeleteOrphanFiles: true, DeleteMissingPackages: true},
+			orphans:        []string{"Old Firefox.pkg"},
+			missing:        []string{"Office.pkg", "Teams.pkg"},
+			mismatches:     []string{"Slack.pkg"},
+			deletedFiles:   []string{"Old Firefox.pkg"},
+			deletedPackage: []string{missingID},
+			transferring:   []string{officeID},
+		},
+		{
+			name:         "after cleanup",
+			options:      &jamfpro.PackageReconcileOptions{DeleteOrphanFiles: true, DeleteMissingPackages: true},
+			missing:      []string{"Office.pkg"},
+			mismatches:   []string{"Slack.pkg"},
+			transferring: []string{officeID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.ReconcilePackages(tt.options)
+			if err != nil {
+				t.Fatalf("ReconcilePackages() returned error: %v", err)
+			}
+
+			var orphans, missing, mismatches []string
+			for _, file := range report.OrphanFiles {
+				orphans = append(orphans, file.FileName)
+			}
+			for _, pkg := range report.MissingFiles {
+				missing = append(missing, pkg.FileName)
+			}
+			for _, mismatch := range report.HashMismatches {
+				mismatches = append(mismatches, mismatch.FileName)
+			}
+			if !slices.Equal(orphans, tt.orphans) || !slices.Equal(missing, tt.missing) || !slices.Equal(mismatches, tt.mismatches) {
+				t.Errorf("ReconcilePackages() orphans %v, missing %v, mismatches %v, want %v, %v, %v",
+					orphans, missing, mismatches, tt.orphans, tt.missing, tt.mismatches)
+			}
+			if !slices.Equal(report.DeletedFiles, tt.deletedFiles) || !slices.Equal(report.DeletedPackageIDs, tt.deletedPackage) {
+				t.Errorf("ReconcilePackages() deleted files %v, packages %v, want %v, %v",
+					report.DeletedFiles, report.DeletedPackageIDs, tt.deletedFiles, tt.deletedPackage)
+			}
+			if !slices.Equal(report.TransferringPackageIDs, tt.transferring) {
+				t.Errorf("ReconcilePackages() kept transferring packages %v, want %v", report.TransferringPackageIDs, tt.transferring)
+			}
+			if report.Matched != 2 || report.Clean() {
+				t.Errorf("ReconcilePackages() matched %d, clean %t, want 2 matched and not clean", report.Matched, report.Clean())
+			}
+		})
+	}
+
+	if mismatch, err := client.ReconcilePackages(nil); err != nil || mismatch.HashMismatches[0].PackageID != slackID ||
+		mismatch.HashMismatches[0].JCDSMD5 != md5Hex("slack 2") {
+		t.Errorf("hash mismatch = %+v, error %v", mismatch, err)
+	}
+	if _, ok := srv.JCDSFile("Old Firefox.pkg"); ok {
+		t.Error("orphan file was not deleted")
+	}
+	if n := srv.Len("/api/v1/packages"); n != 5 {
+		t.Errorf("server holds %d packages, want 5", n)
+	}
+
+	renamed, err := client.ReconcilePackages(nil)
+	want := []jamfpro.PackageRenamedFile{{PackageID: firefoxID, PackageName: "Firefox", FileName: "Firefox.pkg", JCDSFileName: "Firefox 128.pkg", MD5: md5Hex("firefox 128")}}
+	if err != nil || !slices.Equal(renamed.RenamedFiles, want) {
+		t.Errorf("renamed files = %+v, error %v, want %+v", renamed.RenamedFiles, err, want)
+	}
+	if _, ok := srv.JCDSFile("Firefox 128.pkg"); !ok {
+		t.Error("renamed file was deleted")
+	}
+}
+
+func TestReconcilePackagesEmptyJCDS(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+	srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Chrome", FileName: "Chrome.pkg"})
+	srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Slack", FileName: "Slack.pkg"})
+
+	report, err := client.ReconcilePackages(&jamfpro.PackageReconcileOptions{DeleteMissingPackages: true})
+	if err == nil {
+		t.Error("ReconcilePackages() deleted packages although JCDS listed no files")
+	}
+	if len(report.MissingFiles) != 2 || len(report.DeletedPackageIDs) != 0 {
+		t.Errorf("ReconcilePackages() missing %d, deleted %v", len(report.MissingFiles), report.DeletedPackageIDs)
+	}
+	if n := srv.Len("/api/v1/packages"); n != 2 {
+		t.Errorf("server holds %d packages, want 2", n)
+	}
+}
+
+func TestReconcilePackagesDryRun(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	srv.AddJCDSFile("Chrome.pkg", []byte("chrome"))
+	srv.AddJCDSFile("Old Firefox.pkg", []byte("firefox"))
+	srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Chrome", FileName: "Chrome.pkg"})
+	missingID, _ := srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Teams", FileName: "Teams.pkg"})
+
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithDryRun())
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	report, err := client.ReconcilePackages(&jamfpro.PackageReconcileOptions{DeleteOrphanFiles: true, DeleteMissingPackages: true})
+	if err != nil {
+		t.Fatalf("ReconcilePackages() returned error: %v", err)
+	}
+	if !slices.Equal(report.DeletedFiles, []string{"Old Firefox.pkg"}) || !slices.Equal(report.DeletedPackageIDs, []string{missingID}) {
+		t.Errorf("ReconcilePackages() deleted files %v, packages %v", report.DeletedFiles, report.DeletedPackageIDs)
+	}
+
+	var operations []string
+	for _, op := range client.Plan().Operations() {
+		operations = append(operations, op.Operation)
+	}
+	if want := []string{"jamfpro.DeleteJCDS2PackageV2", "jamfpro.DeleteMultiplePackagesByID"}; !slices.Equal(operations, want) {
+		t.Errorf("plan = %v, want %v", operations, want)
+	}
+	if _, ok := srv.JCDSFile("Old Firefox.pkg"); !ok {
+		t.Error("dry run deleted the orphan file")
+	}
+	if n := srv.Len("/api/v1/packages"); n != 2 {
+		t.Errorf("server holds %d packages, want 2", n)
+	}
+}
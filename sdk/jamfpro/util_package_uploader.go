@@ -1,39 +1,332 @@
 // util_package_uploader.go
-// This utility function uploads a package file to Jamf Pro by creating a new package and uploading the package file.
+// This utility uploads package files to Jamf Pro, creating or updating their package metadata, and
+// skips files which Jamf Pro already holds.
 // Requires jamf pro v11.5 or later
 package jamfpro
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
 )
 
-// DoPackageUpload creates a new package and uploads the package file to Jamf Pro
-// Fix this after v2
-func (c *Client) DoPackageUpload(filePath string, packageData *ResourcePackage) (*ResponsePackageCreatedAndUpdated, error) {
-	// Step 1. Create package metadata in Jamf Pro
-	pkgName := filepath.Base(filePath)
-	packageData.FileName = pkgName
+// PackageUploadAction is what UploadPackages did with a file.
+type PackageUploadAction string
+
+const (
+	// PackageUploadCreated means package metadata was created and the file uploaded.
+	PackageUploadCreated PackageUploadAction = "created"
+	// PackageUploadUpdated means the file was uploaded again and the existing metadata updated.
+	PackageUploadUpdated PackageUploadAction = "updated"
+	// PackageUploadSkipped means Jamf Pro already holds the file.
+	PackageUploadSkipped PackageUploadAction = "skipped"
+	// PackageUploadFailed means the file could not be hashed, uploaded or recorded.
+	PackageUploadFailed PackageUploadAction = "failed"
+)
+
+// PackageUploadOptions configures UploadPackages.
+type PackageUploadOptions struct {
+	// Template is the metadata of created packages. PackageName and FileName default to the file name,
+	// CategoryID to "-1" (no category), Priority to 10 and the required flags to false.
+	Template *ResourcePackage
+	// JCDS2 uploads files straight to JCDS 2.0 with UploadJCDS2Package and these options, which resume
+	// interrupted uploads, instead of through the Jamf Pro package upload endpoint.
+	JCDS2 *JCDS2UploadOptions
+}
+
+// PackageUploadResult reports what UploadPackages did with a single file.
+type PackageUploadResult struct {
+	Path      string              `json:"path"`
+	FileName  string              `json:"fileName"`
+	PackageID string              `json:"packageId,omitempty"`
+	Action    PackageUploadAction `json:"action"`
+	// Reason explains the action, such as "new package" or "file changed".
+	Reason string `json:"reason"`
+	MD5    string `json:"md5,omitempty"`
+	SHA512 string `json:"sha512,omitempty"`
+	Size   int64  `json:"size,omitempty"`
+	Error  error  `json:"-"`
+}
+
+// PackageUploadReport lists the result of every file passed to UploadPackages, in order.
+type PackageUploadReport struct {
+	Results []PackageUploadResult `json:"results"`
+}
+
+// ByAction returns the results with the given action.
+func (r *PackageUploadReport) ByAction(action PackageUploadAction) []PackageUploadResult {
+	var results []PackageUploadResult
+	for _, result := range r.Results {
+		if result.Action == action {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// UploadPackages uploads package files to Jamf Pro, skipping those it already holds. Each file is
+// hashed and matched by file name against the existing package metadata and, where JCDS 2.0 is in
+// use, the JCDS file list:
+//
+//   - a file with no metadata gets metadata created from the template and is uploaded, unless JCDS
+//     already holds an identical file, in which case only the metadata is created;
+//   - a file whose metadata or JCDS copy has a different hash, or which is missing from JCDS, is
+//     uploaded again and its metadata updated with the new hashes;
+//   - any other file is skipped.
+//
+// A failed file does not stop the others. The report lists every file, and failures are also returned
+// as a joined error.
+//
+// Example usage:
+//
+//	report, err := client.UploadPackages([]string{"Firefox.pkg", "Chrome.pkg"}, &jamfpro.PackageUploadOptions{
+//		Template: &jamfpro.ResourcePackage{CategoryID: "5", Priority: 10},
+//	})
+//	for _, result := range report.Results {
+//		fmt.Println(result.FileName, result.Action, result.Reason)
+//	}
+func (c *Client) UploadPackages(paths []string, options *PackageUploadOptions) (*PackageUploadReport, error) {
+	c, done := c.startOperation("UploadPackages")
+	defer done()
+
+	var opts PackageUploadOptions
+	if options != nil {
+		opts = *options
+	}
 
-	metadataResponse, err := c.CreatePackage(*packageData)
+	existing, err := c.GetPackages("", "")
 	if err != nil {
-		return nil, fmt.Errorf("failed to create package metadata in Jamf Pro: %v", err)
+		return nil, err
+	}
+	packages := make(map[string]ResourcePackage, len(existing.Results))
+	for _, pkg := range existing.Results {
+		if _, ok := packages[pkg.FileName]; !ok {
+			packages[pkg.FileName] = pkg
+		}
+	}
+
+	// Tenants which distribute packages from other distribution points do not use JCDS 2.0, and their
+	// files are only compared with the package metadata.
+	var jcdsFiles map[string]ResponseJCDS2List
+	if files, err := c.GetJCDS2Packages(); err != nil {
+		c.Logger().Debug("JCDS 2.0 file list unavailable, comparing package metadata only", "error", err)
+	} else {
+		jcdsFiles = make(map[string]ResponseJCDS2List, len(files))
+		for _, file := range files {
+			jcdsFiles[file.FileName] = file
+		}
+	}
+
+	report := &PackageUploadReport{}
+	var errs []error
+	for _, path := range paths {
+		result := c.uploadPackageFile(path, packages, jcdsFiles, &opts)
+		if result.Error != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.FileName, result.Error))
+		}
+		c.Logger().Info("Processed package", "file", result.FileName, "action", string(result.Action),
+			"reason", result.Reason, "package_id", result.PackageID)
+		report.Results = append(report.Results, result)
 	}
 
-	// Log the package creation response from Jamf Pro
-	fmt.Printf("Jamf Pro package metadata created successfully with package ID: %s\n", metadataResponse.ID)
+	return report, errors.Join(errs...)
+}
 
-	// Step 2. Upload the package file using the newly created package ID
-	packageID := metadataResponse.ID
+// uploadPackageFile hashes a single file and creates, updates or skips it.
+func (c *Client) uploadPackageFile(path string, packages map[string]ResourcePackage, jcdsFiles map[string]ResponseJCDS2List, opts *PackageUploadOptions) PackageUploadResult {
+	result := PackageUploadResult{Path: path, FileName: filepath.Base(path)}
+	fail := func(reason string, err error) PackageUploadResult {
+		result.Action, result.Reason, result.Error = PackageUploadFailed, reason, err
+		return result
+	}
 
-	filePaths := []string{filePath}
-	uploadResponse, err := c.UploadPackage(packageID, filePaths)
+	file, size, err := helpers.OpenJCDSPackageFile(path)
+	if err != nil {
+		return fail("file could not be read", err)
+	}
+	result.Size = size
+	result.MD5, result.SHA512, err = hashJCDS2File(c.Context(), file)
+	file.Close()
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload package file: %v", err)
+		return fail("file could not be hashed", err)
+	}
+
+	pkg, exists := packages[result.FileName]
+	if !exists && jcds2FileMatches(jcdsFiles, result) {
+		// JCDS already holds the file, such as when its metadata was deleted, so only the metadata is
+		// created.
+		pkg = newPackageFromTemplate(opts.Template, result.FileName)
+		setPackageHashes(&pkg, result.MD5, result.SHA512)
+		created, err := c.CreatePackage(pkg)
+		if err != nil {
+			return fail("metadata could not be created", err)
+		}
+		pkg.ID = created.ID
+		result.PackageID = created.ID
+		packages[result.FileName] = pkg
+		result.Action, result.Reason = PackageUploadCreated, "file already in JCDS"
+		return result
+	}
+	if !exists {
+		// The metadata is created without hashes, which are only recorded once the file is uploaded, so
+		// that a failed upload is retried on the next run.
+		pkg = newPackageFromTemplate(opts.Template, result.FileName)
+		created, err := c.CreatePackage(pkg)
+		if err != nil {
+			return fail("metadata could not be created", err)
+		}
+		pkg.ID = created.ID
+		result.PackageID = created.ID
+		packages[result.FileName] = pkg
+
+		if err := c.uploadPackageContent(created.ID, path, opts); err != nil {
+			return fail("file could not be uploaded", err)
+		}
+		recordJCDS2File(jcdsFiles, result)
+		setPackageHashes(&pkg, result.MD5, result.SHA512)
+		if _, err := c.UpdatePackageByID(pkg.ID, pkg); err != nil {
+			return fail("metadata could not be updated", err)
+		}
+		packages[result.FileName] = pkg
+		result.Action, result.Reason = PackageUploadCreated, "new package"
+		return result
+	}
+
+	result.PackageID = pkg.ID
+	reason := packageChange(pkg, jcdsFiles, result.FileName, result.MD5, result.SHA512, size)
+	if reason == "" {
+		result.Action, result.Reason = PackageUploadSkipped, "unchanged"
+		return result
 	}
 
-	fmt.Println("Package file uploaded successfully")
+	if err := c.uploadPackageContent(pkg.ID, path, opts); err != nil {
+		return fail("file could not be uploaded", err)
+	}
+	recordJCDS2File(jcdsFiles, result)
+	setPackageHashes(&pkg, result.MD5, result.SHA512)
+	if _, err := c.UpdatePackageByID(pkg.ID, pkg); err != nil {
+		return fail("metadata could not be updated", err)
+	}
+	packages[result.FileName] = pkg
+	result.Action, result.Reason = PackageUploadUpdated, reason
+	return result
+}
+
+// uploadPackageContent uploads the file of package id through Jamf Pro, or straight to JCDS 2.0.
+func (c *Client) uploadPackageContent(id, path string, opts *PackageUploadOptions) error {
+	if opts.JCDS2 != nil {
+		_, err := c.UploadJCDS2Package(path, opts.JCDS2)
+		return err
+	}
+	_, err := c.UploadPackage(id, []string{path})
+	return err
+}
+
+// recordJCDS2File adds an uploaded file to the JCDS 2.0 file list, so that a later path with the same
+// file name is compared with it rather than with what JCDS held before the run.
+func recordJCDS2File(jcdsFiles map[string]ResponseJCDS2List, result PackageUploadResult) {
+	if jcdsFiles != nil {
+		jcdsFiles[result.FileName] = ResponseJCDS2List{FileName: result.FileName, Length: result.Size, MD5: result.MD5}
+	}
+}
+
+// jcds2FileMatches reports whether JCDS 2.0 holds a file with the name, size and MD5 of the result.
+func jcds2FileMatches(jcdsFiles map[string]ResponseJCDS2List, result PackageUploadResult) bool {
+	jcdsFile, ok := jcdsFiles[result.FileName]
+	return ok && jcdsFile.Length == result.Size && jcdsFile.MD5 != "" && strings.EqualFold(jcdsFile.MD5, result.MD5)
+}
+
+// packageChange returns why the file with the given hashes differs from what Jamf Pro holds for pkg,
+// or "" when it does not.
+func packageChange(pkg ResourcePackage, jcdsFiles map[string]ResponseJCDS2List, fileName, md5Hex, sha512Hex string, size int64) string {
+	metadataHash := ""
+	switch {
+	case pkg.MD5 != "":
+		metadataHash = "md5"
+		if !strings.EqualFold(pkg.MD5, md5Hex) {
+			return "file changed"
+		}
+	case strings.EqualFold(pkg.HashType, "SHA_512") && pkg.HashValue != "":
+		metadataHash = "sha512"
+		if !strings.EqualFold(pkg.HashValue, sha512Hex) {
+			return "file changed"
+		}
+	case strings.EqualFold(pkg.HashType, "MD5") && pkg.HashValue != "":
+		metadataHash = "md5"
+		if !strings.EqualFold(pkg.HashValue, md5Hex) {
+			return "file changed"
+		}
+	}
+
+	if jcdsFiles == nil {
+		if metadataHash == "" {
+			return "metadata has no hash"
+		}
+		return ""
+	}
+
+	jcdsFile, ok := jcdsFiles[fileName]
+	switch {
+	case !ok:
+		return "file missing from JCDS"
+	case jcdsFile.Length != size || (jcdsFile.MD5 != "" && !strings.EqualFold(jcdsFile.MD5, md5Hex)):
+		return "JCDS file changed"
+	case metadataHash == "" && jcdsFile.MD5 == "":
+		return "metadata has no hash"
+	}
+	return ""
+}
+
+// newPackageFromTemplate returns the metadata of a new package for fileName.
+func newPackageFromTemplate(template *ResourcePackage, fileName string) ResourcePackage {
+	var pkg ResourcePackage
+	if template != nil {
+		pkg = *template
+	}
+	pkg.ID = ""
+	pkg.FileName = fileName
+	if pkg.PackageName == "" {
+		pkg.PackageName = fileName
+	}
+	if pkg.CategoryID == "" {
+		pkg.CategoryID = "-1"
+	}
+	if pkg.Priority == 0 {
+		pkg.Priority = 10
+	}
+	for _, flag := range []**bool{
+		&pkg.FillUserTemplate, &pkg.RebootRequired, &pkg.OSInstall, &pkg.SuppressUpdates,
+		&pkg.SuppressFromDock, &pkg.SuppressEula, &pkg.SuppressRegistration,
+	} {
+		if *flag == nil {
+			*flag = BoolPtr(false)
+		}
+	}
+	return pkg
+}
+
+// setPackageHashes records the hashes of the package file in its metadata.
+func setPackageHashes(pkg *ResourcePackage, md5Hex, sha512Hex string) {
+	pkg.MD5 = md5Hex
+	pkg.HashType = "SHA_512"
+	pkg.HashValue = sha512Hex
+}
+
+// DoPackageUpload uploads a package file to Jamf Pro, creating package metadata from packageData
+// when none exists for the file name. It is UploadPackages for a single file, so a file which Jamf Pro
+// already holds is not uploaded again, and a changed file updates the existing metadata. The response
+// holds the id of the package.
+func (c *Client) DoPackageUpload(filePath string, packageData *ResourcePackage) (*ResponsePackageCreatedAndUpdated, error) {
+	c, done := c.startOperation("DoPackageUpload")
+	defer done()
+
+	report, err := c.UploadPackages([]string{filePath}, &PackageUploadOptions{Template: packageData})
+	if err != nil {
+		return nil, err
+	}
 
-	// Return the package creation response and nil for no error
-	return uploadResponse, nil
+	return &ResponsePackageCreatedAndUpdated{ID: report.Results[0].PackageID}, nil
 }
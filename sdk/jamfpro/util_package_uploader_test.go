@@ -0,0 +1,252 @@
+// util_package_uploader_test.go
+package jamfpro_test
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+func TestUploadPackages(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	md5Hex := func(content string) string {
+		sum := md5.Sum([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+
+	// Chrome is already in Jamf Pro, and Slack has changed since it was uploaded.
+	srv.AddJCDSFile("Chrome.pkg", []byte("chrome"))
+	chromeID, _ := srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Chrome", FileName: "Chrome.pkg", MD5: md5Hex("chrome")})
+	srv.AddJCDSFile("Slack.pkg", []byte("slack 1"))
+	slackID, _ := srv.AddResource("/api/v1/packages", jamfpro.ResourcePackage{PackageName: "Slack", FileName: "Slack.pkg", MD5: md5Hex("slack 1")})
+
+	paths := []string{
+		write("Chrome.pkg", "chrome"),
+		write("Slack.pkg", "slack 2"),
+		write("Firefox.pkg", "firefox"),
+		write("notes.txt", "not a package"),
+	}
+
+	report, err := client.UploadPackages(paths, &jamfpro.PackageUploadOptions{
+		Template: &jamfpro.ResourcePackage{CategoryID: "5"},
+	})
+	if err == nil {
+		t.Error("UploadPackages() returned no error for a file which is not a package")
+	}
+
+	want := []struct {
+		fileName string
+		action   jamfpro.PackageUploadAction
+		reason   string
+	}{
+		{"Chrome.pkg", jamfpro.PackageUploadSkipped, "unchanged"},
+		{"Slack.pkg", jamfpro.PackageUploadUpdated, "file changed"},
+		{"Firefox.pkg", jamfpro.PackageUploadCreated, "new package"},
+		{"notes.txt", jamfpro.PackageUploadFailed, "file could not be read"},
+	}
+	if len(report.Results) != len(want) {
+		t.Fatalf("report has %d results, want %d", len(report.Results), len(want))
+	}
+	for i, tt := range want {
+		result := report.Results[i]
+		if result.FileName != tt.fileName || result.Action != tt.action || result.Reason != tt.reason {
+			t.Errorf("result %d = %s %s %q, want %s %s %q", i, result.FileName, result.Action, result.Reason, tt.fileName, tt.action, tt.reason)
+		}
+	}
+	if report.Results[0].PackageID != chromeID || report.Results[1].PackageID != slackID {
+		t.Errorf("package ids = %s, %s, want %s, %s", report.Results[0].PackageID, report.Results[1].PackageID, chromeID, slackID)
+	}
+
+	if data, _ := srv.JCDSFile("Slack.pkg"); string(data) != "slack 2" {
+		t.Errorf("JCDS Slack.pkg = %q, want the changed file", data)
+	}
+	slack, err := client.GetPackageByID(slackID)
+	if err != nil || slack.MD5 != md5Hex("slack 2") || slack.HashType != "SHA_512" {
+		t.Errorf("updated Slack metadata = %+v, error %v", slack, err)
+	}
+
+	firefox, err := client.GetPackageByID(report.Results[2].PackageID)
+	if err != nil {
+		t.Fatalf("GetPackageByID() returned error: %v", err)
+	}
+	if firefox.PackageName != "Firefox.pkg" || firefox.CategoryID != "5" || firefox.Priority != 10 || firefox.MD5 != md5Hex("firefox") {
+		t.Errorf("created Firefox metadata = %+v", firefox)
+	}
+	if _, ok := srv.JCDSFile("Firefox.pkg"); !ok {
+		t.Error("Firefox.pkg was not uploaded")
+	}
+
+	// A second run finds every package unchanged.
+	report, err = client.UploadPackages(paths[:3], nil)
+	if err != nil {
+		t.Fatalf("UploadPackages() returned error: %v", err)
+	}
+	if skipped := report.ByAction(jamfpro.PackageUploadSkipped); len(skipped) != 3 {
+		t.Errorf("second run skipped %d packages, want 3: %+v", len(skipped), report.Results)
+	}
+	if n := srv.Len("/api/v1/packages"); n != 3 {
+		t.Errorf("server holds %d packages, want 3", n)
+	}
+
+	// Files can be uploaded straight to JCDS 2.0.
+	report, err = client.UploadPackages([]string{write("Zoom.pkg", "zoom")}, &jamfpro.PackageUploadOptions{
+		JCDS2: &jamfpro.JCDS2UploadOptions{StateDir: t.TempDir()},
+	})
+	if err != nil {
+		t.Fatalf("UploadPackages() with JCDS2 returned error: %v", err)
+	}
+	if report.Results[0].Action != jamfpro.PackageUploadCreated {
+		t.Errorf("Zoom.pkg action = %s, want created", report.Results[0].Action)
+	}
+	if data, _ := srv.JCDSFile("Zoom.pkg"); string(data) != "zoom" {
+		t.Errorf("JCDS Zoom.pkg = %q", data)
+	}
+}
+
+func TestUploadPackagesFailedUpload(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+
+	// The tenant does not use JCDS 2.0, and the first upload fails.
+	failUpload := true
+	client.Use(func(next jamfpro.Handler) jamfpro.Handler {
+		return func(req *jamfpro.Request) (*http.Response, error) {
+			switch {
+			case strings.HasPrefix(req.Endpoint, "/api/v1/jcds/"):
+				return nil, errors.New("JCDS 2.0 is not enabled")
+			case strings.HasSuffix(req.Endpoint, "/upload") && failUpload:
+				failUpload = false
+				return nil, errors.New("connection reset")
+			}
+			return next(req)
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "Firefox.pkg")
+	if err := os.WriteFile(path, []byte("firefox"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := client.UploadPackages([]string{path}, nil)
+	if err == nil || report.Results[0].Reason != "file could not be uploaded" {
+		t.Fatalf("UploadPackages() = %+v, error %v, want a failed upload", report.Results, err)
+	}
+	created, err := client.GetPackageByID(report.Results[0].PackageID)
+	if err != nil || created.MD5 != "" || created.HashValue != "" {
+		t.Errorf("metadata after failed upload = %+v, error %v, want no hashes", created, err)
+	}
+
+	// The rerun uploads the file into the metadata created by the failed run.
+	report, err = client.UploadPackages([]string{path}, nil)
+	if err != nil {
+		t.Fatalf("UploadPackages() returned error: %v", err)
+	}
+	if result := report.Results[0]; result.Action != jamfpro.PackageUploadUpdated || result.Reason != "metadata has no hash" {
+		t.Errorf("rerun result = %s %q, want updated because the metadata has no hash", result.Action, result.Reason)
+	}
+	if _, ok := srv.JCDSFile("Firefox.pkg"); !ok {
+		t.Error("Firefox.pkg was not uploaded on the rerun")
+	}
+}
+
+func TestUploadPackagesSameFileName(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+
+	var paths []string
+	for _, dir := range []string{t.TempDir(), t.TempDir()} {
+		path := filepath.Join(dir, "Firefox.pkg")
+		if err := os.WriteFile(path, []byte("firefox"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	report, err := client.UploadPackages(paths, nil)
+	if err != nil {
+		t.Fatalf("UploadPackages() returned error: %v", err)
+	}
+	if report.Results[0].Action != jamfpro.PackageUploadCreated || report.Results[1].Action != jamfpro.PackageUploadSkipped ||
+		report.Results[1].PackageID != report.Results[0].PackageID {
+		t.Errorf("UploadPackages() = %+v, want the second file skipped as the created package", report.Results)
+	}
+	if n := srv.Len("/api/v1/packages"); n != 1 {
+		t.Errorf("server holds %d packages, want 1", n)
+	}
+}
+
+func TestUploadPackagesFileAlreadyInJCDS(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+
+	uploads := 0
+	client.Use(func(next jamfpro.Handler) jamfpro.Handler {
+		return func(req *jamfpro.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.Endpoint, "/upload") {
+				uploads++
+			}
+			return next(req)
+		}
+	})
+
+	// JCDS holds Firefox.pkg, but its metadata has been deleted.
+	srv.AddJCDSFile("Firefox.pkg", []byte("firefox"))
+	path := filepath.Join(t.TempDir(), "Firefox.pkg")
+	if err := os.WriteFile(path, []byte("firefox"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := client.UploadPackages([]string{path}, nil)
+	if err != nil {
+		t.Fatalf("UploadPackages() returned error: %v", err)
+	}
+	if result := report.Results[0]; result.Action != jamfpro.PackageUploadCreated || result.Reason != "file already in JCDS" {
+		t.Errorf("result = %s %q, want metadata created for the file already in JCDS", result.Action, result.Reason)
+	}
+	if uploads != 0 {
+		t.Errorf("UploadPackages() uploaded the file %d times, want 0", uploads)
+	}
+	sum := md5.Sum([]byte("firefox"))
+	created, err := client.GetPackageByID(report.Results[0].PackageID)
+	if err != nil || created.MD5 != hex.EncodeToString(sum[:]) || created.HashType != "SHA_512" {
+		t.Errorf("created metadata = %+v, error %v, want the file hashes", created, err)
+	}
+}
@@ -3,89 +3,503 @@
 package jamfpro
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
 )
 
-type StandardPaginatedResponse struct {
-	Size    int           `json:"totalCount"`
-	Results []interface{} `json:"results"`
+// PaginatedResponse is the envelope returned by Jamf Pro API list endpoints, decoded directly into T.
+type PaginatedResponse[T any] struct {
+	TotalCount int `json:"totalCount"`
+	Results    []T `json:"results"`
 }
 
-// DoPaginatedGet performs a paginated GET request to a specified endpoint in the Jamf Pro API.
+// Paginator walks the pages of a Jamf Pro API list endpoint and decodes each result into T.
 //
-// This method is designed to fetch data in a paginated manner from Jamf Pro's RESTful API. It constructs
-// the API endpoint using the provided parameters, handles the pagination logic, and accumulates the results
-// into a single response structure. It's particularly useful for endpoints where the response is expected to
-// contain a large number of items that might be paginated by the server.
+// A Paginator is configured with chained calls and then consumed with All, Items or Page:
 //
-// Parameters:
-//   - endpoint_root: The root URL of the API endpoint. This is the base URL to which pagination and sorting
-//     parameters will be appended.
-//   - maxPageSize: Maximum number of items to be fetched in each paginated request. If set to 0, defaults to 200.
-//   - startingPageNumber: The page number from which to start the paginated fetching.
-//   - sort_filter: A string specifying the sorting criteria. It follows the format
-//     'sort=<field_name>[:sort_direction][,<secondary_sort_field_name>[:sort_direction]]*'. The default sort
-//     direction is 'asc' (Ascending). Use 'desc' for Descending ordering. Additional sort parameters are
-//     supported and determine the order of results that have equivalent values for previous sort parameters.
+//	computers, err := jamfpro.NewPaginator[jamfpro.ResourceComputerInventory](client, "/api/v1/computers-inventory").
+//		Sections("GENERAL", "HARDWARE").
+//		Sort("general.name:asc").
+//		Filter(`general.platform=="Mac"`).
+//		All()
 //
-// The method returns a pointer to a StandardPaginatedResponse containing the aggregated results from all
-// fetched pages, or an error if the fetch operation fails at any point.
+// Items streams results one at a time so that very large collections never need to be held in
+// memory at once:
 //
-// Example usage:
-// client.GetSelfServiceBrandingMacOS("sort=id:desc")
+//	for computer, err := range paginator.Items() {
+//		if err != nil {
+//			return err
+//		}
+//		// process computer
+//	}
 //
-// Note:
-// The method appends the results from each page to a slice and breaks the loop when the total number of items
-// fetched matches the reported total count from the server, or when a fetched page contains fewer items than
-// the maximum page size, indicating that it is the last page.
-func (c *Client) DoPaginatedGet(
-	endpoint_root string,
-	maxPageSize, startingPageNumber int,
-	sort_filter string,
-) (*StandardPaginatedResponse, error) {
+// All query parameters are URL encoded when the request is built, so sort and filter expressions
+// can be passed exactly as documented by Jamf.
+//
+// Large collections can be fetched faster by enabling Parallel, which requests the remaining pages
+// concurrently once the total count is known from the first page.
+type Paginator[T any] struct {
+	client    *Client
+	endpoint  string
+	pageSize  int
+	startPage int
+	workers   int
+	query     url.Values
+	err       error
+
+	// typed records whether "filter" and "sort" were set from typed rsql expressions, which cannot be
+	// combined with strings for the same parameter.
+	typed map[string]bool
+}
+
+// NewPaginator returns a Paginator for the given Jamf Pro API list endpoint using the standard page size.
+// Parallel prefetching is enabled automatically when the client was built with EnableParallelPagination.
+func NewPaginator[T any](c *Client, endpoint string) *Paginator[T] {
+	p := &Paginator[T]{
+		client:    c,
+		endpoint:  endpoint,
+		pageSize:  standardPageSize,
+		startPage: startingPageNumber,
+		query:     url.Values{},
+		typed:     map[string]bool{},
+	}
+
+	if c != nil && c.parallelPagination {
+		p.Parallel(0)
+	}
+
+	return p
+}
+
+// PageSize sets the number of results requested per page. Values outside 1 to maxPageSize are clamped.
+func (p *Paginator[T]) PageSize(size int) *Paginator[T] {
+	switch {
+	case size <= 0:
+		p.pageSize = standardPageSize
+	case size > maxPageSize:
+		p.pageSize = maxPageSize
+	default:
+		p.pageSize = size
+	}
+	return p
+}
+
+// StartPage sets the zero based page the paginator starts from.
+func (p *Paginator[T]) StartPage(page int) *Paginator[T] {
+	if page < 0 {
+		page = startingPageNumber
+	}
+	p.startPage = page
+	return p
+}
+
+// Parallel enables concurrent page prefetching with up to workers pages in flight at once. Values
+// less than 1, or greater than the client's MaxConcurrentRequests budget, use the full budget.
+//
+// Results are still returned in page order. If any page fails, all outstanding page requests are
+// cancelled and the error is returned.
+func (p *Paginator[T]) Parallel(workers int) *Paginator[T] {
+	budget := 1
+	if p.client != nil && p.client.maxConcurrentRequests > 1 {
+		budget = p.client.maxConcurrentRequests
+	}
+
+	if workers < 1 || workers > budget {
+		workers = budget
+	}
+	p.workers = workers
+	return p
+}
+
+// Sort adds one or more sort criteria in the form '<field_name>[:asc|:desc]'. Additional criteria
+// determine the order of results that have equivalent values for previous criteria.
+func (p *Paginator[T]) Sort(criteria ...string) *Paginator[T] {
+	for _, criterion := range criteria {
+		if criterion != "" && p.claim("sort", false) {
+			p.query.Add("sort", criterion)
+		}
+	}
+	return p
+}
+
+// Filter sets the RSQL filter expression used to narrow the results. An empty filter is ignored.
+func (p *Paginator[T]) Filter(filter string) *Paginator[T] {
+	if filter != "" && p.claim("filter", false) {
+		p.query.Set("filter", filter)
+	}
+	return p
+}
+
+// Where sets the filter from a typed rsql.Filter. An empty filter is ignored. It cannot be combined
+// with a filter string set through Filter or RawQuery, and a filter RSQL cannot express, such as
+// rsql.In with no values, fails the paginator.
+func (p *Paginator[T]) Where(filter rsql.Filter) *Paginator[T] {
+	if err := filter.Err(); err != nil {
+		if p.err == nil {
+			p.err = err
+		}
+		return p
+	}
+	if s := filter.String(); s != "" && p.claim("filter", true) {
+		p.query.Set("filter", s)
+	}
+	return p
+}
+
+// OrderBy adds typed sort criteria, such as rsql.Asc("general.name"). They cannot be combined with
+// sort strings set through Sort or RawQuery.
+func (p *Paginator[T]) OrderBy(criteria ...rsql.SortCriterion) *Paginator[T] {
+	for _, criterion := range criteria {
+		if p.claim("sort", true) {
+			p.query.Add("sort", criterion.String())
+		}
+	}
+	return p
+}
+
+// claim reports whether key may be set from a typed expression, or from a string when typed is false.
+// Setting it both ways fails the paginator rather than silently preferring one of them.
+func (p *Paginator[T]) claim(key string, typed bool) bool {
+	if p.query.Has(key) && p.typed[key] != typed {
+		if p.err == nil {
+			p.err = fmt.Errorf("%s is set both as a string and as a typed rsql expression, use only one of them", key)
+		}
+		return false
+	}
+	p.typed[key] = typed
+	return true
+}
+
+// ListOption filters or sorts the results of a list method, such as GetBuildings, with typed rsql
+// expressions:
+//
+//	buildings, err := client.GetBuildings("", jamfpro.Where(rsql.Like(rsql.FieldName, "HQ*")), jamfpro.OrderBy(rsql.Asc(rsql.FieldName)))
+//
+// A typed filter or sort cannot be combined with a filter or sort passed to the same call as a string.
+type ListOption func(*listOptions)
+
+type listOptions struct {
+	where   rsql.Filter
+	orderBy []rsql.SortCriterion
+}
 
-	if maxPageSize == 0 {
-		maxPageSize = 200
+// Where filters the results of a list method with filter.
+func Where(filter rsql.Filter) ListOption {
+	return func(o *listOptions) {
+		o.where = filter
 	}
+}
+
+// OrderBy sorts the results of a list method by criteria, such as rsql.Desc(rsql.FieldID).
+func OrderBy(criteria ...rsql.SortCriterion) ListOption {
+	return func(o *listOptions) {
+		o.orderBy = append(o.orderBy, criteria...)
+	}
+}
 
-	var OutStruct StandardPaginatedResponse
-	var TargetObjectAccumulator StandardPaginatedResponse
-	var OutData []interface{}
-	var page = startingPageNumber
+// withOptions applies the ListOptions passed to a list method.
+func (p *Paginator[T]) withOptions(opts []ListOption) *Paginator[T] {
+	var o listOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return p.Where(o.where).OrderBy(o.orderBy...)
+}
 
-	for {
-		endpoint := fmt.Sprintf("%s?page=%d&page-size=%d%s", endpoint_root, startingPageNumber, maxPageSize, sort_filter)
-		fmt.Println(endpoint)
-		resp, err := c.HTTP.DoRequest(
-			"GET",
-			endpoint,
-			nil,
-			&TargetObjectAccumulator,
-		)
+// Sections requests specific data sections on endpoints which support them, such as computer inventory.
+func (p *Paginator[T]) Sections(sections ...string) *Paginator[T] {
+	for _, section := range sections {
+		if section != "" {
+			p.query.Add("section", section)
+		}
+	}
+	return p
+}
 
+// Param adds an arbitrary query parameter to every page request.
+func (p *Paginator[T]) Param(key string, values ...string) *Paginator[T] {
+	for _, value := range values {
+		p.query.Add(key, value)
+	}
+	return p
+}
+
+// RawQuery merges a pre-built query string, such as the legacy 'sort_filter' arguments accepted by
+// the list methods, into the paginator. Leading '?' or '&' characters are ignored. RSQL expressions
+// are allowed to contain ';', so the string is split on '&' only.
+func (p *Paginator[T]) RawQuery(rawQuery string) *Paginator[T] {
+	rawQuery = strings.TrimLeft(rawQuery, "?&")
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(pair, "=")
+		unescapedKey, err := url.QueryUnescape(key)
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch obj %v", err)
+			p.err = fmt.Errorf("invalid query parameter %q: %w", key, err)
+			return p
 		}
 
-		if resp != nil {
-			defer resp.Body.Close()
+		// Values are frequently passed unescaped, so fall back to the literal value.
+		if unescapedValue, err := url.QueryUnescape(value); err == nil {
+			value = unescapedValue
 		}
 
-		OutData = append(OutData, TargetObjectAccumulator.Results...)
+		if (unescapedKey == "filter" || unescapedKey == "sort") && !p.claim(unescapedKey, false) {
+			continue
+		}
+		p.query.Add(unescapedKey, value)
+	}
+	return p
+}
 
-		if len(OutData) >= TargetObjectAccumulator.Size ||
-			len(TargetObjectAccumulator.Results) < maxPageSize ||
-			len(TargetObjectAccumulator.Results) == 0 {
-			break
+// Page fetches and decodes a single zero based page.
+func (p *Paginator[T]) Page(page int) (*PaginatedResponse[T], error) {
+	client, done := p.client.continueOperation("Paginator.Page")
+	defer done()
+	return p.fetchPage(client, page)
+}
+
+// fetchPage fetches a single page using the given client, which may be bound to a different context.
+func (p *Paginator[T]) fetchPage(c *Client, page int) (*PaginatedResponse[T], error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	endpoint, err := p.pageEndpoint(page)
+	if err != nil {
+		return nil, err
+	}
+
+	var out PaginatedResponse[T]
+	resp, err := c.doRequest("GET", endpoint, nil, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch page %d: %w", page, err)
+	}
+
+	return &out, nil
+}
+
+// Pages returns an iterator over every page, starting at the configured start page. Iteration stops
+// after the first error, which is yielded with a nil page. The pages are requested within a single
+// operation, that of the list method which created the paginator, or else "jamfpro.Paginator.Pages".
+func (p *Paginator[T]) Pages() iter.Seq2[*PaginatedResponse[T], error] {
+	return func(yield func(*PaginatedResponse[T], error) bool) {
+		client, done := p.client.continueOperation("Paginator.Pages")
+		defer done()
+
+		if p.workers > 1 {
+			p.parallelPages(client, yield)
+			return
+		}
+
+		fetched := 0
+		for page := p.startPage; ; page++ {
+			resp, err := p.fetchPage(client, page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(resp, nil) {
+				return
+			}
+
+			fetched += len(resp.Results)
+			if p.isLastPage(resp, fetched) {
+				return
+			}
+		}
+	}
+}
+
+// pageResult carries the outcome of a page fetched by a prefetch worker.
+type pageResult[T any] struct {
+	resp *PaginatedResponse[T]
+	err  error
+}
+
+// parallelPages fetches the first page with client, derives the last page from the reported total
+// count and then prefetches up to p.workers pages ahead of the consumer. Pages are yielded in order
+// and at most p.workers pages are buffered at any time. Returning early cancels every outstanding
+// request, as does a failed page as soon as it fails, and the failure is yielded in place of the
+// next page.
+func (p *Paginator[T]) parallelPages(client *Client, yield func(*PaginatedResponse[T], error) bool) {
+	first, err := p.fetchPage(client, p.startPage)
+	if err != nil {
+		yield(nil, err)
+		return
+	}
+
+	if !yield(first, nil) || p.isLastPage(first, len(first.Results)) {
+		return
+	}
+
+	lastPage := (first.TotalCount - 1) / p.pageSize
+	if lastPage <= p.startPage {
+		return
+	}
+
+	// The operation is carried on the context, so pages fetched by the workers are attributed to it.
+	// The first page to fail cancels the others straight away, even those before it in page order.
+	ctx, cancel := context.WithCancelCause(client.Context())
+	client = client.WithContext(ctx)
+
+	var wg sync.WaitGroup
+	defer func() {
+		cancel(nil)
+		wg.Wait()
+	}()
+
+	fetch := func(page int) chan pageResult[T] {
+		result := make(chan pageResult[T], 1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := p.fetchPage(client, page)
+			if err != nil {
+				cancel(err)
+			}
+			result <- pageResult[T]{resp: resp, err: err}
+		}()
+		return result
+	}
+
+	var queue []chan pageResult[T]
+	nextPage := p.startPage + 1
+	for ; nextPage <= lastPage && len(queue) < p.workers; nextPage++ {
+		queue = append(queue, fetch(nextPage))
+	}
+
+	for len(queue) > 0 {
+		result := <-queue[0]
+		queue = queue[1:]
+
+		if result.err != nil {
+			// Report the failure which cancelled the remaining pages rather than the cancellation.
+			yield(nil, context.Cause(ctx))
+			return
 		}
 
-		page++
+		if nextPage <= lastPage {
+			queue = append(queue, fetch(nextPage))
+			nextPage++
+		}
 
+		if !yield(result.resp, nil) {
+			return
+		}
 	}
+}
 
-	OutStruct.Size = TargetObjectAccumulator.Size
-	OutStruct.Results = OutData
+// Items returns an iterator over every result across all pages. Only one page is held in memory at
+// a time. Iteration stops after the first error, which is yielded with the zero value of T.
+func (p *Paginator[T]) Items() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for resp, err := range p.Pages() {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
 
-	return &OutStruct, nil
+			for _, result := range resp.Results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All fetches every page and returns the accumulated results along with the total count reported by the server.
+func (p *Paginator[T]) All() (*PaginatedResponse[T], error) {
+	var out PaginatedResponse[T]
+	for resp, err := range p.Pages() {
+		if err != nil {
+			return nil, err
+		}
+
+		out.TotalCount = resp.TotalCount
+		out.Results = append(out.Results, resp.Results...)
+	}
+
+	return &out, nil
+}
+
+// pageEndpoint builds the endpoint for a single page, encoding all configured query parameters.
+func (p *Paginator[T]) pageEndpoint(page int) (string, error) {
+	u, err := url.Parse(p.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	query := u.Query()
+	for key, values := range p.query {
+		for _, value := range values {
+			query.Add(key, value)
+		}
+	}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page-size", strconv.Itoa(p.pageSize))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// isLastPage reports whether no further pages need to be requested.
+func (p *Paginator[T]) isLastPage(resp *PaginatedResponse[T], fetched int) bool {
+	return len(resp.Results) == 0 ||
+		len(resp.Results) < p.pageSize ||
+		fetched >= resp.TotalCount
+}
+
+type StandardPaginatedResponse struct {
+	Size    int           `json:"totalCount"`
+	Results []interface{} `json:"results"`
+}
+
+// DoPaginatedGet performs a paginated GET request to a specified endpoint in the Jamf Pro API and
+// returns the untyped results of every page.
+//
+// Parameters:
+//   - endpoint_root: The root URL of the API endpoint.
+//   - maxPageSize: Maximum number of items to be fetched in each paginated request. If set to 0, defaults to 200.
+//   - startingPageNumber: The page number from which to start the paginated fetching.
+//   - sort_filter: A query string such as 'sort=id:desc&filter=name=="x"' which is merged into each page request.
+//
+// Deprecated: use NewPaginator, which decodes directly into the resource type and can stream results.
+func (c *Client) DoPaginatedGet(
+	endpoint_root string,
+	maxPageSize, startingPageNumber int,
+	sort_filter string,
+) (*StandardPaginatedResponse, error) {
+	c, done := c.startOperation("DoPaginatedGet")
+	defer done()
+
+	resp, err := NewPaginator[interface{}](c, endpoint_root).
+		PageSize(maxPageSize).
+		StartPage(startingPageNumber).
+		RawQuery(sort_filter).
+		All()
+	if err != nil {
+		return nil, err
+	}
 
+	return &StandardPaginatedResponse{
+		Size:    resp.TotalCount,
+		Results: resp.Results,
+	}, nil
 }
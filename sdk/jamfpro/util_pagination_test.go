@@ -0,0 +1,301 @@
+// util_pagination_test.go
+package jamfpro
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
+	"go.uber.org/zap"
+)
+
+// testIntegration is a minimal httpclient.APIIntegration which sends unauthenticated JSON requests to a test server.
+type testIntegration struct {
+	baseURL string
+}
+
+func (i *testIntegration) GetFQDN() string                                  { return i.baseURL }
+func (i *testIntegration) ConstructURL(endpoint string) string              { return i.baseURL + endpoint }
+func (i *testIntegration) GetAuthMethodDescriptor() string                  { return "none" }
+func (i *testIntegration) CheckRefreshToken() error                         { return nil }
+func (i *testIntegration) PrepRequestParamsAndAuth(req *http.Request) error { return nil }
+func (i *testIntegration) GetSessionCookies() ([]*http.Cookie, error)       { return nil, nil }
+
+func (i *testIntegration) PrepRequestBody(body interface{}, method string, endpoint string) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	return json.Marshal(body)
+}
+
+func (i *testIntegration) MarshalMultipartRequest(fields map[string]string, files map[string]string) ([]byte, string, error) {
+	return nil, "", errors.New("multipart requests are not supported by the test integration")
+}
+
+// newTestClient returns a Client which sends every request to handler.
+func newTestClient(t *testing.T, handler http.Handler, maxConcurrentRequests int) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	executor := newProdExecutor(&http.Client{})
+	config := httpclient.ClientConfig{
+		Integration:           &testIntegration{baseURL: server.URL},
+		Sugar:                 zap.NewNop().Sugar(),
+		MaxConcurrentRequests: maxConcurrentRequests,
+		HTTPExecutor:          executor,
+	}
+
+	httpClient, err := config.Build()
+	if err != nil {
+		t.Fatalf("failed to build test http client: %v", err)
+	}
+
+	return &Client{HTTP: httpClient, executor: executor, maxConcurrentRequests: maxConcurrentRequests}
+}
+
+// pagedHandler serves total integers split into pages, failing the page given by failPage.
+func pagedHandler(total, failPage int, requests *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page-size"))
+		if page == failPage {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"httpStatus":400,"errors":[]}`))
+			return
+		}
+
+		out := PaginatedResponse[int]{TotalCount: total, Results: []int{}}
+		for i := page * pageSize; i < total && i < (page+1)*pageSize; i++ {
+			out.Results = append(out.Results, i)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(out)
+	})
+}
+
+func TestPaginatorPageEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		paginator *Paginator[interface{}]
+		page      int
+		want      url.Values
+	}{
+		{
+			name:      "Defaults",
+			paginator: NewPaginator[interface{}](nil, "/api/v1/buildings"),
+			page:      0,
+			want:      url.Values{"page": {"0"}, "page-size": {"200"}},
+		},
+		{
+			name: "Sort filter and sections",
+			paginator: NewPaginator[interface{}](nil, "/api/v1/computers-inventory").
+				PageSize(50).
+				Sections("GENERAL", "HARDWARE").
+				Sort("general.name:asc", "id:desc").
+				Filter(`general.name=="Mac & Co";general.platform=="Mac"`),
+			page: 3,
+			want: url.Values{
+				"page":      {"3"},
+				"page-size": {"50"},
+				"section":   {"GENERAL", "HARDWARE"},
+				"sort":      {"general.name:asc", "id:desc"},
+				"filter":    {`general.name=="Mac & Co";general.platform=="Mac"`},
+			},
+		},
+		{
+			name:      "Page size is clamped",
+			paginator: NewPaginator[interface{}](nil, "/api/v1/scripts").PageSize(maxPageSize + 1),
+			page:      0,
+			want:      url.Values{"page": {"0"}, "page-size": {"2000"}},
+		},
+		{
+			name:      "Legacy sort filter string",
+			paginator: NewPaginator[interface{}](nil, "/api/v1/scripts").RawQuery(`&sort=name:asc&filter=name=="a;b"`),
+			page:      1,
+			want: url.Values{
+				"page":      {"1"},
+				"page-size": {"200"},
+				"sort":      {"name:asc"},
+				"filter":    {`name=="a;b"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, err := tt.paginator.pageEndpoint(tt.page)
+			if err != nil {
+				t.Fatalf("pageEndpoint(%d) returned error: %v", tt.page, err)
+			}
+
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				t.Fatalf("pageEndpoint(%d) returned unparsable URL %q: %v", tt.page, endpoint, err)
+			}
+
+			if got := u.Query().Encode(); got != tt.want.Encode() {
+				t.Errorf("pageEndpoint(%d) query = %q, want %q", tt.page, got, tt.want.Encode())
+			}
+		})
+	}
+}
+
+func TestPaginatorRejectsConflictingCriteria(t *testing.T) {
+	tests := []struct {
+		name      string
+		paginator *Paginator[interface{}]
+	}{
+		{"Filter and Where", NewPaginator[interface{}](nil, "/api/v1/scripts").Filter(`name=="a"`).Where(rsql.Eq(rsql.FieldName, "b"))},
+		{"Where and legacy filter", NewPaginator[interface{}](nil, "/api/v1/scripts").Where(rsql.Eq(rsql.FieldName, "b")).RawQuery(`filter=name=="a"`)},
+		{"Sort and OrderBy", NewPaginator[interface{}](nil, "/api/v1/scripts").Sort("name:asc").withOptions([]ListOption{OrderBy(rsql.Desc(rsql.FieldID))})},
+		{"In with no values", NewPaginator[interface{}](nil, "/api/v1/scripts").Where(rsql.In(rsql.FieldName))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.paginator.fetchPage(nil, 0); err == nil {
+				t.Error("fetchPage() error = nil, want the conflicting criteria to be rejected")
+			}
+		})
+	}
+}
+
+func TestPaginatorIsLastPage(t *testing.T) {
+	paginator := NewPaginator[int](nil, "/api/v1/scripts").PageSize(2)
+
+	tests := []struct {
+		name    string
+		resp    *PaginatedResponse[int]
+		fetched int
+		want    bool
+	}{
+		{"Empty page", &PaginatedResponse[int]{TotalCount: 10}, 4, true},
+		{"Short page", &PaginatedResponse[int]{TotalCount: 10, Results: []int{1}}, 5, true},
+		{"Total reached", &PaginatedResponse[int]{TotalCount: 4, Results: []int{1, 2}}, 4, true},
+		{"More pages", &PaginatedResponse[int]{TotalCount: 10, Results: []int{1, 2}}, 4, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := paginator.isLastPage(tt.resp, tt.fetched); got != tt.want {
+				t.Errorf("isLastPage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginatorAll(t *testing.T) {
+	for _, workers := range []int{1, 4} {
+		t.Run("workers "+strconv.Itoa(workers), func(t *testing.T) {
+			var requests atomic.Int32
+			client := newTestClient(t, pagedHandler(25, -1, &requests), 4)
+
+			resp, err := NewPaginator[int](client, "/api/v1/numbers").PageSize(10).Parallel(workers).All()
+			if err != nil {
+				t.Fatalf("All() returned error: %v", err)
+			}
+
+			if resp.TotalCount != 25 {
+				t.Errorf("All() TotalCount = %d, want 25", resp.TotalCount)
+			}
+
+			if len(resp.Results) != 25 {
+				t.Fatalf("All() returned %d results, want 25", len(resp.Results))
+			}
+
+			for i, value := range resp.Results {
+				if value != i {
+					t.Fatalf("All() result %d = %d, want results in page order", i, value)
+				}
+			}
+
+			if got := requests.Load(); got != 3 {
+				t.Errorf("All() sent %d requests, want 3", got)
+			}
+		})
+	}
+}
+
+func TestPaginatorParallelFailure(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, pagedHandler(100, 2, &requests), 3)
+
+	_, err := NewPaginator[int](client, "/api/v1/numbers").PageSize(10).Parallel(0).All()
+	if err == nil {
+		t.Fatal("All() returned no error for a failed page")
+	}
+}
+
+func TestPaginatorParallelFailureCancelsEarlierPages(t *testing.T) {
+	aborted := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			// Held until the failure of page 2 cancels it.
+			select {
+			case <-r.Context().Done():
+				close(aborted)
+			case <-time.After(10 * time.Second):
+			}
+			return
+		case "2":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"httpStatus":400,"errors":[]}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(PaginatedResponse[int]{TotalCount: 30, Results: make([]int, 10)})
+	})
+	client := newTestClient(t, handler, 3)
+
+	start := time.Now()
+	_, err := NewPaginator[int](client, "/api/v1/numbers").PageSize(10).Parallel(0).All()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("All() error = %v, want the failure of page 2", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("All() took %v, want page 1 cancelled when page 2 failed", elapsed)
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Error("the server did not see page 1 cancelled")
+	}
+}
+
+func TestPaginatorItemsStopsEarly(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, pagedHandler(100, -1, &requests), 1)
+
+	var seen []int
+	for value, err := range NewPaginator[int](client, "/api/v1/numbers").PageSize(10).Items() {
+		if err != nil {
+			t.Fatalf("Items() returned error: %v", err)
+		}
+		seen = append(seen, value)
+		if len(seen) == 15 {
+			break
+		}
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Items() sent %d requests after stopping on the second page, want 2", got)
+	}
+}
@@ -0,0 +1,543 @@
+// cassette.go
+// Recording of real Jamf Pro traffic to cassette files, and replay of cassettes, so that tests of code
+// built on the SDK can run offline against responses captured from a live tenant.
+package jamfprotest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// EnvCassetteMode selects the mode of CassetteTransport: "record" or "replay".
+const EnvCassetteMode = "JAMF_CASSETTE_MODE"
+
+// Redacted replaces secrets and tokens in cassettes.
+const Redacted = "REDACTED"
+
+// redactedFields are the form fields, JSON keys and XML elements whose values are redacted, compared
+// ignoring case. The Classic API holds passwords for accounts, LDAP and SMTP servers and distribution
+// points. The client id of the token exchange is redacted too, so that a cassette replays for any API
+// client.
+var redactedFields = []string{
+	"client_id", "client_secret", "clientSecret", "password", "access_token", "refresh_token", "token",
+	"secretAccessKey", "sessionToken", "accessKeyID",
+	"read_only_password", "read_write_password", "http_password", "ssh_password",
+}
+
+// redactedHeaders are the headers whose values are redacted.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// DefaultMaxBodySize is the largest body a Recorder keeps unless its MaxBodySize is set.
+const DefaultMaxBodySize = 1 << 20
+
+// Cassette is the file format of recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request as stored in a cassette, with secrets redacted.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body"`
+	// BodyOmitted is set when the body was not recorded because it is binary or too large, such as
+	// a package upload. The body is then not compared when matching.
+	BodyOmitted bool `json:"body_omitted,omitempty"`
+}
+
+// RecordedResponse is a response as stored in a cassette, with secrets and tokens redacted.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body"`
+	// BodyOmitted is set when the body was not recorded because it is binary or too large, such as
+	// a package download. It is replayed as an empty body.
+	BodyOmitted bool `json:"body_omitted,omitempty"`
+}
+
+// Body is a request or response body. Bodies which are not valid UTF-8 are stored as base64.
+type Body []byte
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*b = Body(text)
+		return nil
+	}
+
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	*b = decoded
+	return err
+}
+
+// LoadCassette reads the cassette at path.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("could not unmarshal cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to path, replacing any existing file.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create cassette directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("could not write cassette: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// Recorder is a transport which sends requests with its base transport and records every request and
+// response, including token requests. Pass it to the client with jamfpro.WithTransport, and call Close
+// once the client is done to write the cassette file.
+//
+// Only text bodies, such as JSON, XML and forms, of up to MaxBodySize are recorded. Other bodies, such
+// as package uploads and JCDS downloads, are passed through without being buffered and are marked as
+// omitted in the cassette.
+type Recorder struct {
+	// MaxBodySize is the largest body recorded. Defaults to DefaultMaxBodySize.
+	MaxBodySize int64
+
+	path string
+	base http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a recorder which writes to the cassette at path, sending requests with base, or
+// http.DefaultTransport when base is nil.
+func NewRecorder(path string, base http.RoundTripper) *Recorder {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Recorder{path: path, base: base}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxBodySize := r.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+
+	requestBody, requestOmitted, err := captureBody(&req.Body, req.Header.Get("Content-Type"), maxBodySize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, responseOmitted, err := captureBody(&resp.Body, resp.Header.Get("Content-Type"), maxBodySize)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			Header:      redactHeader(req.Header),
+			Body:        redactBody(requestBody, req.Header.Get("Content-Type")),
+			BodyOmitted: requestOmitted,
+		},
+		Response: RecordedResponse{
+			StatusCode:  resp.StatusCode,
+			Header:      redactHeader(resp.Header),
+			Body:        redactBody(responseBody, resp.Header.Get("Content-Type")),
+			BodyOmitted: responseOmitted,
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return resp, nil
+}
+
+// Close writes the interactions recorded so far to the cassette file, replacing any existing file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+// Replayer is a transport which answers requests from a cassette without network access. A request
+// matches an interaction with the same method, path, query and body, ignoring the host, so that
+// replayed clients can use any instance domain. Secrets in request bodies are redacted before
+// matching, and multipart, binary and omitted bodies are not compared.
+//
+// Matching interactions are served in the order they were recorded, so that a read after an update
+// returns the updated object. Once every matching interaction has been served the last one is served
+// again, as clients may request tokens more or less often than when the cassette was recorded.
+type Replayer struct {
+	cassette *Cassette
+
+	mu     sync.Mutex
+	served []bool
+}
+
+// NewReplayer returns a replayer for the cassette at path.
+func NewReplayer(path string) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Replayer{cassette: cassette, served: make([]bool, len(cassette.Interactions))}, nil
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, omitted, err := captureBody(&req.Body, req.Header.Get("Content-Type"), DefaultMaxBodySize)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	body = redactBody(body, req.Header.Get("Content-Type"))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !matches(interaction.Request, req, body, omitted) {
+			continue
+		}
+		if !r.served[i] {
+			r.served[i] = true
+			return replayResponse(interaction.Response, req), nil
+		}
+		last = i
+	}
+
+	if last < 0 {
+		return nil, fmt.Errorf("jamfprotest: no recorded interaction matches %s %s", req.Method, req.URL.RequestURI())
+	}
+	return replayResponse(r.cassette.Interactions[last].Response, req), nil
+}
+
+// Close releases the replayer. It exists so that a Replayer can be used wherever a Recorder is.
+func (r *Replayer) Close() error {
+	return nil
+}
+
+// CassetteRoundTripper is the Recorder or Replayer returned by CassetteTransport. Close must be called
+// once the client is done, so that a recording is written.
+type CassetteRoundTripper interface {
+	http.RoundTripper
+	io.Closer
+}
+
+// CassetteTransport returns a Recorder sending requests with base when JAMF_CASSETTE_MODE is
+// "record", and a Replayer otherwise, so that the same test can refresh its cassette against a live
+// tenant and run offline:
+//
+//	transport, err := jamfprotest.CassetteTransport("testdata/buildings.json", nil)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	t.Cleanup(func() { transport.Close() })
+//	client, err := jamfpro.New(jamfpro.WithConfig(config), jamfpro.WithTransport(transport))
+func CassetteTransport(path string, base http.RoundTripper) (CassetteRoundTripper, error) {
+	switch mode := os.Getenv(EnvCassetteMode); mode {
+	case "record":
+		return NewRecorder(path, base), nil
+	case "", "replay":
+		return NewReplayer(path)
+	default:
+		return nil, fmt.Errorf("invalid %s %q, supported modes are record and replay", EnvCassetteMode, mode)
+	}
+}
+
+// matches reports whether req, whose redacted body is body, matches the recorded request. Bodies are
+// not compared when either was omitted.
+func matches(recorded RecordedRequest, req *http.Request, body []byte, omitted bool) bool {
+	if recorded.Method != req.Method {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil || recordedURL.Path != req.URL.Path {
+		return false
+	}
+	if !reflect.DeepEqual(recordedURL.Query(), req.URL.Query()) {
+		return false
+	}
+
+	if omitted || recorded.BodyOmitted {
+		return true
+	}
+	return bytes.Equal(bytes.TrimSpace(recorded.Body), bytes.TrimSpace(body))
+}
+
+// replayResponse builds the response to req from a recorded response.
+func replayResponse(recorded RecordedResponse, req *http.Request) *http.Response {
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	body := refreshTokenExpiry(recorded.Body, header.Get("Content-Type"))
+	header.Del("Content-Length")
+
+	return &http.Response{
+		StatusCode:    recorded.StatusCode,
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// refreshTokenExpiry moves the expiry of a recorded basic auth token into the future, as the
+// integration refuses tokens which have already expired.
+func refreshTokenExpiry(body []byte, contentType string) []byte {
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType != "application/json" {
+		return body
+	}
+
+	var token map[string]interface{}
+	if json.Unmarshal(body, &token) != nil {
+		return body
+	}
+	if _, ok := token["expires"].(string); !ok || token["token"] == nil {
+		return body
+	}
+
+	token["expires"] = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	refreshed, err := json.Marshal(token)
+	if err != nil {
+		return body
+	}
+	return refreshed
+}
+
+// captureBody reads a text body of up to maxSize bytes and replaces it, so that it can be read again.
+// Bodies of other media types are left unread, and a larger body is put back together from the part
+// already read and the rest of the stream, so neither is buffered. Both are reported as omitted.
+func captureBody(body *io.ReadCloser, contentType string, maxSize int64) ([]byte, bool, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, false, nil
+	}
+	if !isText(contentType) {
+		return nil, true, nil
+	}
+
+	original := *body
+	data, err := io.ReadAll(io.LimitReader(original, maxSize+1))
+	if err != nil {
+		original.Close()
+		return nil, false, err
+	}
+	if int64(len(data)) > maxSize {
+		*body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), original), original}
+		return nil, true, nil
+	}
+
+	original.Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, false, nil
+}
+
+// isText reports whether a body of contentType is text worth recording. Bodies without a content type
+// are assumed to be text, as Jamf Pro sends some short error responses without one.
+func isText(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		strings.HasSuffix(mediaType, "json"),
+		strings.HasSuffix(mediaType, "xml"),
+		mediaType == "application/x-www-form-urlencoded":
+		return true
+	}
+	return false
+}
+
+// redactHeader returns a copy of header with credentials redacted.
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range redactedHeaders {
+		if values := redacted.Values(name); len(values) > 0 {
+			redacted[http.CanonicalHeaderKey(name)] = []string{Redacted}
+		}
+	}
+	return redacted
+}
+
+// redactBody returns body with the values of secret form fields, JSON keys and XML elements redacted.
+func redactBody(body []byte, contentType string) []byte {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		changed := false
+		for key := range form {
+			if isRedactedField(key) {
+				form.Set(key, Redacted)
+				changed = true
+			}
+		}
+		if !changed {
+			return body
+		}
+		return []byte(form.Encode())
+
+	case "application/json":
+		var value interface{}
+		if json.Unmarshal(body, &value) != nil || !redactJSON(value) {
+			return body
+		}
+		redacted, err := json.Marshal(value)
+		if err != nil {
+			return body
+		}
+		return redacted
+
+	case "application/xml", "text/xml":
+		return redactXML(body)
+	}
+	return body
+}
+
+// redactXML replaces the text of secret elements in an XML document, leaving the rest of the document
+// byte for byte as it was. Elements with child elements are not redacted, and a document which cannot
+// be parsed is redacted up to the error.
+func redactXML(body []byte) []byte {
+	type span struct{ start, end int64 }
+	var secrets []span
+
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		element, ok := token.(xml.StartElement)
+		if !ok || !isRedactedField(element.Name.Local) {
+			continue
+		}
+
+		start, end := decoder.InputOffset(), decoder.InputOffset()
+		for {
+			if token, err = decoder.Token(); err != nil {
+				break
+			}
+			if _, ok := token.(xml.CharData); !ok {
+				break
+			}
+			end = decoder.InputOffset()
+		}
+		if closing, ok := token.(xml.EndElement); ok && closing.Name == element.Name && end > start {
+			secrets = append(secrets, span{start, end})
+		}
+	}
+	if len(secrets) == 0 {
+		return body
+	}
+
+	var redacted bytes.Buffer
+	previous := int64(0)
+	for _, secret := range secrets {
+		redacted.Write(body[previous:secret.start])
+		redacted.WriteString(Redacted)
+		previous = secret.end
+	}
+	redacted.Write(body[previous:])
+	return redacted.Bytes()
+}
+
+// redactJSON redacts secret keys in a decoded JSON value in place, reporting whether any were found.
+func redactJSON(value interface{}) bool {
+	changed := false
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if _, isString := child.(string); isString && isRedactedField(key) {
+				value[key] = Redacted
+				changed = true
+				continue
+			}
+			changed = redactJSON(child) || changed
+		}
+	case []interface{}:
+		for _, child := range value {
+			changed = redactJSON(child) || changed
+		}
+	}
+	return changed
+}
+
+func isRedactedField(name string) bool {
+	for _, field := range redactedFields {
+		if strings.EqualFold(name, field) {
+			return true
+		}
+	}
+	return false
+}
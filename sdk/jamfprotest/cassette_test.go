@@ -0,0 +1,247 @@
+// cassette_test.go
+package jamfprotest_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "buildings.json")
+
+	srv := jamfprotest.NewServer()
+	recorder := jamfprotest.NewRecorder(path, nil)
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(recorder))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	created, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head Office", City: "London"})
+	if err != nil {
+		t.Fatalf("CreateBuilding() returned error: %v", err)
+	}
+	if _, err := client.UpdateBuildingByID(created.ID, &jamfpro.ResourceBuilding{Name: "Head Office", City: "Leeds"}); err != nil {
+		t.Fatalf("UpdateBuildingByID() returned error: %v", err)
+	}
+	if _, err := client.GetBuildingByID(created.ID); err != nil {
+		t.Fatalf("GetBuildingByID() returned error: %v", err)
+	}
+	srv.Close()
+
+	if _, err := os.Stat(path); err == nil {
+		t.Error("cassette was written before the recorder was closed")
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+	if strings.Contains(string(data), srv.ClientSecret) {
+		t.Error("cassette contains the client secret")
+	}
+
+	cassette, err := jamfprotest.LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette() returned error: %v", err)
+	}
+	if len(cassette.Interactions) != 4 {
+		t.Fatalf("cassette has %d interactions, want the token request and 3 API requests", len(cassette.Interactions))
+	}
+	token := cassette.Interactions[0]
+	if !strings.HasSuffix(token.Request.URL, "/api/oauth/token") || !strings.Contains(string(token.Response.Body), `"access_token":"REDACTED"`) {
+		t.Errorf("token interaction = %s %s, want a redacted token", token.Request.URL, token.Response.Body)
+	}
+	for _, interaction := range cassette.Interactions[1:] {
+		if got := interaction.Request.Header.Get("Authorization"); got != jamfprotest.Redacted {
+			t.Errorf("%s %s Authorization = %q, want it redacted", interaction.Request.Method, interaction.Request.URL, got)
+		}
+	}
+
+	// The server is closed, so every response is served from the cassette.
+	replayer, err := jamfprotest.NewReplayer(path)
+	if err != nil {
+		t.Fatalf("NewReplayer() returned error: %v", err)
+	}
+	config := srv.Config()
+	config.ClientSecret = "a-different-secret"
+	replay, err := jamfpro.New(jamfpro.WithConfig(config), jamfpro.WithTransport(replayer))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	replayed, err := replay.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head Office", City: "London"})
+	if err != nil {
+		t.Fatalf("replayed CreateBuilding() returned error: %v", err)
+	}
+	if replayed.ID != created.ID {
+		t.Errorf("replayed CreateBuilding() id = %s, want %s", replayed.ID, created.ID)
+	}
+	if _, err := replay.UpdateBuildingByID(created.ID, &jamfpro.ResourceBuilding{Name: "Head Office", City: "Leeds"}); err != nil {
+		t.Fatalf("replayed UpdateBuildingByID() returned error: %v", err)
+	}
+	building, err := replay.GetBuildingByID(created.ID)
+	if err != nil {
+		t.Fatalf("replayed GetBuildingByID() returned error: %v", err)
+	}
+	if building.City != "Leeds" {
+		t.Errorf("replayed GetBuildingByID() city = %s, want Leeds", building.City)
+	}
+
+	// Requests which were not recorded fail rather than reaching the network.
+	if _, err := replay.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Annex"}); err == nil {
+		t.Error("CreateBuilding() with a body which was not recorded returned no error")
+	}
+	if _, err := replay.GetBuildingByID("999"); err == nil {
+		t.Error("GetBuildingByID() of a path which was not recorded returned no error")
+	}
+}
+
+func TestCassetteRedactsClassicAPIPasswords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ldap_servers.json")
+
+	srv := jamfprotest.NewServer()
+	recorder := jamfprotest.NewRecorder(path, nil)
+	client, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(recorder))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	ldapServer := func(password string) *jamfpro.ResourceLDAPServers {
+		return &jamfpro.ResourceLDAPServers{Connection: jamfpro.LDAPServerSubsetConnection{
+			Name:    "Directory",
+			Account: jamfpro.LDAPServerSubsetConnectionAccount{DistinguishedUsername: "cn=jamf", Password: password},
+		}}
+	}
+	if _, err := client.CreateLDAPServer(ldapServer("ldap-secret")); err != nil {
+		t.Fatalf("CreateLDAPServer() returned error: %v", err)
+	}
+	srv.Close()
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "ldap-secret") {
+		t.Error("cassette contains the LDAP password")
+	}
+	cassette, err := jamfprotest.LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette() returned error: %v", err)
+	}
+	if body := string(cassette.Interactions[1].Request.Body); !strings.Contains(body, "<password>REDACTED</password>") {
+		t.Errorf("CreateLDAPServer() request body = %s, want the password redacted", body)
+	}
+
+	// Request bodies are redacted before matching, so a replay with another password is served.
+	replayer, err := jamfprotest.NewReplayer(path)
+	if err != nil {
+		t.Fatalf("NewReplayer() returned error: %v", err)
+	}
+	replay, err := jamfpro.New(jamfpro.WithConfig(srv.Config()), jamfpro.WithTransport(replayer))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if _, err := replay.CreateLDAPServer(ldapServer("another-secret")); err != nil {
+		t.Fatalf("replayed CreateLDAPServer() returned error: %v", err)
+	}
+}
+
+func TestRecorderOmitsBinaryAndLargeBodies(t *testing.T) {
+	pkg := bytes.Repeat([]byte{0xca, 0xfe}, 1024)
+	large := `"` + strings.Repeat("a", 64) + `"`
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		switch r.URL.Path {
+		case "/package.pkg":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write(pkg)
+		case "/large":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(large))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"ok":true}`))
+		}
+	}))
+	defer upstream.Close()
+
+	path := filepath.Join(t.TempDir(), "transfers.json")
+	recorder := jamfprotest.NewRecorder(path, nil)
+	recorder.MaxBodySize = 32
+	client := &http.Client{Transport: recorder}
+
+	fetch := func(method, url, contentType string, body []byte) []byte {
+		t.Helper()
+		req, err := http.NewRequest(method, url, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", contentType)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s returned error: %v", method, url, err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	if got := fetch(http.MethodGet, upstream.URL+"/package.pkg", "", nil); !bytes.Equal(got, pkg) {
+		t.Errorf("binary download returned %d bytes, want the package passed through", len(got))
+	}
+	if got := fetch(http.MethodGet, upstream.URL+"/large", "", nil); string(got) != large {
+		t.Errorf("large response = %s, want it passed through", got)
+	}
+	fetch(http.MethodPut, upstream.URL+"/upload", "application/octet-stream", pkg)
+	fetch(http.MethodPost, upstream.URL+"/small", "application/json", []byte(`{"name":"a"}`))
+
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+	cassette, err := jamfprotest.LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette() returned error: %v", err)
+	}
+	if len(cassette.Interactions) != 4 {
+		t.Fatalf("cassette has %d interactions, want 4", len(cassette.Interactions))
+	}
+	download, largeResponse, upload, small := cassette.Interactions[0], cassette.Interactions[1], cassette.Interactions[2], cassette.Interactions[3]
+	if !download.Response.BodyOmitted || len(download.Response.Body) != 0 {
+		t.Errorf("binary response body = %d bytes, omitted %v, want it omitted", len(download.Response.Body), download.Response.BodyOmitted)
+	}
+	if !largeResponse.Response.BodyOmitted || len(largeResponse.Response.Body) != 0 {
+		t.Errorf("large response body = %d bytes, omitted %v, want it omitted", len(largeResponse.Response.Body), largeResponse.Response.BodyOmitted)
+	}
+	if !upload.Request.BodyOmitted || len(upload.Request.Body) != 0 {
+		t.Errorf("binary request body = %d bytes, omitted %v, want it omitted", len(upload.Request.Body), upload.Request.BodyOmitted)
+	}
+	if small.Request.BodyOmitted || string(small.Request.Body) != `{"name":"a"}` {
+		t.Errorf("small request body = %s, omitted %v, want it recorded", small.Request.Body, small.Request.BodyOmitted)
+	}
+
+	// Omitted request bodies are not compared when replaying.
+	replayer, err := jamfprotest.NewReplayer(path)
+	if err != nil {
+		t.Fatalf("NewReplayer() returned error: %v", err)
+	}
+	client.Transport = replayer
+	fetch(http.MethodPut, upstream.URL+"/upload", "application/octet-stream", []byte("another package"))
+}
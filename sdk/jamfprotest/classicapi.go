@@ -0,0 +1,286 @@
+// classicapi.go
+// In-memory Classic API collections served as XML under /JSSResource.
+package jamfprotest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// xmlNode is a generic XML element, used to store Classic API resources without knowing their schema.
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []*xmlNode `xml:",any"`
+}
+
+// child returns the first direct child element with the given name.
+func (n *xmlNode) child(name string) *xmlNode {
+	for _, child := range n.Children {
+		if child.XMLName.Local == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// identity returns the element holding the resource's id and name, which is the 'general' element
+// for resources such as policies and computers, and the root element otherwise.
+func (n *xmlNode) identity() *xmlNode {
+	if general := n.child("general"); general != nil {
+		return general
+	}
+	return n
+}
+
+// field returns the text of a child of the identity element.
+func (n *xmlNode) field(name string) string {
+	if child := n.identity().child(name); child != nil {
+		return strings.TrimSpace(child.Text)
+	}
+	return ""
+}
+
+// setID sets the resource id, adding an id element when the resource has none.
+func (n *xmlNode) setID(id int) {
+	identity := n.identity()
+	if child := identity.child("id"); child != nil {
+		child.Text = strconv.Itoa(id)
+		return
+	}
+	identity.Children = append([]*xmlNode{{XMLName: xml.Name{Local: "id"}, Text: strconv.Itoa(id)}}, identity.Children...)
+}
+
+// trimText removes the whitespace between child elements left by indented documents.
+func (n *xmlNode) trimText() {
+	if len(n.Children) > 0 {
+		n.Text = strings.TrimSpace(n.Text)
+	}
+	for _, child := range n.Children {
+		child.trimText()
+	}
+}
+
+// classicCollection holds the records of a single Classic API collection in insertion order.
+type classicCollection struct {
+	nextID  int
+	ids     []int
+	records map[int]*xmlNode
+}
+
+// classicStore holds every Classic API collection, keyed by the collection name, such as 'computergroups'.
+type classicStore struct {
+	collections map[string]*classicCollection
+}
+
+func newClassicStore() *classicStore {
+	return &classicStore{collections: map[string]*classicCollection{}}
+}
+
+// collection returns the named collection, creating it if necessary.
+func (s *classicStore) collection(name string) *classicCollection {
+	collection, ok := s.collections[name]
+	if !ok {
+		collection = &classicCollection{nextID: 1, records: map[int]*xmlNode{}}
+		s.collections[name] = collection
+	}
+	return collection
+}
+
+// classicRoute splits a Classic API path into its collection, lookup type ('id' or 'name') and key.
+func classicRoute(path string) (collection, lookup, key string) {
+	parts := strings.SplitN(strings.Trim(strings.TrimPrefix(path, "/JSSResource/"), "/"), "/", 4)
+	collection = parts[0]
+	if len(parts) >= 3 {
+		lookup, key = parts[1], parts[2]
+	}
+	return collection, lookup, key
+}
+
+// add stores resource, keeping its id when it has one, and returns the id.
+func (s *classicStore) add(path string, resource interface{}) (string, error) {
+	data, err := xml.Marshal(resource)
+	if err != nil {
+		return "", err
+	}
+
+	record, err := decodeXMLNode(data)
+	if err != nil {
+		return "", err
+	}
+
+	name, _, _ := classicRoute(path)
+	id, _ := strconv.Atoi(record.field("id"))
+	return strconv.Itoa(s.collection(name).insert(record, id)), nil
+}
+
+func (s *classicStore) len(path string) int {
+	name, _, _ := classicRoute(path)
+	return len(s.collection(name).ids)
+}
+
+// insert stores record under id, or under the next free id when id is zero.
+func (c *classicCollection) insert(record *xmlNode, id int) int {
+	if id <= 0 {
+		for c.records[c.nextID] != nil {
+			c.nextID++
+		}
+		id = c.nextID
+		c.nextID++
+	} else if id >= c.nextID {
+		c.nextID = id + 1
+	}
+
+	if _, exists := c.records[id]; !exists {
+		c.ids = append(c.ids, id)
+	}
+
+	record.setID(id)
+	c.records[id] = record
+	return id
+}
+
+// find returns the id of the record addressed by lookup and key, or 0 when there is none.
+func (c *classicCollection) find(lookup, key string) int {
+	switch lookup {
+	case "id":
+		if id, err := strconv.Atoi(key); err == nil && c.records[id] != nil {
+			return id
+		}
+	case "name":
+		for _, id := range c.ids {
+			if c.records[id].field("name") == key {
+				return id
+			}
+		}
+	}
+	return 0
+}
+
+// remove deletes the record with id.
+func (c *classicCollection) remove(id int) {
+	delete(c.records, id)
+	for i, existing := range c.ids {
+		if existing == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			return
+		}
+	}
+}
+
+// serveHTTP serves the list, create, read, update and delete operations of a Classic API collection.
+func (s *classicStore) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	name, lookup, key := classicRoute(r.URL.Path)
+	collection := s.collection(name)
+
+	// Listing and creating
+	if lookup == "" || (r.Method == http.MethodPost && lookup == "id" && (key == "0" || key == "-1")) {
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, name, collection)
+		case http.MethodPost:
+			record, ok := decodeClassicRecord(w, r)
+			if !ok {
+				return
+			}
+			id := collection.insert(record, 0)
+			writeClassicID(w, http.StatusCreated, record.XMLName.Local, id)
+		default:
+			writeClassicError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		}
+		return
+	}
+
+	id := collection.find(lookup, key)
+	if id == 0 {
+		writeClassicError(w, http.StatusNotFound, "The server has not found anything matching the request URI")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeXML(w, http.StatusOK, collection.records[id])
+	case http.MethodPut:
+		record, ok := decodeClassicRecord(w, r)
+		if !ok {
+			return
+		}
+		collection.insert(record, id)
+		writeClassicID(w, http.StatusCreated, record.XMLName.Local, id)
+	case http.MethodDelete:
+		root := collection.records[id].XMLName.Local
+		collection.remove(id)
+		writeClassicID(w, http.StatusOK, root, id)
+	default:
+		writeClassicError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// list serves the collection as '<collection><size/><item><id/><name/></item>...</collection>'.
+func (s *classicStore) list(w http.ResponseWriter, name string, collection *classicCollection) {
+	list := &xmlNode{XMLName: xml.Name{Local: name}}
+	list.Children = append(list.Children, &xmlNode{XMLName: xml.Name{Local: "size"}, Text: strconv.Itoa(len(collection.ids))})
+
+	for _, id := range collection.ids {
+		record := collection.records[id]
+		list.Children = append(list.Children, &xmlNode{
+			XMLName: record.XMLName,
+			Children: []*xmlNode{
+				{XMLName: xml.Name{Local: "id"}, Text: strconv.Itoa(id)},
+				{XMLName: xml.Name{Local: "name"}, Text: record.field("name")},
+			},
+		})
+	}
+
+	writeXML(w, http.StatusOK, list)
+}
+
+// decodeXMLNode decodes an XML document into a generic element tree.
+func decodeXMLNode(data []byte) (*xmlNode, error) {
+	var node xmlNode
+	if err := xml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	node.trimText()
+	return &node, nil
+}
+
+// decodeClassicRecord decodes the request body, writing an error response on failure.
+func decodeClassicRecord(w http.ResponseWriter, r *http.Request) (*xmlNode, bool) {
+	var node xmlNode
+	if err := xml.NewDecoder(r.Body).Decode(&node); err != nil {
+		writeClassicError(w, http.StatusBadRequest, "Problem with request body: "+err.Error())
+		return nil, false
+	}
+	node.trimText()
+	return &node, true
+}
+
+// writeXML writes node as an XML response.
+func writeXML(w http.ResponseWriter, status int, node *xmlNode) {
+	w.Header().Set("Content-Type", "application/xml;charset=UTF-8")
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(node)
+}
+
+// writeClassicID writes the '<root><id>n</id></root>' response returned by create, update and delete.
+func writeClassicID(w http.ResponseWriter, status int, root string, id int) {
+	writeXML(w, status, &xmlNode{
+		XMLName:  xml.Name{Local: root},
+		Children: []*xmlNode{{XMLName: xml.Name{Local: "id"}, Text: strconv.Itoa(id)}},
+	})
+}
+
+// writeClassicError writes the HTML error page returned by the Classic API.
+func writeClassicError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<html><head><title>Status page</title></head><body><p>%s</p><p>%s</p></body></html>",
+		http.StatusText(status), html.EscapeString(message))
+}
@@ -0,0 +1,365 @@
+// filter.go
+// A small RSQL evaluator implementing the filter and sort query parameters of Jamf Pro API list endpoints.
+package jamfprotest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// filterExpr is a parsed RSQL expression evaluated against a JSON object.
+type filterExpr interface {
+	match(record map[string]interface{}) bool
+}
+
+// logicalExpr is a group of expressions joined by ';' (and) or ',' (or).
+type logicalExpr struct {
+	and      bool
+	operands []filterExpr
+}
+
+func (e logicalExpr) match(record map[string]interface{}) bool {
+	for _, operand := range e.operands {
+		if operand.match(record) != e.and {
+			return !e.and
+		}
+	}
+	return e.and
+}
+
+// comparisonExpr compares the value of a selector with one or more arguments.
+type comparisonExpr struct {
+	selector   string
+	comparison string
+	arguments  []string
+}
+
+func (e comparisonExpr) match(record map[string]interface{}) bool {
+	value := formatField(lookupField(record, e.selector))
+
+	switch e.comparison {
+	case "==":
+		return matchWildcard(e.arguments[0], value)
+	case "!=":
+		return !matchWildcard(e.arguments[0], value)
+	case "=in=", "=out=":
+		found := false
+		for _, argument := range e.arguments {
+			if strings.EqualFold(unescapeArgument(argument), value) {
+				found = true
+				break
+			}
+		}
+		return found == (e.comparison == "=in=")
+	case "=lt=":
+		return compareValues(value, unescapeArgument(e.arguments[0])) < 0
+	case "=le=":
+		return compareValues(value, unescapeArgument(e.arguments[0])) <= 0
+	case "=gt=":
+		return compareValues(value, unescapeArgument(e.arguments[0])) > 0
+	case "=ge=":
+		return compareValues(value, unescapeArgument(e.arguments[0])) >= 0
+	}
+	return false
+}
+
+// parseFilter parses an RSQL filter expression.
+func parseFilter(input string) (filterExpr, error) {
+	p := &filterParser{input: input}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.input[p.pos], p.pos)
+	}
+	return expr, nil
+}
+
+// filterParser is a recursive descent parser for RSQL expressions.
+type filterParser struct {
+	input string
+	pos   int
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	return p.parseLogical(',', false, p.parseAnd)
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	return p.parseLogical(';', true, p.parseTerm)
+}
+
+// parseLogical parses operands separated by separator.
+func (p *filterParser) parseLogical(separator byte, and bool, operand func() (filterExpr, error)) (filterExpr, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []filterExpr{first}
+	for p.pos < len(p.input) && p.input[p.pos] == separator {
+		p.pos++
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return logicalExpr{and: and, operands: operands}, nil
+}
+
+// parseTerm parses a parenthesised group or a single comparison.
+func (p *filterParser) parseTerm() (filterExpr, error) {
+	if p.pos < len(p.input) && p.input[p.pos] == '(' {
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.input) || p.input[p.pos] != ')' {
+			return nil, fmt.Errorf("missing ')' at position %d", p.pos)
+		}
+		p.pos++
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parseComparison parses '<selector><comparison><arguments>'.
+func (p *filterParser) parseComparison() (filterExpr, error) {
+	start := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] != '=' && p.input[p.pos] != '!' {
+		p.pos++
+	}
+	selector := strings.TrimSpace(p.input[start:p.pos])
+	if selector == "" {
+		return nil, fmt.Errorf("missing selector at position %d", start)
+	}
+
+	comparison, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+
+	var arguments []string
+	if p.pos < len(p.input) && p.input[p.pos] == '(' {
+		p.pos++
+		for {
+			argument, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, argument)
+
+			if p.pos >= len(p.input) {
+				return nil, fmt.Errorf("missing ')' at position %d", p.pos)
+			}
+			if p.input[p.pos] == ')' {
+				p.pos++
+				break
+			}
+			if p.input[p.pos] != ',' {
+				return nil, fmt.Errorf("unexpected %q at position %d", p.input[p.pos], p.pos)
+			}
+			p.pos++
+		}
+	} else {
+		argument, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		arguments = []string{argument}
+	}
+
+	return comparisonExpr{selector: selector, comparison: comparison, arguments: arguments}, nil
+}
+
+// parseOperator parses '==', '!=' or an '=name=' comparison.
+func (p *filterParser) parseOperator() (string, error) {
+	rest := p.input[p.pos:]
+	switch {
+	case strings.HasPrefix(rest, "=="), strings.HasPrefix(rest, "!="):
+		p.pos += 2
+		return rest[:2], nil
+	case strings.HasPrefix(rest, "="):
+		end := strings.IndexByte(rest[1:], '=')
+		if end < 0 {
+			return "", fmt.Errorf("invalid comparison at position %d", p.pos)
+		}
+		operator := rest[:end+2]
+		switch operator {
+		case "=lt=", "=le=", "=gt=", "=ge=", "=in=", "=out=":
+			p.pos += len(operator)
+			return operator, nil
+		}
+		return "", fmt.Errorf("unsupported comparison %q", operator)
+	}
+	return "", fmt.Errorf("missing comparison at position %d", p.pos)
+}
+
+// parseValue parses a quoted or unquoted argument.
+func (p *filterParser) parseValue() (string, error) {
+	if p.pos < len(p.input) && (p.input[p.pos] == '"' || p.input[p.pos] == '\'') {
+		quote := p.input[p.pos]
+		p.pos++
+
+		var value strings.Builder
+		for p.pos < len(p.input) {
+			c := p.input[p.pos]
+			switch {
+			case c == '\\' && p.pos+1 < len(p.input):
+				// Escaped wildcards and backslashes stay escaped, so that matchWildcard treats them as
+				// literal characters.
+				if next := p.input[p.pos+1]; next == '*' || next == '\\' {
+					value.WriteByte(c)
+				}
+				value.WriteByte(p.input[p.pos+1])
+				p.pos += 2
+			case c == quote:
+				p.pos++
+				return value.String(), nil
+			default:
+				value.WriteByte(c)
+				p.pos++
+			}
+		}
+		return "", fmt.Errorf("unterminated string")
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(";,()", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", fmt.Errorf("missing argument at position %d", start)
+	}
+	return p.input[start:p.pos], nil
+}
+
+// lookupField returns the value of a dotted selector such as 'general.name'.
+func lookupField(record map[string]interface{}, selector string) interface{} {
+	var value interface{} = record
+	for _, key := range strings.Split(selector, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+// formatField renders a decoded JSON value as it would appear in a filter argument.
+func formatField(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// matchWildcard reports whether value matches pattern case insensitively, where '*' matches any sequence
+// and '\*' a literal '*'.
+func matchWildcard(pattern, value string) bool {
+	value = strings.ToLower(value)
+
+	parts := splitWildcards(strings.ToLower(pattern))
+	if len(parts) == 1 {
+		return parts[0] == value
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(part):]
+	}
+
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+// splitWildcards splits pattern at its unescaped '*' wildcards, unescaping the parts.
+func splitWildcards(pattern string) []string {
+	var parts []string
+	var part strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case pattern[i] == '\\' && i+1 < len(pattern):
+			i++
+			part.WriteByte(pattern[i])
+		case pattern[i] == '*':
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(pattern[i])
+		}
+	}
+	return append(parts, part.String())
+}
+
+// unescapeArgument returns an argument with its escaped wildcards and backslashes unescaped.
+func unescapeArgument(argument string) string {
+	return strings.Join(splitWildcards(argument), "*")
+}
+
+// compareValues compares two values numerically when both are numbers, and as strings otherwise.
+func compareValues(a, b string) int {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	if errX == nil && errY == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+// sortRecords stably sorts records by criteria in the form '<field>[:asc|:desc]'.
+func sortRecords(records []map[string]interface{}, criteria []string) {
+	var fields []string
+	for _, criterion := range criteria {
+		for _, field := range strings.Split(criterion, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		for _, field := range fields {
+			name, direction, _ := strings.Cut(field, ":")
+			result := compareValues(formatField(lookupField(records[i], name)), formatField(lookupField(records[j], name)))
+			if result == 0 {
+				continue
+			}
+			if strings.EqualFold(direction, "desc") {
+				return result > 0
+			}
+			return result < 0
+		}
+		return false
+	})
+}
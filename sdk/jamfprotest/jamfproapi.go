@@ -0,0 +1,323 @@
+// jamfproapi.go
+// In-memory Jamf Pro API collections served as JSON under /api/vN.
+package jamfprotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// versionPrefix matches the '/api/vN' prefix, which is ignored so that every version of an endpoint
+// shares the same collection.
+var versionPrefix = regexp.MustCompile(`^/api/v\d+/`)
+
+// idPattern matches the numeric and UUID identifiers used by the Jamf Pro API.
+var idPattern = regexp.MustCompile(`^(\d+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})$`)
+
+// numericIDCollections are the collections whose ids are JSON numbers rather than strings.
+var numericIDCollections = map[string]bool{
+	"api-integrations": true,
+}
+
+// proCollection holds the records of a single Jamf Pro API collection in insertion order.
+type proCollection struct {
+	nextID     int
+	numericIDs bool
+	ids        []string
+	records    map[string]map[string]interface{}
+}
+
+// proStore holds every Jamf Pro API collection, keyed by the path without its version prefix.
+type proStore struct {
+	collections map[string]*proCollection
+}
+
+func newProStore() *proStore {
+	return &proStore{collections: map[string]*proCollection{}}
+}
+
+// collectionKey strips the '/api/vN/' prefix and any trailing slash from path.
+func collectionKey(path string) string {
+	return strings.Trim(versionPrefix.ReplaceAllString(path, ""), "/")
+}
+
+// collection returns the collection at key, creating it when create is set.
+func (s *proStore) collection(key string, create bool) *proCollection {
+	collection, ok := s.collections[key]
+	if !ok && create {
+		collection = &proCollection{nextID: 1, numericIDs: numericIDCollections[key], records: map[string]map[string]interface{}{}}
+		s.collections[key] = collection
+	}
+	return collection
+}
+
+// add stores resource, keeping its id when it has one, and returns the id.
+func (s *proStore) add(path string, resource interface{}) (string, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return "", err
+	}
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return "", fmt.Errorf("resource must encode to a JSON object: %w", err)
+	}
+
+	id := formatField(record["id"])
+	if id == "" || id == "0" {
+		id = ""
+	}
+	return s.collection(collectionKey(path), true).insert(record, id), nil
+}
+
+func (s *proStore) len(path string) int {
+	if collection := s.collection(collectionKey(path), false); collection != nil {
+		return len(collection.ids)
+	}
+	return 0
+}
+
+// records returns the records of the collection at key in insertion order.
+func (s *proStore) records(key string) []map[string]interface{} {
+	collection := s.collection(key, false)
+	if collection == nil {
+		return nil
+	}
+
+	records := make([]map[string]interface{}, 0, len(collection.ids))
+	for _, id := range collection.ids {
+		records = append(records, collection.records[id])
+	}
+	return records
+}
+
+// record returns the record with id in the collection at key, or nil when there is none.
+func (s *proStore) record(key, id string) map[string]interface{} {
+	if collection := s.collection(key, false); collection != nil {
+		return collection.records[id]
+	}
+	return nil
+}
+
+// insert stores record under id, or under the next free id when id is empty.
+func (c *proCollection) insert(record map[string]interface{}, id string) string {
+	if id == "" {
+		for c.records[strconv.Itoa(c.nextID)] != nil {
+			c.nextID++
+		}
+		id = strconv.Itoa(c.nextID)
+		c.nextID++
+	} else if n, err := strconv.Atoi(id); err == nil && n >= c.nextID {
+		c.nextID = n + 1
+	}
+
+	if _, exists := c.records[id]; !exists {
+		c.ids = append(c.ids, id)
+	}
+
+	record["id"] = c.encodeID(id)
+	c.records[id] = record
+	return id
+}
+
+// encodeID returns id as it appears in the collection's JSON.
+func (c *proCollection) encodeID(id string) interface{} {
+	if c.numericIDs {
+		return json.Number(id)
+	}
+	return id
+}
+
+// remove deletes the record with id, reporting whether it existed.
+func (c *proCollection) remove(id string) bool {
+	if _, ok := c.records[id]; !ok {
+		return false
+	}
+
+	delete(c.records, id)
+	for i, existing := range c.ids {
+		if existing == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// serveHTTP routes a Jamf Pro API request to a collection or to a single record.
+func (s *proStore) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	key := collectionKey(r.URL.Path)
+	parent, last := key, ""
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		parent, last = key[:i], key[i+1:]
+	}
+
+	if r.Method == http.MethodPost && last == "delete-multiple" {
+		s.deleteMultiple(w, r, parent)
+		return
+	}
+
+	if collection := s.collection(parent, false); last != "" && (idPattern.MatchString(last) || (collection != nil && collection.records[last] != nil)) {
+		s.serveRecord(w, r, parent, last)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.list(w, r, key)
+	case http.MethodPost:
+		s.create(w, r, key)
+	default:
+		writeProError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "", "Method not allowed")
+	}
+}
+
+// list serves a page of the collection, applying the filter, sort, page and page-size parameters.
+func (s *proStore) list(w http.ResponseWriter, r *http.Request, key string) {
+	query := r.URL.Query()
+
+	var filter filterExpr
+	if raw := query.Get("filter"); raw != "" {
+		var err error
+		if filter, err = parseFilter(raw); err != nil {
+			writeProError(w, http.StatusBadRequest, "INVALID_RSQL_FILTER_FIELD", "filter", err.Error())
+			return
+		}
+	}
+
+	records := []map[string]interface{}{}
+	if collection := s.collection(key, false); collection != nil {
+		for _, id := range collection.ids {
+			if record := collection.records[id]; filter == nil || filter.match(record) {
+				records = append(records, record)
+			}
+		}
+	}
+	sortRecords(records, query["sort"])
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, err := strconv.Atoi(query.Get("page-size"))
+	if err != nil || pageSize < 1 {
+		pageSize = 100
+	}
+
+	start := min(max(page, 0)*pageSize, len(records))
+	end := min(start+pageSize, len(records))
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"totalCount": len(records),
+		"results":    records[start:end],
+	})
+}
+
+// create stores the request body as a new record.
+func (s *proStore) create(w http.ResponseWriter, r *http.Request, key string) {
+	record, ok := decodeRecord(w, r)
+	if !ok {
+		return
+	}
+
+	collection := s.collection(key, true)
+	id := collection.insert(record, "")
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"id":   collection.encodeID(id),
+		"href": fmt.Sprintf("http://%s%s/%s", r.Host, strings.TrimSuffix(r.URL.Path, "/"), id),
+	})
+}
+
+// serveRecord reads, replaces, patches or deletes a single record.
+func (s *proStore) serveRecord(w http.ResponseWriter, r *http.Request, key, id string) {
+	collection := s.collection(key, false)
+	if collection == nil || collection.records[id] == nil {
+		writeProError(w, http.StatusNotFound, "INVALID_ID", "id", fmt.Sprintf("Resource with id %s not found", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, collection.records[id])
+	case http.MethodPut:
+		record, ok := decodeRecord(w, r)
+		if !ok {
+			return
+		}
+		collection.insert(record, id)
+		writeJSON(w, http.StatusOK, record)
+	case http.MethodPatch:
+		patch, ok := decodeRecord(w, r)
+		if !ok {
+			return
+		}
+		record := collection.records[id]
+		mergePatch(record, patch)
+		record["id"] = collection.encodeID(id)
+		writeJSON(w, http.StatusOK, record)
+	case http.MethodDelete:
+		collection.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeProError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "", "Method not allowed")
+	}
+}
+
+// deleteMultiple serves the '<collection>/delete-multiple' endpoints.
+func (s *proStore) deleteMultiple(w http.ResponseWriter, r *http.Request, key string) {
+	var body struct {
+		IDs []string `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeProError(w, http.StatusBadRequest, "INVALID_FIELD", "ids", err.Error())
+		return
+	}
+
+	if collection := s.collection(key, false); collection != nil {
+		for _, id := range body.IDs {
+			collection.remove(id)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeRecord decodes the request body as a JSON object, writing an error response on failure.
+func decodeRecord(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	var record map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&record); err != nil || record == nil {
+		writeProError(w, http.StatusBadRequest, "INVALID_JSON", "", "Request body must be a JSON object")
+		return nil, false
+	}
+	return record, true
+}
+
+// mergePatch merges patch into record, recursing into nested objects. Null values in the patch are
+// ignored, matching the way Jamf Pro treats sections which are not sent.
+func mergePatch(record, patch map[string]interface{}) {
+	for key, value := range patch {
+		switch v := value.(type) {
+		case nil:
+			continue
+		case map[string]interface{}:
+			if existing, ok := record[key].(map[string]interface{}); ok {
+				mergePatch(existing, v)
+				continue
+			}
+		}
+		record[key] = value
+	}
+}
+
+// writeProError writes a Jamf Pro API error response.
+func writeProError(w http.ResponseWriter, status int, code, field, description string) {
+	writeJSON(w, status, map[string]interface{}{
+		"httpStatus": status,
+		"errors": []map[string]interface{}{{
+			"code":        code,
+			"field":       field,
+			"description": description,
+			"id":          "0",
+		}},
+	})
+}
@@ -0,0 +1,558 @@
+// jcds.go
+// In-memory Jamf Cloud Distribution Service: the /api/v1/jcds endpoints, and the S3 bucket behind them
+// served through the transport returned by Server.Transport.
+package jamfprotest
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JCDS bucket details issued with upload credentials.
+const (
+	JCDSBucket = "jamfprotest-jcds"
+	JCDSRegion = "eu-west-2"
+	JCDSPath   = "jamfprotest/"
+)
+
+// jcdsEndpoint is the prefix of the Jamf Pro JCDS endpoints.
+const jcdsEndpoint = "/api/v1/jcds"
+
+// s3Namespace is the XML namespace of S3 responses.
+const s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// s3Object is an object stored in the fake bucket.
+type s3Object struct {
+	data        []byte
+	etag        string
+	contentType string
+	metadata    map[string]string
+	modified    time.Time
+}
+
+// s3Upload is a multipart upload in progress.
+type s3Upload struct {
+	key         string
+	contentType string
+	metadata    map[string]string
+	parts       map[int]s3Part
+}
+
+// s3Part is an uploaded part of a multipart upload.
+type s3Part struct {
+	data []byte
+	etag string
+}
+
+// jcdsStore holds the objects and multipart uploads of the JCDS bucket, keyed by object key.
+type jcdsStore struct {
+	mu      sync.Mutex
+	objects map[string]*s3Object
+	uploads map[string]*s3Upload
+	// kms is set when objects are encrypted with a KMS key, whose ETags are not MD5s.
+	kms bool
+}
+
+func newJCDSStore() *jcdsStore {
+	return &jcdsStore{objects: map[string]*s3Object{}, uploads: map[string]*s3Upload{}}
+}
+
+// AddJCDSFile stores data in the JCDS as the file name.
+func (s *Server) AddJCDSFile(name string, data []byte) {
+	s.jcds.mu.Lock()
+	defer s.jcds.mu.Unlock()
+
+	s.jcds.put(JCDSPath+name, data, "", nil)
+}
+
+// JCDSFile returns the contents of the JCDS file name, and whether it exists.
+func (s *Server) JCDSFile(name string) ([]byte, bool) {
+	s.jcds.mu.Lock()
+	defer s.jcds.mu.Unlock()
+
+	object, ok := s.jcds.objects[JCDSPath+name]
+	if !ok {
+		return nil, false
+	}
+	return bytes.Clone(object.data), true
+}
+
+// SetJCDSKMSEncryption sets whether the JCDS bucket encrypts objects with a KMS key, as S3 does for
+// buckets with SSE-KMS default encryption. The ETags of objects and parts stored after the call are
+// then not MD5s of their content.
+func (s *Server) SetJCDSKMSEncryption(enabled bool) {
+	s.jcds.mu.Lock()
+	defer s.jcds.mu.Unlock()
+
+	s.jcds.kms = enabled
+}
+
+// JCDSUploads returns the number of multipart uploads which have been started but neither completed
+// nor aborted.
+func (s *Server) JCDSUploads() int {
+	s.jcds.mu.Lock()
+	defer s.jcds.mu.Unlock()
+
+	return len(s.jcds.uploads)
+}
+
+// Transport returns a transport which serves requests to the JCDS bucket from memory and sends every
+// other request over the network. Config uses it, so that SDK methods which talk to S3 directly work
+// against the server.
+func (s *Server) Transport() http.RoundTripper {
+	return &jcdsTransport{store: s.jcds}
+}
+
+// jcdsTransport routes requests for AWS hosts to the fake bucket.
+type jcdsTransport struct {
+	store *jcdsStore
+}
+
+func (t *jcdsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.HasSuffix(req.URL.Hostname(), ".amazonaws.com") {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+
+	recorder := httptest.NewRecorder()
+	t.store.serveS3(recorder, req)
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+// serveJCDS serves the Jamf Pro JCDS endpoints, reporting whether path was one of them.
+func (s *Server) serveJCDS(w http.ResponseWriter, r *http.Request) bool {
+	path, ok := strings.CutPrefix(r.URL.Path, jcdsEndpoint)
+	if !ok {
+		return false
+	}
+
+	switch {
+	case (path == "/files" || path == "/renew-credentials") && r.Method == http.MethodPost:
+		writeJSON(w, http.StatusOK, map[string]string{
+			"accessKeyID":     "ASIA" + strings.ToUpper(randomHex(8)),
+			"secretAccessKey": randomHex(20),
+			"sessionToken":    randomHex(32),
+			"region":          JCDSRegion,
+			"bucketName":      JCDSBucket,
+			"path":            JCDSPath,
+			"uuid":            newUUID(),
+		})
+
+	case path == "/properties" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"jcds2Enabled":              true,
+			"fileStreamEndpointEnabled": true,
+			"maxChunkSize":              1000,
+		})
+
+	case path == "/files" && r.Method == http.MethodGet:
+		s.jcds.mu.Lock()
+		defer s.jcds.mu.Unlock()
+
+		files := []map[string]interface{}{}
+		for _, key := range sortedObjectKeys(s.jcds.objects, JCDSPath) {
+			object := s.jcds.objects[key]
+			sum := md5.Sum(object.data)
+			files = append(files, map[string]interface{}{
+				"fileName": strings.TrimPrefix(key, JCDSPath),
+				"length":   len(object.data),
+				"md5":      hex.EncodeToString(sum[:]),
+				"region":   JCDSRegion,
+				"sha3":     "",
+			})
+		}
+		writeJSON(w, http.StatusOK, files)
+
+	case strings.HasPrefix(path, "/files/") && r.Method == http.MethodGet:
+		name := strings.TrimPrefix(path, "/files/")
+		s.jcds.mu.Lock()
+		_, exists := s.jcds.objects[JCDSPath+name]
+		s.jcds.mu.Unlock()
+		if !exists {
+			writeProError(w, http.StatusNotFound, "NOT_FOUND", "fileName", fmt.Sprintf("File %s not found", name))
+			return true
+		}
+		writeJSON(w, http.StatusOK, map[string]string{
+			"uri": fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s%s?X-Amz-Signature=%s",
+				JCDSBucket, JCDSRegion, JCDSPath, url.PathEscape(name), randomHex(16)),
+		})
+
+	default:
+		writeProError(w, http.StatusNotFound, "NOT_FOUND", "", "Not Found")
+	}
+	return true
+}
+
+// packageUploadPath matches the endpoint which uploads the file of a package.
+var packageUploadPath = regexp.MustCompile(`^/api/v1/packages/([^/]+)/upload$`)
+
+// handlePackageUpload stores the file uploaded for a package in the JCDS, and records its hashes in
+// the package as Jamf Pro does.
+func (s *Server) handlePackageUpload(w http.ResponseWriter, r *http.Request, id string) {
+	record := s.pro.record("packages", id)
+	if record == nil {
+		writeProError(w, http.StatusNotFound, "INVALID_ID", "id", fmt.Sprintf("Package with id %s not found", id))
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeProError(w, http.StatusBadRequest, "INVALID_FIELD", "file", "A package file is required")
+		return
+	}
+	defer file.Close()
+
+	// The SDK's http client sends file parts base64 encoded.
+	var content io.Reader = file
+	if strings.EqualFold(header.Header.Get("Content-Transfer-Encoding"), "base64") {
+		content = base64.NewDecoder(base64.StdEncoding, file)
+	}
+	data, err := io.ReadAll(content)
+	if err != nil {
+		writeProError(w, http.StatusBadRequest, "INVALID_FIELD", "file", err.Error())
+		return
+	}
+
+	name := filepath.Base(header.Filename)
+	s.jcds.mu.Lock()
+	s.jcds.put(JCDSPath+name, data, "", nil)
+	s.jcds.mu.Unlock()
+
+	md5Sum, sha512Sum := md5.Sum(data), sha512.Sum512(data)
+	record["fileName"] = name
+	record["md5"] = hex.EncodeToString(md5Sum[:])
+	record["hashType"] = "SHA_512"
+	record["hashValue"] = hex.EncodeToString(sha512Sum[:])
+	record["size"] = strconv.Itoa(len(data))
+
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"id":   id,
+		"href": fmt.Sprintf("http://%s/api/v1/packages/%s", r.Host, id),
+	})
+}
+
+// sortedObjectKeys returns the keys of objects under prefix in lexical order, as S3 lists them.
+func sortedObjectKeys(objects map[string]*s3Object, prefix string) []string {
+	var keys []string
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// put stores an object, returning its ETag.
+func (s *jcdsStore) put(key string, data []byte, contentType string, metadata map[string]string) string {
+	etag := s.etag(data)
+	if contentType == "" {
+		contentType = "binary/octet-stream"
+	}
+	s.objects[key] = &s3Object{data: data, etag: etag, contentType: contentType, metadata: metadata, modified: time.Now().UTC()}
+	return etag
+}
+
+// etag returns the ETag of an object or part: the MD5 of its data, or with KMS encryption an opaque
+// value.
+func (s *jcdsStore) etag(data []byte) string {
+	if s.kms {
+		return `"` + randomHex(16) + `"`
+	}
+	return md5ETag(data)
+}
+
+// setEncryption reports the encryption of objects in a response.
+func (s *jcdsStore) setEncryption(header http.Header) {
+	if s.kms {
+		header.Set("X-Amz-Server-Side-Encryption", "aws:kms")
+	} else {
+		header.Set("X-Amz-Server-Side-Encryption", "AES256")
+	}
+}
+
+// serveS3 serves the subset of the S3 API used for JCDS transfers: single and multipart uploads,
+// HEAD, ranged GET, DELETE and ListObjectsV2. Virtual hosted and path style requests are accepted,
+// and request signatures are not checked.
+func (s *jcdsStore) serveS3(w http.ResponseWriter, r *http.Request) {
+	bucket, _, _ := strings.Cut(r.URL.Hostname(), ".s3.")
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if bucket == "s3" || strings.HasPrefix(bucket, "s3.") {
+		bucket, key, _ = strings.Cut(key, "/")
+	}
+	if bucket != JCDSBucket {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		s.listObjects(w, query.Get("prefix"))
+	case query.Has("uploads") && r.Method == http.MethodPost:
+		s.createMultipartUpload(w, r, key)
+	case query.Has("uploadId"):
+		s.serveMultipartUpload(w, r, key, query)
+	case r.Method == http.MethodPut:
+		data, ok := readS3Body(w, r)
+		if !ok {
+			return
+		}
+		w.Header().Set("ETag", s.put(key, data, r.Header.Get("Content-Type"), s3Metadata(r.Header)))
+		s.setEncryption(w.Header())
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		object, ok := s.objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+		w.Header().Set("ETag", object.etag)
+		w.Header().Set("Content-Type", object.contentType)
+		s.setEncryption(w.Header())
+		for name, value := range object.metadata {
+			w.Header().Set("X-Amz-Meta-"+name, value)
+		}
+		http.ServeContent(w, r, "", object.modified, bytes.NewReader(object.data))
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+	}
+}
+
+func (s *jcdsStore) listObjects(w http.ResponseWriter, prefix string) {
+	type content struct {
+		Key          string
+		LastModified string
+		ETag         string
+		Size         int
+		StorageClass string
+	}
+	result := struct {
+		XMLName     xml.Name `xml:"ListBucketResult"`
+		Xmlns       string   `xml:"xmlns,attr"`
+		Name        string
+		Prefix      string
+		KeyCount    int
+		MaxKeys     int
+		IsTruncated bool
+		Contents    []content
+	}{Xmlns: s3Namespace, Name: JCDSBucket, Prefix: prefix, MaxKeys: 1000}
+
+	for _, key := range sortedObjectKeys(s.objects, prefix) {
+		object := s.objects[key]
+		result.Contents = append(result.Contents, content{
+			Key:          key,
+			LastModified: object.modified.Format(time.RFC3339),
+			ETag:         object.etag,
+			Size:         len(object.data),
+			StorageClass: "STANDARD",
+		})
+	}
+	result.KeyCount = len(result.Contents)
+	writeS3XML(w, result)
+}
+
+func (s *jcdsStore) createMultipartUpload(w http.ResponseWriter, r *http.Request, key string) {
+	id := randomHex(16)
+	s.uploads[id] = &s3Upload{
+		key:         key,
+		contentType: r.Header.Get("Content-Type"),
+		metadata:    s3Metadata(r.Header),
+		parts:       map[int]s3Part{},
+	}
+	s.setEncryption(w.Header())
+	writeS3XML(w, struct {
+		XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+		Xmlns    string   `xml:"xmlns,attr"`
+		Bucket   string
+		Key      string
+		UploadId string
+	}{Xmlns: s3Namespace, Bucket: JCDSBucket, Key: key, UploadId: id})
+}
+
+// serveMultipartUpload uploads a part, lists the parts, completes or aborts a multipart upload.
+func (s *jcdsStore) serveMultipartUpload(w http.ResponseWriter, r *http.Request, key string, query url.Values) {
+	id := query.Get("uploadId")
+	upload, ok := s.uploads[id]
+	if !ok || upload.key != key {
+		writeS3Error(w, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		number, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil || number < 1 || number > 10000 {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "Part number must be an integer between 1 and 10000, inclusive")
+			return
+		}
+		data, ok := readS3Body(w, r)
+		if !ok {
+			return
+		}
+		part := s3Part{data: data, etag: s.etag(data)}
+		upload.parts[number] = part
+		w.Header().Set("ETag", part.etag)
+		s.setEncryption(w.Header())
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet:
+		type part struct {
+			PartNumber   int
+			LastModified string
+			ETag         string
+			Size         int
+		}
+		result := struct {
+			XMLName     xml.Name `xml:"ListPartsResult"`
+			Xmlns       string   `xml:"xmlns,attr"`
+			Bucket      string
+			Key         string
+			UploadId    string
+			IsTruncated bool
+			Parts       []part `xml:"Part"`
+		}{Xmlns: s3Namespace, Bucket: JCDSBucket, Key: key, UploadId: id}
+
+		numbers := make([]int, 0, len(upload.parts))
+		for number := range upload.parts {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+		for _, number := range numbers {
+			uploaded := upload.parts[number]
+			result.Parts = append(result.Parts, part{
+				PartNumber:   number,
+				LastModified: time.Now().UTC().Format(time.RFC3339),
+				ETag:         uploaded.etag,
+				Size:         len(uploaded.data),
+			})
+		}
+		writeS3XML(w, result)
+
+	case http.MethodPost:
+		var complete struct {
+			Parts []struct {
+				PartNumber int
+				ETag       string
+			} `xml:"Part"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil || len(complete.Parts) == 0 {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed.")
+			return
+		}
+
+		var data []byte
+		var sums []byte
+		for i, part := range complete.Parts {
+			uploaded, ok := upload.parts[part.PartNumber]
+			if !ok || part.ETag != uploaded.etag || (i > 0 && part.PartNumber <= complete.Parts[i-1].PartNumber) {
+				writeS3Error(w, http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found.")
+				return
+			}
+			data = append(data, uploaded.data...)
+			sum := md5.Sum(uploaded.data)
+			sums = append(sums, sum[:]...)
+		}
+
+		s.put(key, data, upload.contentType, upload.metadata)
+		sum := md5.Sum(sums)
+		etag := fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(sum[:]), len(complete.Parts))
+		if s.kms {
+			etag = fmt.Sprintf(`"%s-%d"`, randomHex(16), len(complete.Parts))
+		}
+		s.objects[key].etag = etag
+		delete(s.uploads, id)
+
+		writeS3XML(w, struct {
+			XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+			Xmlns    string   `xml:"xmlns,attr"`
+			Location string
+			Bucket   string
+			Key      string
+			ETag     string
+		}{Xmlns: s3Namespace, Location: "https://" + JCDSBucket + ".s3.amazonaws.com/" + key, Bucket: JCDSBucket, Key: key, ETag: etag})
+
+	case http.MethodDelete:
+		delete(s.uploads, id)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+	}
+}
+
+// readS3Body reads an upload body, checking its Content-MD5 when one was sent.
+func readS3Body(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeS3Error(w, http.StatusBadRequest, "IncompleteBody", "You did not provide the number of bytes specified by the Content-Length HTTP header.")
+		return nil, false
+	}
+
+	if digest := r.Header.Get("Content-MD5"); digest != "" {
+		sum := md5.Sum(data)
+		if digest != base64.StdEncoding.EncodeToString(sum[:]) {
+			writeS3Error(w, http.StatusBadRequest, "BadDigest", "The Content-MD5 you specified did not match what we received.")
+			return nil, false
+		}
+	}
+	return data, true
+}
+
+// s3Metadata returns the user metadata of a request, keyed by lower case name.
+func s3Metadata(header http.Header) map[string]string {
+	metadata := map[string]string{}
+	for name := range header {
+		if key, ok := strings.CutPrefix(strings.ToLower(name), "x-amz-meta-"); ok {
+			metadata[key] = header.Get(name)
+		}
+	}
+	return metadata
+}
+
+func md5ETag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeS3XML(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(v)
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: message})
+}
@@ -0,0 +1,368 @@
+// server.go
+// Package jamfprotest provides an in-memory fake Jamf Pro server for unit testing code built on the SDK
+// without network access or a live tenant.
+//
+// The server issues OAuth2 and basic auth bearer tokens, serves Jamf Pro API JSON collections under
+// /api and Classic API XML collections under /JSSResource, and keeps every resource in memory. JCDS
+// files are kept in an in-memory S3 bucket, reached through the transport set by Config:
+//
+//	srv := jamfprotest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := srv.NewClient()
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	created, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head Office"})
+//
+// Any collection path is accepted, so resources which the server has never seen before can be created
+// and read back without registering them first. Jamf Pro API list requests support pagination, sorting
+// and the common RSQL filter operators. Classic API resources can be addressed by id or by name.
+package jamfprotest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"go.uber.org/zap"
+)
+
+// Token endpoints used by the Jamf Pro integration.
+const (
+	oAuthTokenEndpoint      = "/api/oauth/token"
+	bearerTokenEndpoint     = "/api/v1/auth/token"
+	keepAliveTokenEndpoint  = "/api/v1/auth/keep-alive"
+	invalidateTokenEndpoint = "/api/v1/auth/invalidate-token"
+)
+
+// clientCredentialsPath matches the endpoint which generates the client credentials of an API integration.
+var clientCredentialsPath = regexp.MustCompile(`^/api/v1/api-integrations/([^/]+)/client-credentials$`)
+
+// DefaultPrivileges returns the API role privileges listed by a new Server.
+func DefaultPrivileges() []string {
+	return []string{
+		"Create Buildings", "Read Buildings", "Update Buildings", "Delete Buildings",
+		"Create Categories", "Read Categories", "Update Categories", "Delete Categories",
+		"Create Computers", "Read Computers", "Update Computers", "Delete Computers",
+		"Create Packages", "Read Packages", "Update Packages", "Delete Packages",
+		"Create Policies", "Read Policies", "Update Policies", "Delete Policies",
+		"Create Scripts", "Read Scripts", "Update Scripts", "Delete Scripts",
+		"Create API Integrations", "Read API Integrations", "Update API Integrations", "Delete API Integrations",
+		"Create API Roles", "Read API Roles", "Update API Roles", "Delete API Roles",
+		"Read Jamf Content Distribution Server Files", "Create Jamf Content Distribution Server Files",
+	}
+}
+
+// Default credentials accepted by a new Server.
+const (
+	DefaultClientID     = "jamfprotest-client-id"
+	DefaultClientSecret = "jamfprotest-client-secret"
+	DefaultUsername     = "jamfprotest"
+	DefaultPassword     = "jamfprotest-password"
+)
+
+// Server is a fake Jamf Pro server backed by an in-memory store.
+type Server struct {
+	*httptest.Server
+
+	// Credentials accepted by the token endpoints. They may be changed before the first request, and
+	// with SetClientSecret afterwards.
+	ClientID     string
+	ClientSecret string
+	Username     string
+	Password     string
+
+	// TokenLifetime is the lifetime of issued bearer tokens.
+	TokenLifetime time.Duration
+
+	// Privileges are the API role privileges listed by /api/v1/api-role-privileges.
+	Privileges []string
+
+	// Version is the version reported by /api/v1/jamf-pro-version.
+	Version string
+
+	mu     sync.Mutex
+	tokens map[string]time.Time
+	// clientSecrets holds the current secret of each API integration client id.
+	clientSecrets map[string]string
+	pro           *proStore
+	classic       *classicStore
+	jcds          *jcdsStore
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		ClientID:      DefaultClientID,
+		ClientSecret:  DefaultClientSecret,
+		Username:      DefaultUsername,
+		Password:      DefaultPassword,
+		TokenLifetime: 20 * time.Minute,
+		Privileges:    DefaultPrivileges(),
+		Version:       "11.10.0-t1725000000",
+		tokens:        map[string]time.Time{},
+		clientSecrets: map[string]string{},
+		pro:           newProStore(),
+		classic:       newClassicStore(),
+		jcds:          newJCDSStore(),
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Config returns a client configuration which authenticates against the server with OAuth2, and sends
+// JCDS transfers to the server's bucket.
+func (s *Server) Config() *jamfpro.ConfigContainer {
+	return &jamfpro.ConfigContainer{
+		Logger:         zap.NewNop().Sugar(),
+		InstanceDomain: s.URL,
+		AuthMethod:     "oauth2",
+		ClientID:       s.ClientID,
+		ClientSecret:   s.ClientSecret,
+		Transport:      s.Transport(),
+	}
+}
+
+// NewClient builds a Jamf Pro client which sends every request to the server.
+func (s *Server) NewClient() (*jamfpro.Client, error) {
+	return jamfpro.BuildClient(s.Config())
+}
+
+// SetClientSecret changes the client secret accepted by the OAuth token endpoint, as rotating the
+// credentials of an API client does. Tokens which have already been issued remain valid.
+func (s *Server) SetClientSecret(secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ClientSecret = secret
+}
+
+// AddResource stores resource in the collection at path, such as "/api/v1/buildings" or
+// "/JSSResource/computergroups", and returns the id assigned to it. Jamf Pro API resources are
+// encoded as JSON and Classic API resources as XML, exactly as the SDK would send them.
+func (s *Server) AddResource(path string, resource interface{}) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if isClassicPath(path) {
+		return s.classic.add(path, resource)
+	}
+	return s.pro.add(path, resource)
+}
+
+// Len returns the number of resources stored in the collection at path.
+func (s *Server) Len(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if isClassicPath(path) {
+		return s.classic.len(path)
+	}
+	return s.pro.len(path)
+}
+
+// serveHTTP authenticates the request and routes it to the Jamf Pro or Classic API handlers.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case oAuthTokenEndpoint:
+		s.handleOAuthToken(w, r)
+		return
+	case bearerTokenEndpoint:
+		s.handleBearerToken(w, r)
+		return
+	}
+
+	token, ok := s.authenticate(r)
+	if !ok {
+		if isClassicPath(r.URL.Path) {
+			writeClassicError(w, http.StatusUnauthorized, "The request requires user authentication")
+		} else {
+			writeProError(w, http.StatusUnauthorized, "INVALID_TOKEN", "", "Unauthorized")
+		}
+		return
+	}
+
+	switch r.URL.Path {
+	case keepAliveTokenEndpoint:
+		s.mu.Lock()
+		delete(s.tokens, token)
+		s.mu.Unlock()
+		s.handleBearerToken(w, r)
+		return
+	case invalidateTokenEndpoint:
+		s.mu.Lock()
+		delete(s.tokens, token)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/api/v1/jamf-pro-version" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]string{"version": s.Version})
+		return
+	case r.URL.Path == "/api/v1/api-role-privileges" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string][]string{"privileges": s.Privileges})
+		return
+	case clientCredentialsPath.MatchString(r.URL.Path) && r.Method == http.MethodPost:
+		s.handleClientCredentials(w, clientCredentialsPath.FindStringSubmatch(r.URL.Path)[1])
+		return
+	}
+
+	if match := packageUploadPath.FindStringSubmatch(r.URL.Path); match != nil && r.Method == http.MethodPost {
+		s.handlePackageUpload(w, r, match[1])
+		return
+	}
+	if s.serveJCDS(w, r) {
+		return
+	}
+
+	if isClassicPath(r.URL.Path) {
+		s.classic.serveHTTP(w, r)
+		return
+	}
+	s.pro.serveHTTP(w, r)
+}
+
+// handleOAuthToken issues a token for the client credentials grant.
+func (s *Server) handleOAuthToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.ParseForm() != nil ||
+		r.PostForm.Get("grant_type") != "client_credentials" ||
+		!s.validClient(r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	token := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"scope":        "api-role:jamfprotest",
+		"expires_in":   int64(s.TokenLifetime / time.Second),
+	})
+}
+
+// validClient reports whether a client id and secret may obtain a token: either the server's own
+// credentials, or the current credentials of an enabled API integration.
+func (s *Server) validClient(clientID, clientSecret string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if clientID == s.ClientID {
+		return clientSecret == s.ClientSecret
+	}
+
+	for _, record := range s.pro.records("api-integrations") {
+		if record["clientId"] == clientID {
+			return record["enabled"] == true && s.clientSecrets[clientID] == clientSecret
+		}
+	}
+	return false
+}
+
+// handleClientCredentials generates new client credentials for an API integration, replacing any
+// previous secret. The integration keeps its client id once it has one.
+func (s *Server) handleClientCredentials(w http.ResponseWriter, id string) {
+	record := s.pro.record("api-integrations", id)
+	if record == nil {
+		writeProError(w, http.StatusNotFound, "INVALID_ID", "id", fmt.Sprintf("Api Integration with id %s not found", id))
+		return
+	}
+
+	clientID, _ := record["clientId"].(string)
+	if clientID == "" {
+		clientID = newUUID()
+		record["clientId"] = clientID
+	}
+
+	secret := randomHex(32)
+	s.clientSecrets[clientID] = secret
+	writeJSON(w, http.StatusOK, map[string]string{"clientId": clientID, "clientSecret": secret})
+}
+
+// handleBearerToken issues a token for basic auth credentials, or renews an authenticated token.
+func (s *Server) handleBearerToken(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == bearerTokenEndpoint {
+		username, password, ok := r.BasicAuth()
+		if r.Method != http.MethodPost || !ok || username != s.Username || password != s.Password {
+			writeProError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "", "Unauthorized")
+			return
+		}
+	}
+
+	token := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"token":   token,
+		"expires": time.Now().Add(s.TokenLifetime).UTC().Format(time.RFC3339),
+	})
+}
+
+// issueToken creates and records a new random bearer token.
+func (s *Server) issueToken() string {
+	token := randomHex(16)
+
+	s.mu.Lock()
+	s.tokens[token] = time.Now().Add(s.TokenLifetime)
+	s.mu.Unlock()
+
+	return token
+}
+
+// authenticate returns the request's bearer token if it was issued by the server and has not expired.
+func (s *Server) authenticate(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return "", false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiry, ok := s.tokens[token]
+	if !ok || time.Now().After(expiry) {
+		return "", false
+	}
+	return token, true
+}
+
+// randomHex returns n random bytes encoded as hex.
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	buf := make([]byte, 16)
+	rand.Read(buf)
+	buf[6] = buf[6]&0x0f | 0x40
+	buf[8] = buf[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buf[0:4], buf[4:6], buf[6:8], buf[8:10], buf[10:])
+}
+
+// isClassicPath reports whether path belongs to the Classic API.
+func isClassicPath(path string) bool {
+	return strings.HasPrefix(path, "/JSSResource/")
+}
+
+// writeJSON writes v as a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
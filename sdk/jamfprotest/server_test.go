@@ -0,0 +1,204 @@
+// server_test.go
+package jamfprotest_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfprotest"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/rsql"
+)
+
+// newClient starts a server and returns it with a client authenticated against it.
+func newClient(t *testing.T) (*jamfprotest.Server, *jamfpro.Client) {
+	t.Helper()
+
+	srv := jamfprotest.NewServer()
+	t.Cleanup(srv.Close)
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() returned error: %v", err)
+	}
+	return srv, client
+}
+
+func TestJamfProAPIRoundTrip(t *testing.T) {
+	srv, client := newClient(t)
+
+	created, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head Office", City: "London"})
+	if err != nil {
+		t.Fatalf("CreateBuilding() returned error: %v", err)
+	}
+
+	building, err := client.GetBuildingByID(created.ID)
+	if err != nil {
+		t.Fatalf("GetBuildingByID() returned error: %v", err)
+	}
+	if building.Name != "Head Office" || building.City != "London" {
+		t.Errorf("GetBuildingByID() = %+v, want the created building", building)
+	}
+
+	building.City = "Leeds"
+	if _, err := client.UpdateBuildingByID(created.ID, building); err != nil {
+		t.Fatalf("UpdateBuildingByID() returned error: %v", err)
+	}
+
+	byName, err := client.GetBuildingByName("Head Office")
+	if err != nil {
+		t.Fatalf("GetBuildingByName() returned error: %v", err)
+	}
+	if byName.ID != created.ID || byName.City != "Leeds" {
+		t.Errorf("GetBuildingByName() = %+v, want the updated building", byName)
+	}
+
+	// '*' in a name is matched literally rather than as a wildcard.
+	wildcard, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Head*"})
+	if err != nil {
+		t.Fatalf("CreateBuilding() returned error: %v", err)
+	}
+	if byName, err := client.GetBuildingByName("Head*"); err != nil || byName.ID != wildcard.ID {
+		t.Errorf("GetBuildingByName(Head*) = %+v, error %v, want the building named Head*", byName, err)
+	}
+	if err := client.DeleteBuildingByID(wildcard.ID); err != nil {
+		t.Fatalf("DeleteBuildingByID() returned error: %v", err)
+	}
+
+	if err := client.DeleteBuildingByID(created.ID); err != nil {
+		t.Fatalf("DeleteBuildingByID() returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildingByID(created.ID); !errors.Is(err, jamfpro.ErrNotFound) {
+		t.Errorf("GetBuildingByID() after delete error = %v, want ErrNotFound", err)
+	}
+
+	if got := srv.Len("/api/v1/buildings"); got != 0 {
+		t.Errorf("Len() after delete = %d, want 0", got)
+	}
+}
+
+func TestJamfProAPIListFilterAndSort(t *testing.T) {
+	srv, client := newClient(t)
+
+	for i := 1; i <= 250; i++ {
+		category := "Utilities"
+		if i%2 == 0 {
+			category = "Security"
+		}
+		if _, err := srv.AddResource("/api/v1/scripts", jamfpro.ResourceScript{Name: fmt.Sprintf("script-%03d", i), CategoryName: category}); err != nil {
+			t.Fatalf("AddResource() returned error: %v", err)
+		}
+	}
+
+	all, err := client.GetScripts("")
+	if err != nil {
+		t.Fatalf("GetScripts() returned error: %v", err)
+	}
+	if all.Size != 250 || len(all.Results) != 250 {
+		t.Fatalf("GetScripts() returned %d of %d scripts, want 250", len(all.Results), all.Size)
+	}
+
+	filtered, err := client.GetScripts(rsql.SortFilter(
+		rsql.Eq("categoryName", "Security").And(rsql.Like(rsql.FieldName, "script-1*")),
+		rsql.Desc(rsql.FieldName),
+	))
+	if err != nil {
+		t.Fatalf("GetScripts() with filter returned error: %v", err)
+	}
+	if filtered.Size != 50 || filtered.Results[0].Name != "script-198" {
+		t.Errorf("GetScripts() with filter returned %d scripts starting with %s, want 50 starting with script-198", filtered.Size, filtered.Results[0].Name)
+	}
+
+	typed, err := client.GetScripts("",
+		jamfpro.Where(rsql.Eq("categoryName", "Security").And(rsql.Like(rsql.FieldName, "script-1*"))),
+		jamfpro.OrderBy(rsql.Desc(rsql.FieldName)),
+	)
+	if err != nil {
+		t.Fatalf("GetScripts() with typed options returned error: %v", err)
+	}
+	if typed.Size != 50 || typed.Results[0].Name != filtered.Results[0].Name {
+		t.Errorf("GetScripts() with typed options returned %d scripts starting with %s, want the same as with a sort_filter", typed.Size, typed.Results[0].Name)
+	}
+
+	// Eq matches a '*' literally.
+	literal, err := client.GetScripts("", jamfpro.Where(rsql.Eq(rsql.FieldName, "script-1*")))
+	if err != nil {
+		t.Fatalf("GetScripts() with typed options returned error: %v", err)
+	}
+	if literal.Size != 0 {
+		t.Errorf("Eq(name, script-1*) matched %d scripts, want none", literal.Size)
+	}
+
+	if _, err := client.GetScripts("filter=name==x", jamfpro.Where(rsql.Eq(rsql.FieldName, "x"))); err == nil {
+		t.Error("GetScripts() with both a string and a typed filter returned no error")
+	}
+}
+
+func TestClassicAPIRoundTrip(t *testing.T) {
+	_, client := newClient(t)
+
+	created, err := client.CreateComputerGroup(&jamfpro.ResourceComputerGroup{Name: "Lab Macs"})
+	if err != nil {
+		t.Fatalf("CreateComputerGroup() returned error: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatal("CreateComputerGroup() returned no id")
+	}
+
+	group, err := client.GetComputerGroupByName("Lab Macs")
+	if err != nil {
+		t.Fatalf("GetComputerGroupByName() returned error: %v", err)
+	}
+	if group.ID != created.ID {
+		t.Errorf("GetComputerGroupByName() ID = %d, want %d", group.ID, created.ID)
+	}
+
+	group.Name = "Lab Macs (Retired)"
+	if _, err := client.UpdateComputerGroupByID(fmt.Sprint(created.ID), group); err != nil {
+		t.Fatalf("UpdateComputerGroupByID() returned error: %v", err)
+	}
+
+	groups, err := client.GetComputerGroups()
+	if err != nil {
+		t.Fatalf("GetComputerGroups() returned error: %v", err)
+	}
+	if groups.Size != 1 || groups.Results[0].Name != "Lab Macs (Retired)" {
+		t.Errorf("GetComputerGroups() = %+v, want the renamed group", groups)
+	}
+
+	if err := client.DeleteComputerGroupByName("Lab Macs (Retired)"); err != nil {
+		t.Fatalf("DeleteComputerGroupByName() returned error: %v", err)
+	}
+
+	_, err = client.GetComputerGroupByID(fmt.Sprint(created.ID))
+	var apiErr *jamfpro.APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, jamfpro.ErrNotFound) || apiErr.Body == "" {
+		t.Errorf("GetComputerGroupByID() after delete error = %v, want a not found *APIError with the HTML body", err)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	srv := jamfprotest.NewServer()
+	defer srv.Close()
+
+	config := srv.Config()
+	config.ClientSecret = "wrong"
+	if _, err := jamfpro.BuildClient(config); err == nil {
+		t.Error("BuildClient() with the wrong client secret returned no error")
+	}
+
+	config = srv.Config()
+	config.AuthMethod = "basic"
+	config.Username = srv.Username
+	config.Password = srv.Password
+	client, err := jamfpro.BuildClient(config)
+	if err != nil {
+		t.Fatalf("BuildClient() with basic auth returned error: %v", err)
+	}
+
+	if _, err := client.GetBuildings(""); err != nil {
+		t.Errorf("GetBuildings() with basic auth returned error: %v", err)
+	}
+}
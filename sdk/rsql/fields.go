@@ -0,0 +1,71 @@
+// fields.go
+// Selectors for commonly filtered and sorted fields.
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_v1-computers-inventory
+package rsql
+
+// Common selectors supported by most Jamf Pro API list endpoints.
+const (
+	FieldID          = "id"
+	FieldName        = "name"
+	FieldDisplayName = "displayName"
+)
+
+// Computer inventory selectors for /api/v1/computers-inventory.
+const (
+	ComputerID   = "id"
+	ComputerUDID = "udid"
+
+	// General
+	ComputerGeneralName                     = "general.name"
+	ComputerGeneralPlatform                 = "general.platform"
+	ComputerGeneralAssetTag                 = "general.assetTag"
+	ComputerGeneralBarcode1                 = "general.barcode1"
+	ComputerGeneralBarcode2                 = "general.barcode2"
+	ComputerGeneralLastIPAddress            = "general.lastIpAddress"
+	ComputerGeneralLastReportedIP           = "general.lastReportedIp"
+	ComputerGeneralLastContactTime          = "general.lastContactTime"
+	ComputerGeneralReportDate               = "general.reportDate"
+	ComputerGeneralLastEnrolledDate         = "general.lastEnrolledDate"
+	ComputerGeneralInitialEntryDate         = "general.initialEntryDate"
+	ComputerGeneralJamfBinaryVersion        = "general.jamfBinaryVersion"
+	ComputerGeneralManaged                  = "general.remoteManagement.managed"
+	ComputerGeneralSupervised               = "general.supervised"
+	ComputerGeneralUserApprovedMDM          = "general.userApprovedMdm"
+	ComputerGeneralEnrolledViaADE           = "general.enrolledViaAutomatedDeviceEnrollment"
+	ComputerGeneralMDMProfileExpiration     = "general.mdmProfileExpiration"
+	ComputerGeneralDistributionPoint        = "general.distributionPoint"
+	ComputerGeneralSiteID                   = "general.site.id"
+	ComputerGeneralManagementID             = "general.managementId"
+	ComputerGeneralDeclarativeDMEnabled     = "general.declarativeDeviceManagementEnabled"
+	ComputerGeneralEnrollmentMethodID       = "general.enrollmentMethod.id"
+	ComputerGeneralLastCloudBackupDate      = "general.lastCloudBackupDate"
+	ComputerGeneralITunesStoreAccountActive = "general.itunesStoreAccountActive"
+
+	// Hardware
+	ComputerHardwareSerialNumber    = "hardware.serialNumber"
+	ComputerHardwareModel           = "hardware.model"
+	ComputerHardwareModelIdentifier = "hardware.modelIdentifier"
+	ComputerHardwareMACAddress      = "hardware.macAddress"
+	ComputerHardwareProcessorType   = "hardware.processorType"
+	ComputerHardwareAppleSilicon    = "hardware.appleSilicon"
+
+	// Operating system
+	ComputerOperatingSystemName    = "operatingSystem.name"
+	ComputerOperatingSystemVersion = "operatingSystem.version"
+	ComputerOperatingSystemBuild   = "operatingSystem.build"
+
+	// User and location
+	ComputerUserUsername     = "userAndLocation.username"
+	ComputerUserRealname     = "userAndLocation.realname"
+	ComputerUserEmail        = "userAndLocation.email"
+	ComputerUserPosition     = "userAndLocation.position"
+	ComputerUserBuildingID   = "userAndLocation.buildingId"
+	ComputerUserDepartmentID = "userAndLocation.departmentId"
+	ComputerUserRoom         = "userAndLocation.room"
+
+	// Purchasing
+	ComputerPurchasingPONumber     = "purchasing.poNumber"
+	ComputerPurchasingVendor       = "purchasing.vendor"
+	ComputerPurchasingLeased       = "purchasing.leased"
+	ComputerPurchasingWarrantyDate = "purchasing.warrantyDate"
+)
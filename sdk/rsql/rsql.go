@@ -0,0 +1,301 @@
+// rsql.go
+// Package rsql builds correctly escaped RSQL filter and sort expressions for Jamf Pro API list endpoints.
+// Api documentaton: https://developer.jamf.com/developer-guide/docs/api-style-guide#query-parameters
+//
+// Filters are composed from comparisons and rendered with String:
+//
+//	filter := rsql.Eq(rsql.ComputerGeneralName, `Bob's "Mac"`).
+//		And(rsql.In(rsql.ComputerHardwareModel, "MacBook Pro", "Mac mini"))
+//
+//	filter.String() // general.name=="Bob's \"Mac\"";hardware.model=in=("MacBook Pro","Mac mini")
+//
+// String values are always quoted and escaped, including '*', which Jamf Pro would otherwise treat as a
+// wildcard. Use Like when a wildcard match is intended.
+package rsql
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RSQL logical operators.
+const (
+	andOperator = ";"
+	orOperator  = ","
+)
+
+// Filter is an RSQL expression. The zero value is an empty filter which renders as "" and is
+// ignored when combined with other filters.
+type Filter struct {
+	// Comparison
+	selector   string
+	comparison string
+	arguments  []string
+
+	// Logical group
+	logical  string
+	operands []Filter
+
+	// err records a filter which cannot be expressed in RSQL, such as In with no values.
+	err error
+}
+
+// IsZero reports whether the filter is empty.
+func (f Filter) IsZero() bool {
+	return f.selector == "" && len(f.operands) == 0
+}
+
+// Err reports the first filter within f which cannot be expressed in RSQL, such as In with no
+// values. Such a filter still renders, but Jamf Pro rejects it rather than returning every record.
+func (f Filter) Err() error {
+	if f.err != nil {
+		return f.err
+	}
+	for _, operand := range f.operands {
+		if err := operand.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String renders the filter as an RSQL expression.
+func (f Filter) String() string {
+	return f.render("")
+}
+
+// And combines the filter with others so that all of them must match.
+func (f Filter) And(others ...Filter) Filter {
+	return And(append([]Filter{f}, others...)...)
+}
+
+// Or combines the filter with others so that any of them may match.
+func (f Filter) Or(others ...Filter) Filter {
+	return Or(append([]Filter{f}, others...)...)
+}
+
+// And returns a filter which matches when all of the given filters match. Empty filters are ignored.
+func And(filters ...Filter) Filter {
+	return group(andOperator, filters)
+}
+
+// Or returns a filter which matches when any of the given filters match. Empty filters are ignored.
+func Or(filters ...Filter) Filter {
+	return group(orOperator, filters)
+}
+
+// Eq matches records where selector equals value.
+func Eq(selector string, value any) Filter {
+	return compare(selector, "==", value)
+}
+
+// Ne matches records where selector does not equal value.
+func Ne(selector string, value any) Filter {
+	return compare(selector, "!=", value)
+}
+
+// Lt matches records where selector is less than value.
+func Lt(selector string, value any) Filter {
+	return compare(selector, "=lt=", value)
+}
+
+// Le matches records where selector is less than or equal to value.
+func Le(selector string, value any) Filter {
+	return compare(selector, "=le=", value)
+}
+
+// Gt matches records where selector is greater than value.
+func Gt(selector string, value any) Filter {
+	return compare(selector, "=gt=", value)
+}
+
+// Ge matches records where selector is greater than or equal to value.
+func Ge(selector string, value any) Filter {
+	return compare(selector, "=ge=", value)
+}
+
+// Like matches records where selector matches pattern, in which '*' matches any sequence of characters.
+// For example Like(ComputerGeneralName, "*-LAB-*").
+func Like(selector, pattern string) Filter {
+	return Filter{
+		selector:   selector,
+		comparison: "==",
+		arguments:  []string{`"` + patternReplacer.Replace(pattern) + `"`},
+	}
+}
+
+// In matches records where selector equals any of values. RSQL cannot express a match against
+// no values, so In with no values returns a filter whose Err reports it.
+func In(selector string, values ...any) Filter {
+	filter := compareList(selector, "=in=", values)
+	if len(values) == 0 {
+		filter.err = fmt.Errorf("rsql: In(%s) needs at least one value", selector)
+	}
+	return filter
+}
+
+// Out matches records where selector equals none of values. Out with no values excludes nothing,
+// so it returns the empty filter.
+func Out(selector string, values ...any) Filter {
+	if len(values) == 0 {
+		return Filter{}
+	}
+	return compareList(selector, "=out=", values)
+}
+
+// compare builds a single argument comparison.
+func compare(selector, comparison string, value any) Filter {
+	return Filter{
+		selector:   selector,
+		comparison: comparison,
+		arguments:  []string{formatValue(value)},
+	}
+}
+
+// compareList builds a comparison against a list of arguments.
+func compareList(selector, comparison string, values []any) Filter {
+	arguments := make([]string, 0, len(values))
+	for _, value := range values {
+		arguments = append(arguments, formatValue(value))
+	}
+
+	return Filter{
+		selector:   selector,
+		comparison: comparison,
+		arguments:  arguments,
+	}
+}
+
+// group builds a logical group, flattening nested groups using the same operator.
+func group(logical string, filters []Filter) Filter {
+	var operands []Filter
+	for _, filter := range filters {
+		switch {
+		case filter.IsZero():
+			continue
+		case filter.logical == logical:
+			operands = append(operands, filter.operands...)
+		default:
+			operands = append(operands, filter)
+		}
+	}
+
+	if len(operands) == 1 {
+		return operands[0]
+	}
+
+	return Filter{logical: logical, operands: operands}
+}
+
+// render renders the filter, adding parentheses when an OR group is nested inside an AND group.
+func (f Filter) render(parent string) string {
+	if f.IsZero() {
+		return ""
+	}
+
+	if f.logical == "" {
+		if len(f.arguments) == 1 && f.comparison != "=in=" && f.comparison != "=out=" {
+			return f.selector + f.comparison + f.arguments[0]
+		}
+		return f.selector + f.comparison + "(" + strings.Join(f.arguments, ",") + ")"
+	}
+
+	parts := make([]string, 0, len(f.operands))
+	for _, operand := range f.operands {
+		parts = append(parts, operand.render(f.logical))
+	}
+
+	rendered := strings.Join(parts, f.logical)
+	if parent == andOperator && f.logical == orOperator {
+		return "(" + rendered + ")"
+	}
+	return rendered
+}
+
+// formatValue renders a comparison argument. Numbers and booleans, including named types such as
+// an integer enum, are written as-is while all other values are quoted, escaping backslashes, double
+// quotes and wildcards.
+func formatValue(value any) string {
+	if t, ok := value.(time.Time); ok {
+		return quote(t.UTC().Format(time.RFC3339))
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	default:
+		return quote(fmt.Sprint(value))
+	}
+}
+
+// Replacers escaping the characters which are significant inside a quoted argument. Patterns keep their
+// wildcards.
+var (
+	quoteReplacer   = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `*`, `\*`)
+	patternReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+// quote wraps value in double quotes, escaping it so that it is matched literally.
+func quote(value string) string {
+	return `"` + quoteReplacer.Replace(value) + `"`
+}
+
+// SortCriterion is a single '<field>:<direction>' sort criterion.
+type SortCriterion struct {
+	Field      string
+	Descending bool
+}
+
+// Asc sorts by field in ascending order.
+func Asc(field string) SortCriterion {
+	return SortCriterion{Field: field}
+}
+
+// Desc sorts by field in descending order.
+func Desc(field string) SortCriterion {
+	return SortCriterion{Field: field, Descending: true}
+}
+
+// String renders the criterion in the form expected by the Jamf Pro API.
+func (s SortCriterion) String() string {
+	if s.Descending {
+		return s.Field + ":desc"
+	}
+	return s.Field + ":asc"
+}
+
+// SortBy renders several criteria as a single comma separated sort expression, for list methods
+// which take a 'sort' string argument.
+func SortBy(criteria ...SortCriterion) string {
+	parts := make([]string, 0, len(criteria))
+	for _, criterion := range criteria {
+		parts = append(parts, criterion.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// SortFilter renders a filter and sort criteria as a URL encoded query string, for list methods
+// which take a combined 'sort_filter' argument.
+//
+//	client.GetScripts(rsql.SortFilter(rsql.Eq("name", "Install Rosetta"), rsql.Asc("id")))
+func SortFilter(filter Filter, criteria ...SortCriterion) string {
+	query := url.Values{}
+	if sort := SortBy(criteria...); sort != "" {
+		query.Set("sort", sort)
+	}
+	if !filter.IsZero() {
+		query.Set("filter", filter.String())
+	}
+	return query.Encode()
+}
@@ -0,0 +1,107 @@
+// rsql_test.go
+package rsql
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestFilterString(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{"Empty", Filter{}, ""},
+		{"Equal string", Eq(ComputerGeneralName, "Mac-01"), `general.name=="Mac-01"`},
+		{"Equal integer", Eq(FieldID, 5), `id==5`},
+		{"Not equal boolean", Ne(ComputerGeneralManaged, false), `general.remoteManagement.managed!=false`},
+		{"Escaped quotes and backslashes", Eq(FieldName, `Bob's "Mac" \ 1`), `name=="Bob's \"Mac\" \\ 1"`},
+		{"Reserved characters are quoted", Eq(FieldName, "a;b,c(d)"), `name=="a;b,c(d)"`},
+		{"Equal escapes wildcards", Eq(FieldName, "Mac*"), `name=="Mac\*"`},
+		{"Like keeps wildcards", Like(ComputerGeneralName, "*-LAB-*"), `general.name=="*-LAB-*"`},
+		{"Greater than time", Gt(ComputerGeneralReportDate, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)), `general.reportDate=gt="2024-05-01T12:00:00Z"`},
+		{"Range", Ge(FieldID, 10).And(Lt(FieldID, 20)), `id=ge=10;id=lt=20`},
+		{"In", In(ComputerHardwareModel, "MacBook Pro", "Mac mini"), `hardware.model=in=("MacBook Pro","Mac mini")`},
+		{"Single value in", In(FieldID, 1), `id=in=(1)`},
+		{"Out", Out(FieldID, 1, 2), `id=out=(1,2)`},
+		{"Out with no values excludes nothing", Out(FieldID), ""},
+		{"Named numeric and boolean types are not quoted", Eq(FieldID, priority(3)).And(Eq(ComputerGeneralManaged, flag(true))), `id==3;general.remoteManagement.managed==true`},
+		{"Float", Ge(FieldID, 1.5), `id=ge=1.5`},
+		{
+			"Or nested in and is grouped",
+			Eq(ComputerGeneralPlatform, "Mac").And(Eq(FieldName, "a").Or(Eq(FieldName, "b"))),
+			`general.platform=="Mac";(name=="a",name=="b")`,
+		},
+		{
+			"And nested in or is not grouped",
+			Or(And(Eq(FieldID, 1), Eq(FieldName, "a")), Eq(FieldID, 2)),
+			`id==1;name=="a",id==2`,
+		},
+		{"Same operator groups are flattened", And(And(Eq(FieldID, 1), Eq(FieldID, 2)), Eq(FieldID, 3)), `id==1;id==2;id==3`},
+		{"Empty operands are ignored", And(Filter{}, Eq(FieldID, 1), Filter{}), `id==1`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// Named types as callers commonly declare them for enums and flags.
+type (
+	priority int
+	flag     bool
+)
+
+func (p priority) String() string { return "priority-" + strconv.Itoa(int(p)) }
+
+func TestFilterErr(t *testing.T) {
+	if err := Eq(FieldID, 1).And(In(FieldName, "a")).Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+
+	filter := Eq(FieldID, 1).And(Or(Eq(FieldName, "a"), In(FieldName)))
+	if err := filter.Err(); err == nil {
+		t.Errorf("Err() of %s = nil, want an error for In with no values", filter)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	got := SortBy(Asc(ComputerGeneralName), Desc(FieldID))
+	if want := "general.name:asc,id:desc"; got != want {
+		t.Errorf("SortBy() = %s, want %s", got, want)
+	}
+
+	if got := SortBy(); got != "" {
+		t.Errorf("SortBy() with no criteria = %q, want empty string", got)
+	}
+}
+
+func TestSortFilter(t *testing.T) {
+	filter := Eq(FieldName, "a&b").And(Eq(FieldID, 1))
+
+	raw := SortFilter(filter, Desc(FieldID))
+	want := "filter=name%3D%3D%22a%26b%22%3Bid%3D%3D1&sort=id%3Adesc"
+	if raw != want {
+		t.Errorf("SortFilter() = %s, want %s", raw, want)
+	}
+
+	query, err := url.ParseQuery(raw)
+	if err != nil {
+		t.Fatalf("SortFilter() produced an unparsable query: %v", err)
+	}
+
+	if got := query.Get("filter"); got != filter.String() {
+		t.Errorf("SortFilter() filter round trip = %s, want %s", got, filter.String())
+	}
+
+	if got := SortFilter(Filter{}); got != "" {
+		t.Errorf("SortFilter() with no filter or sort = %q, want empty string", got)
+	}
+}